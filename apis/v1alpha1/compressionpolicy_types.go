@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=cmpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// CompressionPolicy is a Direct Attached Policy. It provides a way to override the compression settings,
+// configured in the NginxProxy CRD that is attached to the GatewayClass parametersRef, for an HTTPRoute.
+type CompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CompressionPolicy.
+	Spec CompressionPolicySpec `json:"spec"`
+
+	// Status defines the state of the CompressionPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompressionPolicyList contains a list of CompressionPolicies.
+type CompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompressionPolicy `json:"items"`
+}
+
+// CompressionPolicySpec defines the desired state of the CompressionPolicy.
+type CompressionPolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	//
+	// Support: HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Compression defines the compression settings for the responses of the target.
+	// Settings that are not specified are inherited from the NginxProxy settings.
+	Compression Compression `json:"compression"`
+}
@@ -0,0 +1,147 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=eppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// ErrorPagePolicy is a Direct Attached Policy. It provides a way to replace the responses with particular
+// status codes with custom error pages for a Gateway or an HTTPRoute.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// The error pages of an HTTPRoute take precedence over the error pages of the Gateway
+	// for the same status codes.
+	//
+	// Support: Gateway, HTTPRoute
+	TargetRef gatewayv1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// ErrorPages are the custom error pages.
+	// If several error pages include the same status code, the first one is used.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	ErrorPages []ErrorPage `json:"errorPages"`
+}
+
+// ErrorPage defines a custom response for a set of status codes.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of body, configMapRef or backendRef must be specified",rule="[has(self.body), has(self.configMapRef), has(self.backendRef)].filter(x, x).size() == 1"
+//
+//nolint:lll
+type ErrorPage struct {
+	// ResponseCode is the status code of the error page response.
+	// If not specified, the original status code is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	ResponseCode *int32 `json:"responseCode,omitempty"`
+
+	// ContentType is the media type of the error page. The value "*" is not allowed.
+	// If not specified, "text/html" is used.
+	// It is ignored for an error page served by a backendRef, which uses the media type of the response
+	// of the backend.
+	//
+	// +optional
+	ContentType *MIMEType `json:"contentType,omitempty"`
+
+	// Body is the content of the error page.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Body *string `json:"body,omitempty"`
+
+	// ConfigMapRef references a key of a ConfigMap in the namespace of the policy.
+	// The value of the key is the content of the error page.
+	//
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// BackendRef references a Service in the namespace of the policy that serves the error page.
+	//
+	// +optional
+	BackendRef *ErrorPageBackendRef `json:"backendRef,omitempty"`
+
+	// Codes are the status codes of the responses that are replaced with the error page.
+	// The error pages of an HTTPRoute also replace the responses of the backends of the HTTPRoute.
+	// The error pages of a Gateway only replace the responses generated by NGINX, for example when a backend
+	// is unavailable, and not the responses of the backends, unless the HTTPRoute is targeted by an ErrorPagePolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Codes []ErrorStatusCode `json:"codes"`
+}
+
+// ErrorStatusCode is an HTTP status code that can be replaced with an error page.
+//
+// +kubebuilder:validation:Minimum=300
+// +kubebuilder:validation:Maximum=599
+type ErrorStatusCode int32
+
+// ConfigMapKeyReference references a key of a ConfigMap.
+type ConfigMapKeyReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key in the data or binaryData field of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
+
+// ErrorPageBackendRef references a Service that serves an error page.
+type ErrorPageBackendRef struct {
+	// Name is the name of the Service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Path is the path of the error page on the Service.
+	// If not specified, "/" is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s{};]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Port is the port of the Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
@@ -0,0 +1,43 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Hostname",type=string,JSONPath=`.spec.hostname`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HostnameBackend is a backend outside the cluster that is identified by a DNS hostname.
+// It is referenced by an HTTPRoute or GRPCRoute backendRef with the group "gateway.nginx.org" and the kind
+// "HostnameBackend", and the port of the backendRef is used as the port of the backend.
+// NGINX resolves the hostname at runtime using the DNS resolver configured in the NginxProxy, which requires
+// NGINX Plus.
+type HostnameBackend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the HostnameBackend.
+	Spec HostnameBackendSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// HostnameBackendList contains a list of HostnameBackends.
+type HostnameBackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostnameBackend `json:"items"`
+}
+
+// HostnameBackendSpec defines the desired state of the HostnameBackend.
+type HostnameBackendSpec struct {
+	// Hostname is the DNS hostname of the backend.
+	// Format: a lowercase RFC 1123 subdomain.
+	//
+	//nolint:lll
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Hostname string `json:"hostname"`
+}
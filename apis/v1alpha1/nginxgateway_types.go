@@ -35,6 +35,12 @@ type NginxGatewaySpec struct {
 	//
 	// +optional
 	Logging *Logging `json:"logging,omitempty"`
+
+	// EventBatching defines how the control plane batches Kubernetes events before it updates NGINX.
+	// If not set, the control plane uses the values of its command-line flags.
+	//
+	// +optional
+	EventBatching *EventBatching `json:"eventBatching,omitempty"`
 }
 
 // Logging defines logging related settings for the control plane.
@@ -46,6 +52,24 @@ type Logging struct {
 	Level *ControllerLogLevel `json:"level,omitempty"`
 }
 
+// EventBatching defines how the control plane batches Kubernetes events before it updates NGINX.
+// Batching events reduces the number of NGINX reloads when many resources change at once.
+type EventBatching struct {
+	// Window is how long the control plane waits for another event before it processes the received events
+	// as one batch. Every new event restarts the window, but the control plane doesn't wait longer than
+	// ten windows after the first event of a batch. Zero disables waiting.
+	//
+	// +optional
+	Window *Duration `json:"window,omitempty"`
+
+	// MaxSize is the number of events at which the control plane stops waiting for the window and
+	// processes the batch. Zero means there is no maximum.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int32 `json:"maxSize,omitempty"`
+}
+
 // ControllerLogLevel type defines the logging level for the control plane.
 //
 // +kubebuilder:validation:Enum=info;debug;error
@@ -64,6 +88,12 @@ const (
 
 // NginxGatewayStatus defines the state of the NginxGateway.
 type NginxGatewayStatus struct {
+	// LastAppliedConfigHash is the hash of the NGINX configuration that was last applied to NGINX.
+	// NGINX is not reloaded if the generated configuration has the same hash.
+	//
+	// +optional
+	LastAppliedConfigHash string `json:"lastAppliedConfigHash,omitempty"`
+
 	// +optional
 	// +listType=map
 	// +listMapKey=type
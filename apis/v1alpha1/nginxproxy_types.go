@@ -32,8 +32,128 @@ type NginxProxySpec struct {
 	//
 	// +optional
 	Telemetry *Telemetry `json:"telemetry,omitempty"`
+
+	// Compression specifies the default compression settings for responses.
+	// The settings can be overridden for an HTTPRoute using a CompressionPolicy.
+	//
+	// +optional
+	Compression *Compression `json:"compression,omitempty"`
+
+	// DNSResolver specifies the DNS servers NGINX uses to resolve the hostnames of backends at runtime,
+	// such as ExternalName Services and HostnameBackends.
+	// Resolving backend hostnames requires NGINX Plus.
+	//
+	// +optional
+	DNSResolver *DNSResolver `json:"dnsResolver,omitempty"`
+
+	// TopologyAwareRouting configures NGINX to prefer the endpoints of a backend that are in the same zone
+	// as NGINX, to reduce cross-zone traffic.
+	//
+	// +optional
+	TopologyAwareRouting *TopologyAwareRouting `json:"topologyAwareRouting,omitempty"`
+
+	// TerminatingEndpoints configures how NGINX handles the endpoints of a backend that are terminating
+	// but still serving, so that in-flight requests are not cut off when a Pod shuts down.
+	//
+	// +optional
+	TerminatingEndpoints *TerminatingEndpoints `json:"terminatingEndpoints,omitempty"`
+
+	// DynamicUpstreams enables updating the endpoints of backends without reloading NGINX open source.
+	// NGINX chooses a random endpoint of a backend from shared memory instead of using its upstream configuration,
+	// so upstream settings like the load balancing method do not apply to backends with live updated endpoints.
+	// NGINX Plus always updates endpoints without a reload, so this setting has no effect for NGINX Plus.
+	//
+	// +optional
+	DynamicUpstreams *bool `json:"dynamicUpstreams,omitempty"`
+}
+
+// TerminatingEndpoints configures how NGINX handles terminating endpoints.
+// An endpoint is terminating but still serving when its EndpointSlice conditions are serving and terminating.
+type TerminatingEndpoints struct {
+	// Policy is the policy for terminating endpoints.
+	//
+	// +kubebuilder:validation:Enum=Remove;Backup;Drain
+	Policy TerminatingEndpointsPolicy `json:"policy"`
 }
 
+// TerminatingEndpointsPolicy is the policy for terminating endpoints.
+type TerminatingEndpointsPolicy string
+
+const (
+	// TerminatingEndpointsPolicyRemove removes terminating endpoints from NGINX as soon as they stop being ready.
+	TerminatingEndpointsPolicyRemove TerminatingEndpointsPolicy = "Remove"
+
+	// TerminatingEndpointsPolicyBackup keeps terminating endpoints as backup servers until they disappear,
+	// so that they only receive requests when no other endpoint is available.
+	// If all endpoints are terminating, they are kept as primary servers.
+	TerminatingEndpointsPolicyBackup TerminatingEndpointsPolicy = "Backup"
+
+	// TerminatingEndpointsPolicyDrain keeps terminating endpoints in the draining state until they disappear,
+	// so that they only receive requests bound to them by session persistence.
+	// The draining state is only supported by NGINX Plus. NGINX open source uses terminating endpoints as backup
+	// servers instead. If all endpoints are terminating, they are kept as primary servers.
+	TerminatingEndpointsPolicyDrain TerminatingEndpointsPolicy = "Drain"
+)
+
+// TopologyAwareRouting configures how NGINX chooses endpoints based on their zone.
+// The zone of NGINX is the value of the "topology.kubernetes.io/zone" label of the Node NGINX runs on.
+type TopologyAwareRouting struct {
+	// Mode is the topology-aware routing mode.
+	//
+	// +kubebuilder:validation:Enum=Disabled;PreferSameZone
+	Mode TopologyMode `json:"mode"`
+}
+
+// TopologyMode is the topology-aware routing mode.
+type TopologyMode string
+
+const (
+	// TopologyModeDisabled load balances requests among all endpoints regardless of their zone.
+	TopologyModeDisabled TopologyMode = "Disabled"
+
+	// TopologyModePreferSameZone sends requests to the endpoints in the zone of NGINX. The endpoints in other zones
+	// are backup servers that only receive requests when the endpoints in the zone are unavailable.
+	// If all endpoints of a Service have zone hints, the hints are used instead of the zones of the endpoints.
+	// If no endpoint is in the zone of NGINX, or the zone of NGINX is unknown, all endpoints are used.
+	TopologyModePreferSameZone TopologyMode = "PreferSameZone"
+)
+
+// DNSResolver specifies the DNS resolver configuration.
+type DNSResolver struct {
+	// Valid overrides the time NGINX caches resolved addresses.
+	// By default, NGINX uses the TTL value of the DNS response.
+	//
+	// +optional
+	Valid *Duration `json:"valid,omitempty"`
+
+	// Timeout is the timeout for name resolution.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#resolver_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// DisableIPv6 disables looking up IPv6 addresses.
+	//
+	// +optional
+	DisableIPv6 *bool `json:"disableIPv6,omitempty"`
+
+	// Addresses are the addresses of the DNS servers, with an optional port.
+	// Format: an IPv4 address, an IPv6 address in square brackets, or a hostname, followed by an optional port.
+	// Examples: 10.96.0.10, [fd00::10]:53, kube-dns.kube-system.svc.cluster.local:53.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []ResolverAddress `json:"addresses"`
+}
+
+// ResolverAddress is the address of a DNS server, with an optional port.
+//
+// +kubebuilder:validation:MaxLength=260
+// +kubebuilder:validation:Pattern=`^(?:\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*)(?::\d{1,5})?$`
+//
+//nolint:lll
+type ResolverAddress string
+
 // Telemetry specifies the OpenTelemetry configuration.
 type Telemetry struct {
 	// Exporter specifies OpenTelemetry export parameters.
@@ -40,6 +40,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ObservabilityPolicyList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
+		&CompressionPolicy{},
+		&CompressionPolicyList{},
+		&ErrorPagePolicy{},
+		&ErrorPagePolicyList{},
+		&SnippetsFilter{},
+		&SnippetsFilterList{},
+		&HostnameBackend{},
+		&HostnameBackendList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -25,3 +25,51 @@ type SpanAttribute struct {
 	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
 	Value string `json:"value"`
 }
+
+// Compression defines the gzip and Brotli compression settings for responses.
+type Compression struct {
+	// Enable turns gzip compression of responses on or off.
+	// If specified neither in the CompressionPolicy nor in the NginxProxy, compression is enabled.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Brotli turns Brotli compression of responses on or off. Brotli is used for clients that accept it,
+	// while gzip is used for the others. MinLength and MIMETypes also apply to Brotli, Level applies to gzip only.
+	// Brotli compression requires NGINX Plus.
+	// If not specified, Brotli compression is disabled.
+	//
+	// +optional
+	Brotli *bool `json:"brotli,omitempty"`
+
+	// MinLength is the minimum length of a response, in bytes, that will be compressed.
+	// The length is determined only from the "Content-Length" response header field.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_min_length
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Level is the gzip compression level of a response. Acceptable values are in the range from 1 to 9.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_comp_level
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *int32 `json:"level,omitempty"`
+
+	// MIMETypes are the MIME types of the responses to compress, in addition to "text/html".
+	// The special value "*" matches any MIME type.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_types
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	MIMETypes []MIMEType `json:"mimeTypes,omitempty"`
+}
+
+// MIMEType is a media type of the form "type/subtype", for example, "application/json".
+// The special value "*" matches any media type.
+//
+// +kubebuilder:validation:MaxLength=127
+// +kubebuilder:validation:Pattern=`^(\*|[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*/[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*)$`
+type MIMEType string
@@ -85,3 +85,10 @@ Expand leader election lock name.
 {{- printf "%s-%s" (include "nginx-gateway.fullname" .) "leader-election" -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Expand the name of the validating admission webhook resources.
+*/}}
+{{- define "nginx-gateway.webhookName" -}}
+{{- printf "%s-%s" (include "nginx-gateway.fullname" .) "webhook" -}}
+{{- end -}}
@@ -1,23 +1,31 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
+	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	ctlrZap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/provisioner"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/render"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
 )
 
 const (
@@ -44,26 +52,66 @@ func createRootCommand() *cobra.Command {
 }
 
 func createStaticModeCommand() *cobra.Command {
+	return newStaticModeCommand(os.Getenv, startStaticMode)
+}
+
+// startStaticMode starts NGINX Gateway Fabric in static mode with the config built by the static-mode command.
+func startStaticMode(_ context.Context, conf config.Config) error {
+	conf.Logger.Info(
+		"Starting NGINX Gateway Fabric in static mode",
+		"version", version,
+		"commit", commit,
+		"date", date,
+	)
+
+	if err := static.StartManager(conf); err != nil {
+		return fmt.Errorf("failed to start control loop: %w", err)
+	}
+
+	return nil
+}
+
+// newStaticModeCommand creates the static-mode command. The command reads the environment variables with getenv,
+// builds the config from them and the flags, and passes it to run.
+func newStaticModeCommand(
+	getenv func(key string) string,
+	run func(ctx context.Context, conf config.Config) error,
+) *cobra.Command {
 	// flag names
 	const (
-		gatewayFlag                 = "gateway"
-		configFlag                  = "config"
-		serviceFlag                 = "service"
-		updateGCStatusFlag          = "update-gatewayclass-status"
-		metricsDisableFlag          = "metrics-disable"
-		metricsSecureFlag           = "metrics-secure-serving"
-		metricsPortFlag             = "metrics-port"
-		healthDisableFlag           = "health-disable"
-		healthPortFlag              = "health-port"
-		leaderElectionDisableFlag   = "leader-election-disable"
-		leaderElectionLockNameFlag  = "leader-election-lock-name"
-		productTelemetryDisableFlag = "product-telemetry-disable"
-		plusFlag                    = "nginx-plus"
-		gwAPIExperimentalFlag       = "gateway-api-experimental-features"
-		usageReportSecretFlag       = "usage-report-secret"
-		usageReportServerURLFlag    = "usage-report-server-url"
-		usageReportSkipVerifyFlag   = "usage-report-skip-verify"
-		usageReportClusterNameFlag  = "usage-report-cluster-name"
+		gatewayFlag                          = "gateway"
+		configFlag                           = "config"
+		serviceFlag                          = "service"
+		updateGCStatusFlag                   = "update-gatewayclass-status"
+		metricsDisableFlag                   = "metrics-disable"
+		metricsSecureFlag                    = "metrics-secure-serving"
+		metricsPortFlag                      = "metrics-port"
+		debugEndpointFlag                    = "debug-endpoint"
+		healthDisableFlag                    = "health-disable"
+		healthPortFlag                       = "health-port"
+		leaderElectionDisableFlag            = "leader-election-disable"
+		leaderElectionLockNameFlag           = "leader-election-lock-name"
+		productTelemetryDisableFlag          = "product-telemetry-disable"
+		productTelemetryExporterFlag         = "product-telemetry-exporter"
+		productTelemetryEndpointFlag         = "product-telemetry-endpoint"
+		productTelemetryEndpointInsecureFlag = "product-telemetry-endpoint-insecure"
+		productTelemetryFileFlag             = "product-telemetry-file"
+		plusFlag                             = "nginx-plus"
+		gwAPIExperimentalFlag                = "gateway-api-experimental-features"
+		snippetsFiltersFlag                  = "snippets-filters"
+		errorPagePoliciesFlag                = "error-page-policies"
+		usageReportSecretFlag                = "usage-report-secret"
+		usageReportServerURLFlag             = "usage-report-server-url"
+		usageReportSkipVerifyFlag            = "usage-report-skip-verify"
+		usageReportClusterNameFlag           = "usage-report-cluster-name"
+		eventBatchWindowFlag                 = "event-batch-window"
+		eventBatchMaxSizeFlag                = "event-batch-max-size"
+		watchNamespacesFlag                  = "watch-namespaces"
+		webhookEnableFlag                    = "webhook-enable"
+		webhookPortFlag                      = "webhook-port"
+		webhookSecretFlag                    = "webhook-secret"
+		webhookServiceFlag                   = "webhook-service"
+		webhookConfigFlag                    = "webhook-config"
 	)
 
 	// flag values
@@ -86,6 +134,7 @@ func createStaticModeCommand() *cobra.Command {
 		}
 		disableMetrics    bool
 		metricsSecure     bool
+		debugEndpoint     bool
 		metricsListenPort = intValidatingValue{
 			validator: validatePort,
 			value:     9113,
@@ -104,7 +153,20 @@ func createStaticModeCommand() *cobra.Command {
 
 		gwExperimentalFeatures bool
 
-		disableProductTelemetry bool
+		snippetsFilters bool
+
+		errorPagePolicies bool
+
+		disableProductTelemetry  bool
+		productTelemetryExporter = stringValidatingValue{
+			validator: validateProductTelemetryExporter,
+			value:     string(config.ProductTelemetryExporterOTLPGRPC),
+		}
+		productTelemetryEndpoint = stringValidatingValue{
+			validator: validateEndpoint,
+		}
+		productTelemetryEndpointInsecure bool
+		productTelemetryFile             string
 
 		plus                   bool
 		usageReportSkipVerify  bool
@@ -115,6 +177,31 @@ func createStaticModeCommand() *cobra.Command {
 		usageReportServerURL  = stringValidatingValue{
 			validator: validateURL,
 		}
+
+		eventBatchWindow  time.Duration
+		eventBatchMaxSize = intValidatingValue{
+			validator: validateNonNegative,
+		}
+
+		watchNamespaces = watchNamespacesValue{}
+
+		enableWebhook     bool
+		webhookListenPort = intValidatingValue{
+			validator: validatePort,
+			value:     9443,
+		}
+		webhookSecretName = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx-gateway-webhook-cert",
+		}
+		webhookServiceName = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx-gateway-webhook",
+		}
+		webhookConfigName = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx-gateway-webhook",
+		}
 	)
 
 	cmd := &cobra.Command{
@@ -124,34 +211,49 @@ func createStaticModeCommand() *cobra.Command {
 			atom := zap.NewAtomicLevel()
 
 			logger := ctlrZap.New(ctlrZap.Level(atom))
-			logger.Info(
-				"Starting NGINX Gateway Fabric in static mode",
-				"version", version,
-				"commit", commit,
-				"date", date,
-			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if enableWebhook {
+				ports = append(ports, webhookListenPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
-			podIP := os.Getenv("POD_IP")
+			if debugEndpoint && disableMetrics {
+				return fmt.Errorf("%s requires metrics to be enabled, but %s is set", debugEndpointFlag, metricsDisableFlag)
+			}
+
+			if debugEndpoint && !metricsSecure {
+				return fmt.Errorf(
+					"%s requires metrics to be served via https, but %s is not set",
+					debugEndpointFlag,
+					metricsSecureFlag,
+				)
+			}
+
+			if eventBatchWindow < 0 {
+				return fmt.Errorf("%s must not be negative", eventBatchWindowFlag)
+			}
+
+			podIP := getenv("POD_IP")
 			if err := validateIP(podIP); err != nil {
 				return fmt.Errorf("error validating POD_IP environment variable: %w", err)
 			}
 
-			namespace := os.Getenv("POD_NAMESPACE")
+			namespace := getenv("POD_NAMESPACE")
 			if namespace == "" {
 				return errors.New("POD_NAMESPACE environment variable must be set")
 			}
 
-			podName := os.Getenv("POD_NAME")
+			podName := getenv("POD_NAME")
 			if podName == "" {
 				return errors.New("POD_NAME environment variable must be set")
 			}
 
-			imageSource := os.Getenv("BUILD_AGENT")
+			imageSource := getenv("BUILD_AGENT")
 			if imageSource != "gha" && imageSource != "local" {
 				imageSource = "unknown"
 			}
@@ -161,8 +263,13 @@ func createStaticModeCommand() *cobra.Command {
 				return fmt.Errorf("error parsing telemetry report period: %w", err)
 			}
 
-			if telemetryEndpoint != "" {
-				if err := validateEndpoint(telemetryEndpoint); err != nil {
+			endpoint := telemetryEndpoint
+			if cmd.Flags().Changed(productTelemetryEndpointFlag) {
+				endpoint = productTelemetryEndpoint.value
+			}
+
+			if endpoint != "" {
+				if err := validateEndpoint(endpoint); err != nil {
 					return fmt.Errorf("error validating telemetry endpoint: %w", err)
 				}
 			}
@@ -172,6 +279,30 @@ func createStaticModeCommand() *cobra.Command {
 				return fmt.Errorf("error parsing telemetry endpoint insecure: %w", err)
 			}
 
+			if cmd.Flags().Changed(productTelemetryEndpointInsecureFlag) {
+				telemetryEndpointInsecure = productTelemetryEndpointInsecure
+			}
+
+			telemetryExporter := config.ProductTelemetryExporter(productTelemetryExporter.value)
+
+			if telemetryExporter == config.ProductTelemetryExporterOTLPHTTP && endpoint == "" {
+				return fmt.Errorf(
+					"%s must be set when %s is %s",
+					productTelemetryEndpointFlag,
+					productTelemetryExporterFlag,
+					telemetryExporter,
+				)
+			}
+
+			if telemetryExporter == config.ProductTelemetryExporterFile && productTelemetryFile == "" {
+				return fmt.Errorf(
+					"%s must be set when %s is %s",
+					productTelemetryFileFlag,
+					productTelemetryExporterFlag,
+					telemetryExporter,
+				)
+			}
+
 			var gwNsName *types.NamespacedName
 			if cmd.Flags().Changed(gatewayFlag) {
 				gwNsName = &gateway.value
@@ -206,15 +337,32 @@ func createStaticModeCommand() *cobra.Command {
 					ServiceName: serviceName.value,
 					Namespace:   namespace,
 					Name:        podName,
+					NodeName:    getenv("NODE_NAME"),
 				},
 				HealthConfig: config.HealthConfig{
 					Enabled: !disableHealth,
 					Port:    healthListenPort.value,
 				},
+				EventBatching: config.EventBatchingConfig{
+					Window:  eventBatchWindow,
+					MaxSize: eventBatchMaxSize.value,
+				},
+				WatchNamespaces: config.WatchNamespacesConfig{
+					Names:    watchNamespaces.names,
+					Selector: watchNamespaces.selector,
+				},
+				Webhook: config.WebhookConfig{
+					Enabled:           enableWebhook,
+					Port:              webhookListenPort.value,
+					SecretName:        webhookSecretName.value,
+					ServiceName:       webhookServiceName.value,
+					ConfigurationName: webhookConfigName.value,
+				},
 				MetricsConfig: config.MetricsConfig{
-					Enabled: !disableMetrics,
-					Port:    metricsListenPort.value,
-					Secure:  metricsSecure,
+					Enabled:       !disableMetrics,
+					Port:          metricsListenPort.value,
+					Secure:        metricsSecure,
+					DebugEndpoint: debugEndpoint,
 				},
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
@@ -225,12 +373,16 @@ func createStaticModeCommand() *cobra.Command {
 				ProductTelemetryConfig: config.ProductTelemetryConfig{
 					ReportPeriod:     period,
 					Enabled:          !disableProductTelemetry,
-					Endpoint:         telemetryEndpoint,
+					Exporter:         telemetryExporter,
+					Endpoint:         endpoint,
 					EndpointInsecure: telemetryEndpointInsecure,
+					FilePath:         productTelemetryFile,
 				},
 				Plus:                 plus,
 				Version:              version,
 				ExperimentalFeatures: gwExperimentalFeatures,
+				SnippetsFilters:      snippetsFilters,
+				ErrorPagePolicies:    errorPagePolicies,
 				ImageSource:          imageSource,
 				Flags: config.Flags{
 					Names:  flagKeys,
@@ -238,11 +390,7 @@ func createStaticModeCommand() *cobra.Command {
 				},
 			}
 
-			if err := static.StartManager(conf); err != nil {
-				return fmt.Errorf("failed to start control loop: %w", err)
-			}
-
-			return nil
+			return run(cmd.Context(), conf)
 		},
 	}
 
@@ -313,6 +461,16 @@ func createStaticModeCommand() *cobra.Command {
 			" Please note that this endpoint will be secured with a self-signed certificate.",
 	)
 
+	cmd.Flags().BoolVar(
+		&debugEndpoint,
+		debugEndpointFlag,
+		false,
+		"Expose the latest graph, dataplane configuration and generated NGINX configuration on the metrics server "+
+			"under /debug/graph, /debug/configuration and /debug/nginx. Private keys and certificates are redacted. "+
+			"Requests must carry the bearer token of a user that is allowed to get the path. "+
+			"Requires metrics to be served via https, so that the bearer tokens are not sent in plain text.",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableHealth,
 		healthDisableFlag,
@@ -349,6 +507,38 @@ func createStaticModeCommand() *cobra.Command {
 		"Disable the collection of product telemetry.",
 	)
 
+	cmd.Flags().Var(
+		&productTelemetryExporter,
+		productTelemetryExporterFlag,
+		"The exporter of the product telemetry reports. "+
+			"'otlp-grpc' sends the reports to the telemetry endpoint using OTLP over gRPC. "+
+			"'otlp-http' sends the reports to the telemetry endpoint using OTLP over HTTP. "+
+			"'file' writes the reports as JSON lines to the file set by "+productTelemetryFileFlag+", "+
+			"rotating it when it reaches 1MB. 'logging' logs the reports.",
+	)
+
+	cmd.Flags().Var(
+		&productTelemetryEndpoint,
+		productTelemetryEndpointFlag,
+		"The endpoint of the OTLP telemetry service, in the format <host>:<port>. "+
+			"Overrides the default telemetry endpoint.",
+	)
+
+	cmd.Flags().BoolVar(
+		&productTelemetryEndpointInsecure,
+		productTelemetryEndpointInsecureFlag,
+		false,
+		"Disable TLS for the connection to the OTLP telemetry service. "+
+			"Overrides the default of the telemetry endpoint.",
+	)
+
+	cmd.Flags().StringVar(
+		&productTelemetryFile,
+		productTelemetryFileFlag,
+		"",
+		"The path of the file to which the 'file' product telemetry exporter writes the reports.",
+	)
+
 	cmd.Flags().BoolVar(
 		&plus,
 		plusFlag,
@@ -364,6 +554,24 @@ func createStaticModeCommand() *cobra.Command {
 			"Requires the Gateway APIs installed from the experimental channel.",
 	)
 
+	cmd.Flags().BoolVar(
+		&snippetsFilters,
+		snippetsFiltersFlag,
+		false,
+		"Enable SnippetsFilters feature. SnippetsFilters and the snippet annotations of HTTPRoutes allow inserting "+
+			"NGINX configuration into the generated NGINX config for HTTPRoute resources. The snippets are validated "+
+			"with nginx -t in the snippets-validator container of the NGINX Gateway Fabric Pod.",
+	)
+
+	cmd.Flags().BoolVar(
+		&errorPagePolicies,
+		errorPagePoliciesFlag,
+		false,
+		"Enable ErrorPagePolicies feature. ErrorPagePolicies replace the responses with particular status codes "+
+			"with custom error pages. The control plane watches ConfigMaps, which can hold the content of the "+
+			"error pages.",
+	)
+
 	cmd.Flags().Var(
 		&usageReportSecretName,
 		usageReportSecretFlag,
@@ -391,10 +599,79 @@ func createStaticModeCommand() *cobra.Command {
 		"Disable client verification of the NGINX Plus usage reporting server certificate.",
 	)
 
+	cmd.Flags().DurationVar(
+		&eventBatchWindow,
+		eventBatchWindowFlag,
+		0,
+		"The time to wait for another Kubernetes event before processing the received events as one batch. "+
+			"Every new event restarts the window, up to 10 windows after the first event of a batch. "+
+			"Zero disables waiting. The NginxGateway resource can override it.",
+	)
+
+	cmd.Flags().Var(
+		&eventBatchMaxSize,
+		eventBatchMaxSizeFlag,
+		"The number of Kubernetes events at which a batch is processed without waiting for the event batch window. "+
+			"Zero means there is no maximum. The NginxGateway resource can override it.",
+	)
+
+	cmd.Flags().Var(
+		&watchNamespaces,
+		watchNamespacesFlag,
+		"The Namespaces in which the control plane watches routes, Services, EndpointSlices, Secrets, ConfigMaps "+
+			"and ReferenceGrants. Either a comma-separated list of Namespace names or a Namespace label selector. "+
+			`A value that contains "=", "!" or "(" is a label selector, which is evaluated only on startup, `+
+			"so changes to the labels of Namespaces take effect after a restart. Policies, SnippetsFilters and "+
+			"HostnameBackends are watched in all Namespaces, but the ConfigMaps they reference in the Namespaces "+
+			"that are not watched are not found. The Namespace of the control plane is always watched. "+
+			"If not specified, all Namespaces are watched.",
+	)
+
+	cmd.Flags().BoolVar(
+		&enableWebhook,
+		webhookEnableFlag,
+		false,
+		"Enable the validating admission webhook server. The webhook rejects invalid routes and NGINX Gateway Fabric "+
+			"resources when they are created or updated. If disabled, invalid resources are only reported in their status.",
+	)
+
+	cmd.Flags().Var(
+		&webhookListenPort,
+		webhookPortFlag,
+		"Set the port where the validating admission webhook server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().Var(
+		&webhookSecretName,
+		webhookSecretFlag,
+		"The name of the Secret in the same Namespace as the controller that stores the self-signed certificates "+
+			"of the validating admission webhook. The Secret is created if it doesn't exist.",
+	)
+
+	cmd.Flags().Var(
+		&webhookServiceName,
+		webhookServiceFlag,
+		"The name of the Service in the same Namespace as the controller that fronts the validating admission webhook.",
+	)
+
+	cmd.Flags().Var(
+		&webhookConfigName,
+		webhookConfigFlag,
+		"The name of the ValidatingWebhookConfiguration that the CA certificate of the validating admission webhook "+
+			"is injected into.",
+	)
+
 	return cmd
 }
 
 func createProvisionerModeCommand() *cobra.Command {
+	// flag names
+	const (
+		hpaMaxReplicasFlag          = "hpa-max-replicas"
+		hpaTargetCPUUtilizationFlag = "hpa-target-cpu-utilization"
+	)
+
+	// flag values
 	var (
 		gatewayCtlrName = stringValidatingValue{
 			validator: validateGatewayControllerName,
@@ -402,6 +679,13 @@ func createProvisionerModeCommand() *cobra.Command {
 		gatewayClassName = stringValidatingValue{
 			validator: validateResourceName,
 		}
+		hpaMaxReplicas = intValidatingValue{
+			validator: validateNonNegative,
+		}
+		hpaTargetCPUUtilization = intValidatingValue{
+			validator: validatePercentage,
+			value:     80,
+		}
 	)
 
 	cmd := &cobra.Command{
@@ -421,6 +705,10 @@ func createProvisionerModeCommand() *cobra.Command {
 				Logger:           logger,
 				GatewayClassName: gatewayClassName.value,
 				GatewayCtlrName:  gatewayCtlrName.value,
+				HPA: provisioner.HPAConfig{
+					MaxReplicas:                    int32(hpaMaxReplicas.value),
+					TargetCPUUtilizationPercentage: int32(hpaTargetCPUUtilization.value),
+				},
 			})
 		},
 	}
@@ -439,9 +727,256 @@ func createProvisionerModeCommand() *cobra.Command {
 	)
 	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
 
+	cmd.Flags().Var(
+		&hpaMaxReplicas,
+		hpaMaxReplicasFlag,
+		"The maximum number of replicas of each provisioned Deployment. If set, the provisioner creates "+
+			"a HorizontalPodAutoscaler for each Deployment. Zero disables the HorizontalPodAutoscaler.",
+	)
+
+	cmd.Flags().Var(
+		&hpaTargetCPUUtilization,
+		hpaTargetCPUUtilizationFlag,
+		"The target average CPU utilization, in percent, of the HorizontalPodAutoscaler of "+
+			"each provisioned Deployment.",
+	)
+
 	return cmd
 }
 
+func createRenderCommand() *cobra.Command {
+	// flag names
+	const (
+		fileFlag              = "file"
+		outputDirFlag         = "output-dir"
+		plusFlag              = "nginx-plus"
+		gwAPIExperimentalFlag = "gateway-api-experimental-features"
+		snippetsFiltersFlag   = "snippets-filters"
+		errorPagePoliciesFlag = "error-page-policies"
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+		}
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+		}
+		files                  []string
+		outputDir              string
+		plus                   bool
+		gwExperimentalFeatures bool
+		snippetsFilters        bool
+		errorPagePolicies      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render the NGINX configuration and the statuses of resources from manifests, without a cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return render.Render(cmd.Context(), render.Config{
+				Logger:               ctlrZap.New(),
+				GatewayCtlrName:      gatewayCtlrName.value,
+				GatewayClassName:     gatewayClassName.value,
+				ManifestPaths:        files,
+				OutputDir:            outputDir,
+				Plus:                 plus,
+				ExperimentalFeatures: gwExperimentalFeatures,
+				SnippetsFilters:      snippetsFilters,
+				ErrorPagePolicies:    errorPagePolicies,
+			})
+		},
+	}
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayCtlrNameFlag))
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
+
+	cmd.Flags().StringSliceVarP(
+		&files,
+		fileFlag,
+		"f",
+		nil,
+		"The manifest files of the Gateway API, NGINX Gateway Fabric and Kubernetes resources, such as Services, "+
+			"EndpointSlices and Secrets. A directory includes all .yaml, .yml and .json files in it, recursively. "+
+			"Can be repeated or comma-separated.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	cmd.Flags().StringVarP(
+		&outputDir,
+		outputDirFlag,
+		"o",
+		"",
+		"The directory where the NGINX configuration files are written, with the same paths as in the NGINX "+
+			"container, along with the resources with their statuses in "+render.StatusesFileName+".",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(outputDirFlag))
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Render the configuration for NGINX Plus",
+	)
+
+	cmd.Flags().BoolVar(
+		&gwExperimentalFeatures,
+		gwAPIExperimentalFlag,
+		false,
+		"Enable the experimental features of Gateway API which are supported by NGINX Gateway Fabric. "+
+			"Otherwise, GRPCRoutes and BackendTLSPolicies are ignored.",
+	)
+
+	cmd.Flags().BoolVar(
+		&snippetsFilters,
+		snippetsFiltersFlag,
+		false,
+		"Enable SnippetsFilters feature. Otherwise, SnippetsFilters and the snippet annotations of HTTPRoutes "+
+			"are ignored.",
+	)
+
+	cmd.Flags().BoolVar(
+		&errorPagePolicies,
+		errorPagePoliciesFlag,
+		false,
+		"Enable ErrorPagePolicies feature. Otherwise, ErrorPagePolicies are ignored.",
+	)
+
+	return cmd
+}
+
+func createProductTelemetryCommand() *cobra.Command {
+	// flag names
+	const podFlag = "pod"
+	// flag values
+	pod := namespacedNameValue{}
+
+	cmd := &cobra.Command{
+		Use: "product-telemetry",
+		Short: "Print the product telemetry data that NGINX Gateway Fabric would send, " +
+			"collected from the current state of the cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			podNsName := pod.value
+			if !cmd.Flags().Changed(podFlag) {
+				podNsName = types.NamespacedName{
+					Namespace: os.Getenv("POD_NAMESPACE"),
+					Name:      os.Getenv("POD_NAME"),
+				}
+				if podNsName.Namespace == "" || podNsName.Name == "" {
+					return fmt.Errorf("%s must be set when not running in the NGINX Gateway Fabric Pod", podFlag)
+				}
+			}
+
+			restConfig, err := ctlr.GetConfig()
+			if err != nil {
+				return fmt.Errorf("error getting Kubernetes config: %w", err)
+			}
+
+			k8sClient, err := client.New(restConfig, client.Options{})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			var ngfPod apiv1.Pod
+			if err := k8sClient.Get(cmd.Context(), podNsName, &ngfPod); err != nil {
+				return fmt.Errorf("error getting NGINX Gateway Fabric Pod: %w", err)
+			}
+
+			args, err := getStaticModeArgs(&ngfPod)
+			if err != nil {
+				return err
+			}
+
+			var data telemetry.Data
+
+			// The config of the static mode is built from the arguments and the environment of the NGF Pod,
+			// so that the data is collected the same way as NGF does.
+			staticModeCmd := newStaticModeCommand(
+				getPodEnv(&ngfPod),
+				func(ctx context.Context, conf config.Config) error {
+					data, err = static.CollectProductTelemetry(ctx, restConfig, conf)
+					return err
+				},
+			)
+			staticModeCmd.SetArgs(args)
+			staticModeCmd.SetOut(cmd.ErrOrStderr())
+			staticModeCmd.SetErr(cmd.ErrOrStderr())
+
+			if err := staticModeCmd.ExecuteContext(cmd.Context()); err != nil {
+				return fmt.Errorf("error collecting product telemetry: %w", err)
+			}
+
+			out, err := json.MarshalIndent(data, "", "  ")
+			if err != nil {
+				return fmt.Errorf("error marshaling product telemetry: %w", err)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+
+	cmd.Flags().Var(
+		&pod,
+		podFlag,
+		"The NGINX Gateway Fabric Pod in the format NAMESPACE/NAME. The product telemetry is collected with "+
+			"the arguments of the Pod. Defaults to the Pod from the POD_NAMESPACE and POD_NAME environment "+
+			"variables, which are set when the command runs in the NGINX Gateway Fabric container.",
+	)
+
+	return cmd
+}
+
+// getStaticModeArgs returns the arguments of the static-mode command of the NGF container of the Pod.
+func getStaticModeArgs(pod *apiv1.Pod) ([]string, error) {
+	const staticModeCmd = "static-mode"
+
+	for _, container := range pod.Spec.Containers {
+		args := append(slices.Clone(container.Command), container.Args...)
+
+		if idx := slices.Index(args, staticModeCmd); idx != -1 {
+			return args[idx+1:], nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"the Pod %s/%s has no container that runs the %s command",
+		pod.Namespace,
+		pod.Name,
+		staticModeCmd,
+	)
+}
+
+// getPodEnv returns a function that gets the environment variables of the NGF container of the Pod,
+// which the static mode reads. Other variables, like BUILD_AGENT, are read from the environment of the command.
+func getPodEnv(pod *apiv1.Pod) func(key string) string {
+	env := map[string]string{
+		"POD_IP":        pod.Status.PodIP,
+		"POD_NAMESPACE": pod.Namespace,
+		"POD_NAME":      pod.Name,
+		"NODE_NAME":     pod.Spec.NodeName,
+	}
+
+	return func(key string) string {
+		if value, exists := env[key]; exists {
+			return value
+		}
+		return os.Getenv(key)
+	}
+}
+
 // FIXME(pleshakov): Remove this command once NGF min supported Kubernetes version supports sleep action in
 // preStop hook.
 // nolint:lll
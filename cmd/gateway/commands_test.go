@@ -7,6 +7,8 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -153,6 +155,7 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--metrics-port=9114",
 				"--metrics-disable",
 				"--metrics-secure-serving",
+				"--debug-endpoint",
 				"--health-port=8081",
 				"--health-disable",
 				"--leader-election-lock-name=my-lock",
@@ -160,6 +163,20 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--usage-report-secret=default/my-secret",
 				"--usage-report-server-url=https://my-api.com",
 				"--usage-report-cluster-name=my-cluster",
+				"--snippets-filters",
+				"--error-page-policies",
+				"--event-batch-window=200ms",
+				"--event-batch-max-size=100",
+				"--watch-namespaces=team-a,team-b",
+				"--webhook-enable",
+				"--webhook-port=9444",
+				"--webhook-secret=my-webhook-cert",
+				"--webhook-service=my-webhook",
+				"--webhook-config=my-webhook-config",
+				"--product-telemetry-exporter=otlp-http",
+				"--product-telemetry-endpoint=telemetry.example.com:4318",
+				"--product-telemetry-endpoint-insecure",
+				"--product-telemetry-file=/var/log/nginx-gateway/telemetry.json",
 			},
 			wantErr: false,
 		},
@@ -375,6 +392,117 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "$invalid*(#)" for "--usage-report-cluster-name" flag: invalid format`,
 		},
+		{
+			name: "event-batch-window is invalid",
+			args: []string{
+				"--event-batch-window=invalid",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "invalid" for "--event-batch-window" flag: time: invalid duration`,
+		},
+		{
+			name: "event-batch-max-size is negative",
+			args: []string{
+				"--event-batch-max-size=-1",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-1" for "--event-batch-max-size" flag: must not be negative: -1`,
+		},
+		{
+			name: "watch-namespaces is a label selector",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--watch-namespaces=team in (a,b)",
+			},
+			wantErr: false,
+		},
+		{
+			name: "watch-namespaces is set to empty string",
+			args: []string{
+				"--watch-namespaces=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--watch-namespaces" flag: must be set`,
+		},
+		{
+			name: "watch-namespaces is invalid",
+			args: []string{
+				"--watch-namespaces=team-a,Team_B",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "team-a,Team_B" for "--watch-namespaces" flag: ` +
+				`invalid namespace name "Team_B"`,
+		},
+		{
+			name: "debug-endpoint is not a bool",
+			args: []string{
+				"--debug-endpoint=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-endpoint" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+		{
+			name: "webhook-enable is not a bool",
+			args: []string{
+				"--webhook-enable=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--webhook-enable" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+		{
+			name: "webhook-port is outside of range",
+			args: []string{
+				"--webhook-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--webhook-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "webhook-secret is set to invalid string",
+			args: []string{
+				"--webhook-secret=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--webhook-secret" flag: invalid format`,
+		},
+		{
+			name: "webhook-service is set to invalid string",
+			args: []string{
+				"--webhook-service=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--webhook-service" flag: invalid format`,
+		},
+		{
+			name: "webhook-config is set to invalid string",
+			args: []string{
+				"--webhook-config=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--webhook-config" flag: invalid format`,
+		},
+		{
+			name: "product-telemetry-exporter is invalid",
+			args: []string{
+				"--product-telemetry-exporter=otlp",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "otlp" for "--product-telemetry-exporter" flag: ` +
+				`unsupported exporter "otlp"`,
+		},
+		{
+			name: "product-telemetry-endpoint is invalid",
+			args: []string{
+				"--product-telemetry-endpoint=telemetry.example.com",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "telemetry.example.com" for "--product-telemetry-endpoint" flag: ` +
+				`"telemetry.example.com" must be in the format <host>:<port>`,
+		},
 	}
 
 	// common flags validation is tested separately
@@ -388,18 +516,236 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 }
 
 func TestProvisionerModeCmdFlagValidation(t *testing.T) {
-	testCase := flagTestCase{
-		name: "valid flags",
-		args: []string{
-			"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
-			"--gatewayclass=nginx",                                // common and required flag
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--hpa-max-replicas=5",
+				"--hpa-target-cpu-utilization=50",
+			},
+			wantErr: false,
+		},
+		{
+			name: "hpa-max-replicas is negative",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--hpa-max-replicas=-1",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-1" for "--hpa-max-replicas" flag: must not be negative`,
+		},
+		{
+			name: "hpa-target-cpu-utilization is out of range",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--hpa-target-cpu-utilization=0",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "0" for "--hpa-target-cpu-utilization" flag: ` +
+				`percentage outside of valid range`,
 		},
-		wantErr: false,
 	}
 
 	// common flags validation is tested separately
 
-	testFlag(t, createProvisionerModeCommand(), testCase)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testFlag(t, createProvisionerModeCommand(), test)
+		})
+	}
+}
+
+func TestRenderCmdFlagValidation(t *testing.T) {
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--file=gateway.yaml,routes.yaml",
+				"-f=manifests",
+				"--output-dir=out",
+				"--nginx-plus",
+				"--gateway-api-experimental-features",
+				"--snippets-filters",
+				"--error-page-policies",
+			},
+			wantErr: false,
+		},
+		{
+			name: "file is not set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--output-dir=out",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "file" not set`,
+		},
+		{
+			name: "output-dir is not set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--file=gateway.yaml",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "output-dir" not set`,
+		},
+		{
+			name: "gatewayclass is invalid",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=@",
+				"--file=gateway.yaml",
+				"--output-dir=out",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "@" for "--gatewayclass" flag: invalid format`,
+		},
+		{
+			name: "nginx-plus is not a bool",
+			args: []string{
+				"--nginx-plus=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--nginx-plus" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testFlag(t, createRenderCommand(), test)
+		})
+	}
+}
+
+func TestProductTelemetryCmdFlagValidation(t *testing.T) {
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--pod=nginx-gateway/nginx-gateway-5d4f4c7db7-xk2wq",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "valid flags, non-required not set",
+			args:    []string{},
+			wantErr: false,
+		},
+		{
+			name: "pod is invalid",
+			args: []string{
+				"--pod=nginx-gateway-5d4f4c7db7-xk2wq", // no namespace
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "nginx-gateway-5d4f4c7db7-xk2wq" for "--pod" flag: invalid format; ` +
+				"must be NAMESPACE/NAME",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testFlag(t, createProductTelemetryCommand(), test)
+		})
+	}
+}
+
+func TestGetStaticModeArgs(t *testing.T) {
+	createPod := func(containers ...apiv1.Container) *apiv1.Pod {
+		return &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "nginx-gateway",
+				Name:      "nginx-gateway",
+			},
+			Spec: apiv1.PodSpec{
+				Containers: containers,
+			},
+		}
+	}
+
+	nginxContainer := apiv1.Container{Name: "nginx"}
+
+	tests := []struct {
+		pod     *apiv1.Pod
+		name    string
+		expArgs []string
+		expErr  bool
+	}{
+		{
+			name: "static-mode in args",
+			pod: createPod(
+				nginxContainer,
+				apiv1.Container{
+					Name: "nginx-gateway",
+					Args: []string{"static-mode", "--gateway-ctlr-name=gateway.nginx.org/nginx-gateway"},
+				},
+			),
+			expArgs: []string{"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway"},
+		},
+		{
+			name: "static-mode in command",
+			pod: createPod(
+				apiv1.Container{
+					Name:    "nginx-gateway",
+					Command: []string{"/usr/bin/gateway", "static-mode"},
+					Args:    []string{"--gatewayclass=nginx"},
+				},
+			),
+			expArgs: []string{"--gatewayclass=nginx"},
+		},
+		{
+			name:   "no static-mode container",
+			pod:    createPod(nginxContainer),
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			args, err := getStaticModeArgs(test.pod)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(args).To(Equal(test.expArgs))
+		})
+	}
+}
+
+func TestGetPodEnv(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Setenv("BUILD_AGENT", "gha")
+	t.Setenv("POD_NAME", "local")
+
+	getenv := getPodEnv(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "nginx-gateway",
+			Name:      "nginx-gateway",
+		},
+		Spec: apiv1.PodSpec{
+			NodeName: "node",
+		},
+		Status: apiv1.PodStatus{
+			PodIP: "10.0.0.1",
+		},
+	})
+
+	g.Expect(getenv("POD_IP")).To(Equal("10.0.0.1"))
+	g.Expect(getenv("POD_NAMESPACE")).To(Equal("nginx-gateway"))
+	g.Expect(getenv("POD_NAME")).To(Equal("nginx-gateway"))
+	g.Expect(getenv("NODE_NAME")).To(Equal("node"))
+	g.Expect(getenv("BUILD_AGENT")).To(Equal("gha"))
 }
 
 /*
@@ -25,6 +25,8 @@ func main() {
 	rootCmd.AddCommand(
 		createStaticModeCommand(),
 		createProvisionerModeCommand(),
+		createRenderCommand(),
+		createProductTelemetryCommand(),
 		createSleepCommand(),
 	)
 
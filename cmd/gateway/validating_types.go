@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -84,3 +85,32 @@ func (v *namespacedNameValue) Set(param string) error {
 func (v *namespacedNameValue) Type() string {
 	return "string"
 }
+
+// watchNamespacesValue is a string flag value that represents either a list of Namespace names or
+// a label selector of Namespaces.
+// it implements the pflag.Value interface.
+type watchNamespacesValue struct {
+	selector labels.Selector
+	value    string
+	names    []string
+}
+
+func (v *watchNamespacesValue) String() string {
+	return v.value
+}
+
+func (v *watchNamespacesValue) Set(param string) error {
+	names, selector, err := parseWatchNamespaces(param)
+	if err != nil {
+		return err
+	}
+
+	v.value = param
+	v.names = names
+	v.selector = selector
+	return nil
+}
+
+func (v *watchNamespacesValue) Type() string {
+	return "string"
+}
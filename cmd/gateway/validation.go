@@ -9,8 +9,11 @@ import (
 	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 )
 
 const (
@@ -91,6 +94,32 @@ func parseNamespacedResourceName(value string) (types.NamespacedName, error) {
 	}, nil
 }
 
+// parseWatchNamespaces parses either a comma-separated list of Namespace names or a label selector of Namespaces.
+// A value that contains any of the characters "=", "!" or "(" is parsed as a label selector.
+func parseWatchNamespaces(value string) ([]string, labels.Selector, error) {
+	if value == "" {
+		return nil, nil, errors.New("must be set")
+	}
+
+	if strings.ContainsAny(value, "=!(") {
+		selector, err := labels.Parse(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+
+		return nil, selector, nil
+	}
+
+	names := strings.Split(value, ",")
+	for _, name := range names {
+		if err := validateNamespaceName(name); err != nil {
+			return nil, nil, fmt.Errorf("invalid namespace name %q: %w", name, err)
+		}
+	}
+
+	return names, nil, nil
+}
+
 func validateQualifiedName(name string) error {
 	if len(name) == 0 {
 		return errors.New("must be set")
@@ -171,6 +200,22 @@ func validatePort(port int) error {
 	return nil
 }
 
+// validateNonNegative makes sure a given value is not negative
+func validateNonNegative(value int) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative: %v", value)
+	}
+	return nil
+}
+
+// validatePercentage makes sure a given value is a percentage in the range [1 - 100]
+func validatePercentage(value int) error {
+	if value < 1 || value > 100 {
+		return fmt.Errorf("percentage outside of valid range [1 - 100]: %v", value)
+	}
+	return nil
+}
+
 // ensureNoPortCollisions checks if the same port has been defined multiple times
 func ensureNoPortCollisions(ports ...int) error {
 	seen := make(map[int]struct{})
@@ -184,3 +229,23 @@ func ensureNoPortCollisions(ports ...int) error {
 
 	return nil
 }
+
+// validateProductTelemetryExporter makes sure a given value is a supported product telemetry exporter.
+func validateProductTelemetryExporter(value string) error {
+	switch config.ProductTelemetryExporter(value) {
+	case config.ProductTelemetryExporterOTLPGRPC,
+		config.ProductTelemetryExporterOTLPHTTP,
+		config.ProductTelemetryExporterFile,
+		config.ProductTelemetryExporterLogging:
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported exporter %q, must be one of: %s, %s, %s, %s",
+			value,
+			config.ProductTelemetryExporterOTLPGRPC,
+			config.ProductTelemetryExporterOTLPHTTP,
+			config.ProductTelemetryExporterFile,
+			config.ProductTelemetryExporterLogging,
+		)
+	}
+}
@@ -255,6 +255,78 @@ func TestParseNamespacedResourceName(t *testing.T) {
 	}
 }
 
+func TestParseWatchNamespaces(t *testing.T) {
+	tests := []struct {
+		name              string
+		value             string
+		expectedErrPrefix string
+		expectedSelector  string
+		expectedNames     []string
+		expectErr         bool
+	}{
+		{
+			name:          "single name",
+			value:         "team-a",
+			expectedNames: []string{"team-a"},
+		},
+		{
+			name:          "multiple names",
+			value:         "team-a,team-b",
+			expectedNames: []string{"team-a", "team-b"},
+		},
+		{
+			name:             "equality selector",
+			value:            "team=a",
+			expectedSelector: "team=a",
+		},
+		{
+			name:             "set-based selector",
+			value:            "team in (a,b),!restricted",
+			expectedSelector: "!restricted,team in (a,b)",
+		},
+		{
+			name:              "empty",
+			value:             "",
+			expectErr:         true,
+			expectedErrPrefix: "must be set",
+		},
+		{
+			name:              "invalid name",
+			value:             "team-a,",
+			expectErr:         true,
+			expectedErrPrefix: `invalid namespace name ""`,
+		},
+		{
+			name:              "invalid selector key",
+			value:             "te@m=a",
+			expectErr:         true,
+			expectedErrPrefix: "invalid label selector",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			names, selector, err := parseWatchNamespaces(test.value)
+
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(HavePrefix(test.expectedErrPrefix))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(names).To(Equal(test.expectedNames))
+			if test.expectedSelector == "" {
+				g.Expect(selector).To(BeNil())
+			} else {
+				g.Expect(selector.String()).To(Equal(test.expectedSelector))
+			}
+		})
+	}
+}
+
 func TestValidateQualifiedName(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -529,3 +601,31 @@ func TestEnsureNoPortCollisions(t *testing.T) {
 	g.Expect(ensureNoPortCollisions(9113, 8081)).To(Succeed())
 	g.Expect(ensureNoPortCollisions(9113, 9113)).ToNot(Succeed())
 }
+
+func TestValidateNonNegative(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(validateNonNegative(0)).To(Succeed())
+	g.Expect(validateNonNegative(100)).To(Succeed())
+	g.Expect(validateNonNegative(-1)).ToNot(Succeed())
+}
+
+func TestValidatePercentage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(validatePercentage(1)).To(Succeed())
+	g.Expect(validatePercentage(100)).To(Succeed())
+	g.Expect(validatePercentage(0)).ToNot(Succeed())
+	g.Expect(validatePercentage(101)).ToNot(Succeed())
+}
+
+func TestValidateProductTelemetryExporter(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(validateProductTelemetryExporter("otlp-grpc")).To(Succeed())
+	g.Expect(validateProductTelemetryExporter("otlp-http")).To(Succeed())
+	g.Expect(validateProductTelemetryExporter("file")).To(Succeed())
+	g.Expect(validateProductTelemetryExporter("logging")).To(Succeed())
+	g.Expect(validateProductTelemetryExporter("")).ToNot(Succeed())
+	g.Expect(validateProductTelemetryExporter("otlp")).ToNot(Succeed())
+}
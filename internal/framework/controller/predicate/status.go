@@ -0,0 +1,66 @@
+package predicate
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// StatusChangedPredicate implements a predicate function based on the Status field of a resource.
+//
+// This predicate will skip the following events:
+// 1. Create, Delete and Generic events.
+// 2. Update events where the Status has not changed.
+type StatusChangedPredicate struct{}
+
+// Create skips all CreateEvents.
+func (StatusChangedPredicate) Create(event.CreateEvent) bool {
+	return false
+}
+
+// Delete skips all DeleteEvents.
+func (StatusChangedPredicate) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic skips all GenericEvents.
+func (StatusChangedPredicate) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// Update filters UpdateEvents based on the Status.
+func (StatusChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		// this case should not happen
+		return false
+	}
+
+	oldStatus, ok := getStatus(e.ObjectOld)
+	if !ok {
+		return false
+	}
+
+	newStatus, ok := getStatus(e.ObjectNew)
+	if !ok {
+		return false
+	}
+
+	return !equality.Semantic.DeepEqual(oldStatus, newStatus)
+}
+
+// getStatus returns the Status field of the object if it has one.
+func getStatus(obj client.Object) (interface{}, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	status := v.Elem().FieldByName("Status")
+	if !status.IsValid() {
+		return nil, false
+	}
+
+	return status.Interface(), true
+}
@@ -0,0 +1,117 @@
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestStatusChangedPredicate_Update(t *testing.T) {
+	createGC := func(generation int64, condType string) *v1.GatewayClass {
+		gc := &v1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "gc",
+				Generation: generation,
+			},
+		}
+
+		if condType != "" {
+			gc.Status.Conditions = []metav1.Condition{
+				{
+					Type:   condType,
+					Status: metav1.ConditionTrue,
+				},
+			}
+		}
+
+		return gc
+	}
+
+	tests := []struct {
+		event     event.UpdateEvent
+		name      string
+		expUpdate bool
+	}{
+		{
+			name: "status changed",
+			event: event.UpdateEvent{
+				ObjectOld: createGC(1, "Accepted"),
+				ObjectNew: createGC(1, "Other"),
+			},
+			expUpdate: true,
+		},
+		{
+			name: "status cleared",
+			event: event.UpdateEvent{
+				ObjectOld: createGC(1, "Accepted"),
+				ObjectNew: createGC(1, ""),
+			},
+			expUpdate: true,
+		},
+		{
+			name: "status has not changed",
+			event: event.UpdateEvent{
+				ObjectOld: createGC(1, "Accepted"),
+				ObjectNew: createGC(2, "Accepted"),
+			},
+			expUpdate: false,
+		},
+		{
+			name: "object has no status",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{},
+				ObjectNew: &metav1.PartialObjectMetadata{},
+			},
+			expUpdate: false,
+		},
+		{
+			name: "objects of different types",
+			event: event.UpdateEvent{
+				ObjectOld: createGC(1, "Accepted"),
+				ObjectNew: &apiext.CustomResourceDefinition{},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "old object is nil",
+			event: event.UpdateEvent{
+				ObjectOld: nil,
+				ObjectNew: createGC(1, "Accepted"),
+			},
+			expUpdate: false,
+		},
+		{
+			name: "new object is nil",
+			event: event.UpdateEvent{
+				ObjectOld: createGC(1, "Accepted"),
+				ObjectNew: nil,
+			},
+			expUpdate: false,
+		},
+	}
+
+	p := StatusChangedPredicate{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			update := p.Update(test.event)
+			g.Expect(update).To(Equal(test.expUpdate))
+		})
+	}
+}
+
+func TestStatusChangedPredicate(t *testing.T) {
+	g := NewWithT(t)
+
+	p := StatusChangedPredicate{}
+	gc := &v1.GatewayClass{}
+
+	g.Expect(p.Create(event.CreateEvent{Object: gc})).To(BeFalse())
+	g.Expect(p.Delete(event.DeleteEvent{Object: gc})).To(BeFalse())
+	g.Expect(p.Generic(event.GenericEvent{Object: gc})).To(BeFalse())
+}
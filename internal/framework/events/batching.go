@@ -0,0 +1,61 @@
+package events
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// maxBatchWaitWindows is the maximum number of batching windows the EventLoop waits after the first event of a batch
+// before it handles the batch, even if new events keep restarting the window.
+const maxBatchWaitWindows = 10
+
+// BatchingConfig configures how the EventLoop batches events.
+type BatchingConfig struct {
+	// Window is how long the EventLoop waits for another event before it handles the saved events as one batch.
+	// Every new event restarts the window, but the EventLoop doesn't wait longer than 10 windows after the first
+	// event of the batch. Zero means the EventLoop doesn't wait.
+	Window time.Duration
+	// MaxSize is the number of events at which the EventLoop stops waiting for the window and handles the batch.
+	// Zero means there is no maximum.
+	MaxSize int
+}
+
+// AtomicBatchingConfig is a BatchingConfig that can be safely changed while the EventLoop runs.
+type AtomicBatchingConfig struct {
+	cfg atomic.Pointer[BatchingConfig]
+}
+
+// NewAtomicBatchingConfig creates a new AtomicBatchingConfig with the given BatchingConfig.
+func NewAtomicBatchingConfig(cfg BatchingConfig) *AtomicBatchingConfig {
+	c := &AtomicBatchingConfig{}
+	c.Store(cfg)
+
+	return c
+}
+
+// Load returns the BatchingConfig.
+func (c *AtomicBatchingConfig) Load() BatchingConfig {
+	return *c.cfg.Load()
+}
+
+// Store replaces the BatchingConfig. The EventLoop uses the new BatchingConfig starting with the next event.
+func (c *AtomicBatchingConfig) Store(cfg BatchingConfig) {
+	c.cfg.Store(&cfg)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . MetricsCollector
+
+// MetricsCollector collects metrics of the batches of the EventLoop.
+type MetricsCollector interface {
+	// ObserveEventBatchSize observes the number of events in a batch.
+	ObserveEventBatchSize(size int)
+	// ObserveEventBatchWaitTime observes the time between the first event of a batch and the start of its handling.
+	ObserveEventBatchWaitTime(duration time.Duration)
+}
+
+// noopMetricsCollector is used when the EventLoop doesn't collect metrics.
+type noopMetricsCollector struct{}
+
+func (noopMetricsCollector) ObserveEventBatchSize(_ int) {}
+
+func (noopMetricsCollector) ObserveEventBatchWaitTime(_ time.Duration) {}
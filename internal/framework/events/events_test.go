@@ -9,7 +9,7 @@ import (
 
 func TestEventLoop_SwapBatches(t *testing.T) {
 	g := NewWithT(t)
-	eventLoop := NewEventLoop(nil, zap.New(), nil, nil)
+	eventLoop := NewEventLoop(nil, zap.New(), nil, nil, nil, nil)
 
 	eventLoop.currentBatch = EventBatch{
 		"event0",
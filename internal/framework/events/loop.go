@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 )
@@ -22,11 +23,16 @@ import (
 // FIXME(pleshakov): better document the side effects and how to prevent and mitigate them.
 // So when the EventLoop have 100 saved events, it is better to process them at once rather than one by one.
 // https://github.com/nginxinc/nginx-gateway-fabric/issues/551
+//
+// To batch events that come in quick succession, like when many resources are applied at once, the EventLoop can
+// also wait for more events before it handles the saved events, as configured by the BatchingConfig.
 type EventLoop struct {
-	handler  EventHandler
-	preparer FirstEventBatchPreparer
-	eventCh  <-chan interface{}
-	logger   logr.Logger
+	handler          EventHandler
+	preparer         FirstEventBatchPreparer
+	batching         *AtomicBatchingConfig
+	metricsCollector MetricsCollector
+	eventCh          <-chan interface{}
+	logger           logr.Logger
 
 	// The EventLoop uses double buffering to handle event batch processing.
 	// The goroutine that handles the batch will always read from the currentBatch slice.
@@ -40,19 +46,33 @@ type EventLoop struct {
 }
 
 // NewEventLoop creates a new EventLoop.
+// If batching is nil, the EventLoop doesn't wait for more events. If metricsCollector is nil, the EventLoop doesn't
+// collect metrics.
 func NewEventLoop(
 	eventCh <-chan interface{},
 	logger logr.Logger,
 	handler EventHandler,
 	preparer FirstEventBatchPreparer,
+	batching *AtomicBatchingConfig,
+	metricsCollector MetricsCollector,
 ) *EventLoop {
+	if batching == nil {
+		batching = NewAtomicBatchingConfig(BatchingConfig{})
+	}
+
+	if metricsCollector == nil {
+		metricsCollector = noopMetricsCollector{}
+	}
+
 	return &EventLoop{
-		eventCh:      eventCh,
-		logger:       logger,
-		handler:      handler,
-		preparer:     preparer,
-		currentBatch: make(EventBatch, 0),
-		nextBatch:    make(EventBatch, 0),
+		eventCh:          eventCh,
+		logger:           logger,
+		handler:          handler,
+		preparer:         preparer,
+		batching:         batching,
+		metricsCollector: metricsCollector,
+		currentBatch:     make(EventBatch, 0),
+		nextBatch:        make(EventBatch, 0),
 	}
 }
 
@@ -64,6 +84,33 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	// handlingDone is used to signal the completion of handling a batch.
 	handlingDone := make(chan struct{})
 
+	// nextBatchReady tells if the next batch can be handled as soon as no batch is being handled.
+	var nextBatchReady bool
+	// nextBatchStart is the time when the first event of the next batch was received.
+	var nextBatchStart time.Time
+	// batchTimer makes the next batch ready when the batching window ends. It is nil if no window is running.
+	var batchTimer *time.Timer
+	// batchTimerCh is the channel of the batchTimer. It is nil if no window is running, so that it blocks.
+	var batchTimerCh <-chan time.Time
+
+	stopBatchTimer := func() {
+		if batchTimer != nil {
+			batchTimer.Stop()
+			batchTimer = nil
+			batchTimerCh = nil
+		}
+	}
+
+	startBatchTimer := func(window time.Duration) {
+		stopBatchTimer()
+
+		// Don't wait longer than the maximum wait time after the first event of the batch.
+		wait := min(window, time.Until(nextBatchStart.Add(maxBatchWaitWindows*window)))
+
+		batchTimer = time.NewTimer(wait)
+		batchTimerCh = batchTimer.C
+	}
+
 	handleBatch := func() {
 		go func(batch EventBatch) {
 			el.currentBatchID++
@@ -79,6 +126,12 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	}
 
 	swapAndHandleBatch := func() {
+		el.metricsCollector.ObserveEventBatchSize(len(el.nextBatch))
+		el.metricsCollector.ObserveEventBatchWaitTime(time.Since(nextBatchStart))
+
+		stopBatchTimer()
+		nextBatchReady = false
+
 		el.swapBatches()
 		handleBatch()
 		handling = true
@@ -111,13 +164,19 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	for {
 		select {
 		case <-ctx.Done():
+			stopBatchTimer()
+
 			// Wait for the completion if a batch is being handled.
 			if handling {
 				<-handlingDone
 			}
 			return nil
 		case e := <-el.eventCh:
-			// Add the event to the current batch.
+			if len(el.nextBatch) == 0 {
+				nextBatchStart = time.Now()
+			}
+
+			// Add the event to the next batch.
 			el.nextBatch = append(el.nextBatch, e)
 
 			el.logger.Info(
@@ -126,6 +185,25 @@ func (el *EventLoop) Start(ctx context.Context) error {
 				"total", len(el.nextBatch),
 			)
 
+			batching := el.batching.Load()
+
+			// Wait for more events, unless waiting is disabled or the batch is full.
+			if batching.Window <= 0 || (batching.MaxSize > 0 && len(el.nextBatch) >= batching.MaxSize) {
+				stopBatchTimer()
+				nextBatchReady = true
+			} else if !nextBatchReady {
+				startBatchTimer(batching.Window)
+			}
+
+			// If no batch is currently being handled, swap batches and begin handling the batch.
+			if !handling && nextBatchReady {
+				swapAndHandleBatch()
+			}
+		case <-batchTimerCh:
+			batchTimer = nil
+			batchTimerCh = nil
+			nextBatchReady = true
+
 			// If no batch is currently being handled, swap batches and begin handling the batch.
 			if !handling {
 				swapAndHandleBatch()
@@ -133,8 +211,8 @@ func (el *EventLoop) Start(ctx context.Context) error {
 		case <-handlingDone:
 			handling = false
 
-			// If there's at least one event in the next batch, swap batches and begin handling the batch.
-			if len(el.nextBatch) > 0 {
+			// If the next batch is ready, swap batches and begin handling the batch.
+			if nextBatchReady {
 				swapAndHandleBatch()
 			}
 		}
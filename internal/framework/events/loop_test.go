@@ -3,6 +3,7 @@ package events_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -18,6 +19,8 @@ var _ = Describe("EventLoop", func() {
 		fakeHandler  *eventsfakes.FakeEventHandler
 		eventCh      chan interface{}
 		fakePreparer *eventsfakes.FakeFirstEventBatchPreparer
+		fakeMetrics  *eventsfakes.FakeMetricsCollector
+		batching     *events.AtomicBatchingConfig
 		eventLoop    *events.EventLoop
 		ctx          context.Context
 		cancel       context.CancelFunc
@@ -28,8 +31,10 @@ var _ = Describe("EventLoop", func() {
 		fakeHandler = &eventsfakes.FakeEventHandler{}
 		eventCh = make(chan interface{})
 		fakePreparer = &eventsfakes.FakeFirstEventBatchPreparer{}
+		fakeMetrics = &eventsfakes.FakeMetricsCollector{}
+		batching = events.NewAtomicBatchingConfig(events.BatchingConfig{})
 
-		eventLoop = events.NewEventLoop(eventCh, zap.New(), fakeHandler, fakePreparer)
+		eventLoop = events.NewEventLoop(eventCh, zap.New(), fakeHandler, fakePreparer, batching, fakeMetrics)
 
 		ctx, cancel = context.WithCancel(context.Background())
 		errorCh = make(chan error)
@@ -75,6 +80,10 @@ var _ = Describe("EventLoop", func() {
 
 			var expectedBatch events.EventBatch = []interface{}{e}
 			Expect(batch).Should(Equal(expectedBatch))
+
+			Expect(fakeMetrics.ObserveEventBatchSizeCallCount()).To(Equal(1))
+			Expect(fakeMetrics.ObserveEventBatchSizeArgsForCall(0)).To(Equal(1))
+			Expect(fakeMetrics.ObserveEventBatchWaitTimeCallCount()).To(Equal(1))
 		})
 
 		It("should batch multiple events", func() {
@@ -120,6 +129,77 @@ var _ = Describe("EventLoop", func() {
 			// the second HandleEventBatch() call must have handled a batch with e2 and e3
 			Expect(batch).Should(Equal(expectedBatch))
 		})
+
+		It("should wait for the batching window before handling events", func() {
+			batching.Store(events.BatchingConfig{Window: 200 * time.Millisecond})
+
+			e1 := "event1"
+			e2 := "event2"
+
+			eventCh <- e1
+			eventCh <- e2
+
+			Consistently(fakeHandler.HandleEventBatchCallCount, 100*time.Millisecond).Should(Equal(1))
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(2))
+
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+
+			var expectedBatch events.EventBatch = []interface{}{e1, e2}
+			Expect(batch).Should(Equal(expectedBatch))
+
+			Expect(fakeMetrics.ObserveEventBatchSizeArgsForCall(0)).To(Equal(2))
+			Expect(fakeMetrics.ObserveEventBatchWaitTimeArgsForCall(0)).To(BeNumerically(">=", 200*time.Millisecond))
+		})
+
+		It("should not wait for the batching window when the batch is full", func() {
+			batching.Store(events.BatchingConfig{Window: time.Hour, MaxSize: 2})
+
+			e1 := "event1"
+			e2 := "event2"
+
+			eventCh <- e1
+			eventCh <- e2
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(2))
+
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+
+			var expectedBatch events.EventBatch = []interface{}{e1, e2}
+			Expect(batch).Should(Equal(expectedBatch))
+		})
+
+		It("should not wait longer than 10 batching windows when events keep coming", func() {
+			batching.Store(events.BatchingConfig{Window: 50 * time.Millisecond})
+
+			stop := make(chan struct{})
+			stopped := make(chan struct{})
+
+			go func() {
+				defer close(stopped)
+
+				ticker := time.NewTicker(10 * time.Millisecond)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-stop:
+						return
+					case <-ticker.C:
+						select {
+						case eventCh <- "event":
+						case <-stop:
+							return
+						}
+					}
+				}
+			}()
+
+			// the events keep restarting the window, so only the maximum wait time makes the loop handle the batch
+			Eventually(fakeHandler.HandleEventBatchCallCount, 2*time.Second).Should(BeNumerically(">=", 2))
+
+			close(stop)
+			<-stopped
+		})
 	})
 
 	Describe("Edge cases", func() {
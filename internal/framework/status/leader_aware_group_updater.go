@@ -3,7 +3,11 @@ package status
 import (
 	"context"
 	"errors"
+	"reflect"
 	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // GroupUpdater updates statuses of groups of resources.
@@ -14,12 +18,16 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . GroupUpdater
 type GroupUpdater interface {
 	UpdateGroup(ctx context.Context, name string, reqs ...UpdateRequest)
+	// Enabled returns true if the requests are written, rather than only saved.
+	Enabled() bool
 }
 
 // LeaderAwareGroupUpdater updates statuses of groups of resources.
 // Before it is enabled, it saves all requests.
 // When it is enabled, it updates status using the saved requests. Note: it can only be enabled once.
-// After it is enabled, it will not save requests anymore and update statuses immediately.
+// After it is enabled, it updates statuses immediately.
+// In both cases, it keeps the latest requests of every group, so that it can restore the status of a resource
+// if another actor changes it.
 type LeaderAwareGroupUpdater struct {
 	updater   *Updater
 	lock      *sync.Mutex
@@ -41,13 +49,13 @@ func (u *LeaderAwareGroupUpdater) UpdateGroup(ctx context.Context, name string,
 	u.lock.Lock()
 	defer u.lock.Unlock()
 
-	if !u.enabled {
-		if len(reqs) == 0 {
-			delete(u.groupReqs, name)
-			return
-		}
-
+	if len(reqs) == 0 {
+		delete(u.groupReqs, name)
+	} else {
 		u.groupReqs[name] = reqs
+	}
+
+	if !u.enabled {
 		return
 	}
 
@@ -65,8 +73,42 @@ func (u *LeaderAwareGroupUpdater) Enable(ctx context.Context) {
 
 	u.enabled = true
 
-	for name, reqs := range u.groupReqs {
+	for _, reqs := range u.groupReqs {
 		u.updater.Update(ctx, reqs...)
-		delete(u.groupReqs, name)
+	}
+}
+
+// Enabled returns true if the LeaderAwareGroupUpdater is enabled. Once it returns true, the saved requests were
+// written, and the later requests are written immediately.
+func (u *LeaderAwareGroupUpdater) Enabled() bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	return u.enabled
+}
+
+// Restore restores the status of a resource using the latest requests for it from all groups.
+// The status is only updated if it differs from the one the requests set.
+// Before the LeaderAwareGroupUpdater is enabled, Restore does nothing.
+func (u *LeaderAwareGroupUpdater) Restore(
+	ctx context.Context,
+	resourceType client.Object,
+	nsname types.NamespacedName,
+) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if !u.enabled {
+		return
+	}
+
+	t := reflect.TypeOf(resourceType)
+
+	for _, reqs := range u.groupReqs {
+		for _, r := range reqs {
+			if r.NsName == nsname && reflect.TypeOf(r.ResourceType) == t {
+				u.updater.Update(ctx, r)
+			}
+		}
 	}
 }
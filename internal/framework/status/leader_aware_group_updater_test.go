@@ -109,6 +109,16 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 				testNoStatuses(allGCNames)
 			})
 
+			It("should not be enabled", func() {
+				Expect(updater.Enabled()).To(BeFalse())
+			})
+
+			It("should not restore statuses", func() {
+				updater.Restore(context.Background(), &v1.GatewayClass{}, types.NamespacedName{Name: group1GCNames[0]})
+
+				testNoStatuses(allGCNames)
+			})
+
 			When("passing no update requests", func() {
 				It("should clear saved requests of group2", func() {
 					updater.UpdateGroup(context.Background(), group2)
@@ -122,6 +132,7 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 
 				testStatuses(group1GCNames, "TestAllSaveForLater")
 				testNoStatuses(group2GCNames)
+				Expect(updater.Enabled()).To(BeTrue())
 			})
 
 			When("passing no update requests", func() {
@@ -140,6 +151,38 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 
 				testStatuses(allGCNames, "TestAll")
 			})
+
+			It("should restore the status of a resource changed by another actor", func() {
+				nsname := types.NamespacedName{Name: group2GCNames[0]}
+
+				var gc v1.GatewayClass
+				Expect(k8sClient.Get(context.Background(), nsname, &gc)).To(Succeed())
+
+				gc.Status = v1.GatewayClassStatus{}
+				Expect(k8sClient.Status().Update(context.Background(), &gc)).To(Succeed())
+
+				updater.Restore(context.Background(), &v1.GatewayClass{}, nsname)
+
+				testStatuses(allGCNames, "TestAll")
+			})
+
+			It("should not restore the status of a resource of another type", func() {
+				nsname := types.NamespacedName{Name: group2GCNames[0]}
+
+				var gc v1.GatewayClass
+				Expect(k8sClient.Get(context.Background(), nsname, &gc)).To(Succeed())
+
+				gc.Status = v1.GatewayClassStatus{}
+				Expect(k8sClient.Status().Update(context.Background(), &gc)).To(Succeed())
+
+				updater.Restore(context.Background(), &v1.Gateway{}, nsname)
+
+				testNoStatuses([]string{group2GCNames[0]})
+
+				updater.Restore(context.Background(), &v1.GatewayClass{}, nsname)
+
+				testStatuses(allGCNames, "TestAll")
+			})
 		})
 
 		When("updater is enabled second time", func() {
@@ -0,0 +1,73 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/predicate"
+)
+
+// Restorer restores the status of a resource.
+type Restorer interface {
+	Restore(ctx context.Context, resourceType client.Object, nsname types.NamespacedName)
+}
+
+// RestoreReconciler restores the statuses of resources of a specific type when they change.
+// This way, if another actor removes or changes the status written by the Gateway, the status is restored without
+// waiting for the next change of the resources the Gateway handles.
+// It implements the reconcile.Reconciler interface.
+type RestoreReconciler struct {
+	restorer     Restorer
+	resourceType client.Object
+}
+
+var _ reconcile.Reconciler = &RestoreReconciler{}
+
+// NewRestoreReconciler creates a new RestoreReconciler.
+func NewRestoreReconciler(restorer Restorer, resourceType client.Object) *RestoreReconciler {
+	return &RestoreReconciler{
+		restorer:     restorer,
+		resourceType: resourceType,
+	}
+}
+
+// Reconcile implements the reconcile.Reconciler Reconcile method.
+func (r *RestoreReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log.FromContext(ctx).V(1).Info("Restoring the status of the resource")
+
+	r.restorer.Restore(ctx, r.resourceType, req.NamespacedName)
+
+	return reconcile.Result{}, nil
+}
+
+// RegisterRestoreController registers a new controller in the manager that restores the statuses of the resources
+// of the object type when their statuses change.
+func RegisterRestoreController(mgr manager.Manager, restorer Restorer, objectType client.Object) error {
+	gvk, err := apiutil.GVKForObject(objectType, mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("cannot get GroupVersionKind for %T: %w", objectType, err)
+	}
+
+	// The name must be different from the name of the controller that sends the events of the resources.
+	name := strings.ToLower(gvk.Kind) + "-status"
+
+	err = ctlr.NewControllerManagedBy(mgr).
+		Named(name).
+		For(objectType).
+		WithEventFilter(predicate.StatusChangedPredicate{}).
+		Complete(NewRestoreReconciler(restorer, objectType))
+	if err != nil {
+		return fmt.Errorf("cannot build a status restore controller for %T: %w", objectType, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package status
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type restoreCall struct {
+	resourceType client.Object
+	nsname       types.NamespacedName
+}
+
+type fakeRestorer struct {
+	calls []restoreCall
+}
+
+func (f *fakeRestorer) Restore(_ context.Context, resourceType client.Object, nsname types.NamespacedName) {
+	f.calls = append(f.calls, restoreCall{resourceType: resourceType, nsname: nsname})
+}
+
+var _ = Describe("RestoreReconciler", func() {
+	It("should restore the status of the reconciled resource", func() {
+		restorer := &fakeRestorer{}
+		resourceType := &v1.Gateway{}
+
+		reconciler := NewRestoreReconciler(restorer, resourceType)
+
+		nsname := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+		result, err := reconciler.Reconcile(context.Background(), reconcile.Request{NamespacedName: nsname})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(restorer.calls).To(HaveLen(1))
+		Expect(restorer.calls[0].resourceType).To(BeIdenticalTo(resourceType))
+		Expect(restorer.calls[0].nsname).To(Equal(nsname))
+	})
+})
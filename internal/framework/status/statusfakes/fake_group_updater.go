@@ -9,6 +9,16 @@ import (
 )
 
 type FakeGroupUpdater struct {
+	EnabledStub        func() bool
+	enabledMutex       sync.RWMutex
+	enabledArgsForCall []struct {
+	}
+	enabledReturns struct {
+		result1 bool
+	}
+	enabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	UpdateGroupStub        func(context.Context, string, ...status.UpdateRequest)
 	updateGroupMutex       sync.RWMutex
 	updateGroupArgsForCall []struct {
@@ -20,6 +30,59 @@ type FakeGroupUpdater struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeGroupUpdater) Enabled() bool {
+	fake.enabledMutex.Lock()
+	ret, specificReturn := fake.enabledReturnsOnCall[len(fake.enabledArgsForCall)]
+	fake.enabledArgsForCall = append(fake.enabledArgsForCall, struct {
+	}{})
+	stub := fake.EnabledStub
+	fakeReturns := fake.enabledReturns
+	fake.recordInvocation("Enabled", []interface{}{})
+	fake.enabledMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGroupUpdater) EnabledCallCount() int {
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	return len(fake.enabledArgsForCall)
+}
+
+func (fake *FakeGroupUpdater) EnabledCalls(stub func() bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = stub
+}
+
+func (fake *FakeGroupUpdater) EnabledReturns(result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	fake.enabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeGroupUpdater) EnabledReturnsOnCall(i int, result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	if fake.enabledReturnsOnCall == nil {
+		fake.enabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeGroupUpdater) UpdateGroup(arg1 context.Context, arg2 string, arg3 ...status.UpdateRequest) {
 	fake.updateGroupMutex.Lock()
 	fake.updateGroupArgsForCall = append(fake.updateGroupArgsForCall, struct {
@@ -57,6 +120,8 @@ func (fake *FakeGroupUpdater) UpdateGroupArgsForCall(i int) (context.Context, st
 func (fake *FakeGroupUpdater) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
 	fake.updateGroupMutex.RLock()
 	defer fake.updateGroupMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
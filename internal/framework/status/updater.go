@@ -38,15 +38,14 @@ type Setter func(client.Object) (wasSet bool)
 // Making Updater asynchronous will prevent it from adding variable delays to the event loop.
 // FIXME(pleshakov): https://github.com/nginxinc/nginx-gateway-fabric/issues/1014
 //
-// (2) It doesn't clear the statuses of a resources that are no longer handled by the Gateway. For example, if
-// an HTTPRoute resource no longer has the parentRef to the Gateway resources, the Gateway must update the status
-// of the resource to remove the status about the removed parentRef.
-// FIXME(pleshakov): https://github.com/nginxinc/nginx-gateway-fabric/issues/1015
+// (2) It only updates the statuses of the resources from the requests. To clear the statuses of resources that are
+// no longer handled by the Gateway, the caller must send requests for them. For example, if an HTTPRoute resource no
+// longer has the parentRef to the Gateway resource, the caller sends a request that removes the status about the
+// removed parentRef. The caller finds such resources by their statuses in the cluster, so that the statuses are
+// also cleared for the resources that stopped being handled while the Gateway was not running.
 //
-// (3) If another controllers changes the status of the Gateway/HTTPRoute resource so that the information set by our
-// Gateway is removed, our Gateway will not restore the status until the EventLoop invokes the StatusUpdater as a
-// result of processing some other new change to a resource(s).
-// FIXME(pleshakov): https://github.com/nginxinc/nginx-gateway-fabric/issues/1813
+// (3) It doesn't restore the status of a resource if another controller or a user changes it so that the information
+// set by our Gateway is removed. The caller must send the requests again for that. See LeaderAwareGroupUpdater.Restore.
 type Updater struct {
 	client client.Client
 	logger logr.Logger
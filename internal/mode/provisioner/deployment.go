@@ -1,27 +1,79 @@
 package provisioner
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strings"
 
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+const (
+	// managedByLabel is the label that marks the resources created by the provisioner.
+	managedByLabel = "app.kubernetes.io/managed-by"
+	// managedByLabelValue is the value of managedByLabel for the resources created by the provisioner.
+	managedByLabelValue = "nginx-gateway-provisioner"
+	// gatewayNameAnnotation is the annotation with the name of the Gateway of a provisioned resource.
+	// The name is stored in an annotation rather than a label, because it can be longer than a label value allows.
+	gatewayNameAnnotation = "gateway.nginx.org/gateway-name"
+	// gatewayNamespaceAnnotation is the annotation with the namespace of the Gateway of a provisioned resource.
+	gatewayNamespaceAnnotation = "gateway.nginx.org/gateway-namespace"
+)
+
+// legacyDeploymentNameRegexp matches the names of the Deployments created by the older versions of the provisioner,
+// which generated the names from an in-memory counter.
+var legacyDeploymentNameRegexp = regexp.MustCompile(`^nginx-gateway-[0-9]+$`)
+
+// generateResourceName generates the name of the resources provisioned for the Gateway with the given
+// NamespacedName. The name only depends on the NamespacedName, so the provisioner generates the same name after
+// it restarts.
+func generateResourceName(gwNsName types.NamespacedName) string {
+	sum := sha256.Sum256([]byte(gwNsName.String()))
+
+	return "nginx-gateway-" + hex.EncodeToString(sum[:])[:10]
+}
+
 // prepareDeployment prepares a new the static mode Deployment based on the YAML manifest.
-// It will use the specified id to set unique parts of the deployment, so it must be unique among all Deployments for
-// Gateways.
-// It will configure the Deployment to use the Gateway with the given NamespacedName.
-func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName) (*v1.Deployment, error) {
+// It will configure the Deployment to use the Gateway and the ServiceAccount and Service provisioned for it.
+// If replicas are managed by a HorizontalPodAutoscaler, the Deployment doesn't set them.
+func prepareDeployment(
+	depYAML []byte,
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	hpaEnabled bool,
+) (*v1.Deployment, error) {
 	dep := &v1.Deployment{}
 	if err := yaml.Unmarshal(depYAML, dep); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal deployment: %w", err)
 	}
 
-	dep.ObjectMeta.Name = id
-	dep.Spec.Selector.MatchLabels["app"] = id
-	dep.Spec.Template.ObjectMeta.Labels["app"] = id
+	gwNsName := client.ObjectKeyFromObject(gw)
+	name := generateResourceName(gwNsName)
+
+	dep.ObjectMeta = prepareObjectMeta(gw, gc, dep.Namespace, dep.Labels)
+
+	dep.Spec.Selector.MatchLabels["app"] = name
+
+	dep.Spec.Template.ObjectMeta.Labels = mergeMaps(
+		mergeMaps(dep.Spec.Template.ObjectMeta.Labels, getInfrastructureLabels(gw)),
+		map[string]string{"app": name},
+	)
+	dep.Spec.Template.ObjectMeta.Annotations = mergeMaps(
+		dep.Spec.Template.ObjectMeta.Annotations,
+		getInfrastructureAnnotations(gw),
+	)
+
+	dep.Spec.Template.Spec.ServiceAccountName = name
+
+	if hpaEnabled {
+		dep.Spec.Replicas = nil
+	}
 
 	finalArgs := []string{
 		"--gateway=" + gwNsName.String(),
@@ -29,10 +81,12 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 	}
 
 	for _, arg := range dep.Spec.Template.Spec.Containers[0].Args {
-		if strings.Contains(arg, "leader-election-lock-name") {
-			lockNameArg := "--leader-election-lock-name=" + gwNsName.Name
-			finalArgs = append(finalArgs, lockNameArg)
-		} else {
+		switch {
+		case strings.Contains(arg, "leader-election-lock-name"):
+			finalArgs = append(finalArgs, "--leader-election-lock-name="+gwNsName.Name)
+		case strings.HasPrefix(arg, "--service="):
+			finalArgs = append(finalArgs, "--service="+name)
+		default:
 			finalArgs = append(finalArgs, arg)
 		}
 	}
@@ -41,3 +95,83 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 
 	return dep, nil
 }
+
+// updateDeployment updates the fields of the existing Deployment that the provisioner owns.
+// The other fields are left intact, because Kubernetes sets defaults for many of them.
+func updateDeployment(existing, desired *v1.Deployment, hpaEnabled bool) {
+	if !hpaEnabled {
+		existing.Spec.Replicas = desired.Spec.Replicas
+	}
+
+	existing.Spec.Template.Labels = mergeMaps(existing.Spec.Template.Labels, desired.Spec.Template.Labels)
+	existing.Spec.Template.Annotations = mergeMaps(
+		existing.Spec.Template.Annotations,
+		desired.Spec.Template.Annotations,
+	)
+	existing.Spec.Template.Spec.ServiceAccountName = desired.Spec.Template.Spec.ServiceAccountName
+
+	for _, desiredContainer := range desired.Spec.Template.Spec.Containers {
+		for i := range existing.Spec.Template.Spec.Containers {
+			container := &existing.Spec.Template.Spec.Containers[i]
+			if container.Name != desiredContainer.Name {
+				continue
+			}
+
+			container.Image = desiredContainer.Image
+			container.Args = desiredContainer.Args
+		}
+	}
+}
+
+// getGatewayNsName returns the NamespacedName of the Gateway of the provisioned resource based on its annotations.
+// It returns false if the resource doesn't have the annotations.
+func getGatewayNsName(obj client.Object) (types.NamespacedName, bool) {
+	name, nameExists := obj.GetAnnotations()[gatewayNameAnnotation]
+	namespace, namespaceExists := obj.GetAnnotations()[gatewayNamespaceAnnotation]
+
+	if !nameExists || !namespaceExists {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// getLegacyGatewayNsName returns the NamespacedName of the Gateway of the Deployment created by an older version of
+// the provisioner. Such Deployments don't have the labels of the provisioner, so they are recognized by their name
+// and the arguments that the provisioner set. It returns false if the Deployment was not created by an older version.
+func getLegacyGatewayNsName(dep *v1.Deployment) (types.NamespacedName, bool) {
+	if _, exists := dep.Labels[managedByLabel]; exists || !legacyDeploymentNameRegexp.MatchString(dep.Name) {
+		return types.NamespacedName{}, false
+	}
+
+	if len(dep.Spec.Template.Spec.Containers) == 0 {
+		return types.NamespacedName{}, false
+	}
+
+	var (
+		gwNsName        types.NamespacedName
+		gatewayArgFound bool
+		statusArgFound  bool
+	)
+
+	for _, arg := range dep.Spec.Template.Spec.Containers[0].Args {
+		switch {
+		case strings.HasPrefix(arg, "--gateway="):
+			namespace, name, ok := strings.Cut(strings.TrimPrefix(arg, "--gateway="), "/")
+			if !ok {
+				return types.NamespacedName{}, false
+			}
+
+			gwNsName = types.NamespacedName{Namespace: namespace, Name: name}
+			gatewayArgFound = true
+		case arg == "--update-gatewayclass-status=false":
+			statusArgFound = true
+		}
+	}
+
+	if !gatewayArgFound || !statusArgFound {
+		return types.NamespacedName{}, false
+	}
+
+	return gwNsName, true
+}
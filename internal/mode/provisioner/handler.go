@@ -3,11 +3,22 @@ package provisioner
 import (
 	"context"
 	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -20,6 +31,20 @@ import (
 
 type timeNowFunc func() metav1.Time
 
+// retryEvent makes the provisioner retry provisioning the infrastructure after a failure.
+type retryEvent struct{}
+
+// newRetryBackoff returns the backoff of the retries of provisioning after failures.
+func newRetryBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      5 * time.Minute,
+	}
+}
+
 // eventHandler ensures each Gateway for the specific GatewayClass has a corresponding Deployment
 // of NGF configured to use that specific Gateway.
 //
@@ -28,23 +53,37 @@ type eventHandler struct {
 	gcName string
 	store  *store
 
-	// provisions maps NamespacedName of Gateway to its corresponding Deployment
+	// provisions maps NamespacedName of Gateway to its corresponding Deployment.
+	// It is restored from the existing Deployments when the first batch is handled.
 	provisions map[types.NamespacedName]*v1.Deployment
 
 	statusUpdater *status.Updater
 	k8sClient     client.Client
-	timeNow       timeNowFunc
+	// k8sReader reads resources directly from the k8s API, bypassing the cache.
+	k8sReader client.Reader
+	timeNow   timeNowFunc
 
 	staticModeDeploymentYAML []byte
 
-	gatewayNextID int64
+	hpaCfg HPAConfig
+
+	// eventCh is the channel of the event loop. The provisioner sends retryEvent to it to retry failed
+	// provisioning.
+	eventCh      chan<- interface{}
+	retryTimer   *time.Timer
+	retryBackoff wait.Backoff
+
+	provisionsRestored bool
 }
 
 func newEventHandler(
 	gcName string,
 	statusUpdater *status.Updater,
 	k8sClient client.Client,
+	k8sReader client.Reader,
+	eventCh chan<- interface{},
 	staticModeDeploymentYAML []byte,
+	hpaCfg HPAConfig,
 	timeNow timeNowFunc,
 ) *eventHandler {
 	return &eventHandler{
@@ -53,12 +92,87 @@ func newEventHandler(
 		statusUpdater:            statusUpdater,
 		gcName:                   gcName,
 		k8sClient:                k8sClient,
+		k8sReader:                k8sReader,
 		staticModeDeploymentYAML: staticModeDeploymentYAML,
-		gatewayNextID:            1,
+		hpaCfg:                   hpaCfg,
+		eventCh:                  eventCh,
+		retryBackoff:             newRetryBackoff(),
 		timeNow:                  timeNow,
 	}
 }
 
+// restoreProvisions restores the provisions from the Deployments created by the previous replicas of
+// the provisioner. The Deployments of the Gateways that no longer exist will be removed afterward
+// by ensureProvisionsMatchGateways.
+func (h *eventHandler) restoreProvisions(ctx context.Context, logger logr.Logger) error {
+	var deps v1.DeploymentList
+
+	if err := h.k8sReader.List(ctx, &deps, client.MatchingLabels{managedByLabel: managedByLabelValue}); err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	for i := range deps.Items {
+		deployment := &deps.Items[i]
+
+		nsname, ok := getGatewayNsName(deployment)
+		if !ok {
+			logger.Info(
+				"Skipping deployment without gateway annotations",
+				"deployment", client.ObjectKeyFromObject(deployment),
+			)
+			continue
+		}
+
+		h.provisions[nsname] = deployment
+
+		logger.Info(
+			"Restored deployment",
+			"deployment", client.ObjectKeyFromObject(deployment),
+			"gateway", nsname,
+		)
+	}
+
+	if err := h.removeLegacyDeployments(ctx, logger); err != nil {
+		return err
+	}
+
+	h.provisionsRestored = true
+
+	return nil
+}
+
+// removeLegacyDeployments deletes the Deployments created by the older versions of the provisioner.
+// Those Deployments can't be adopted, because their names and Pod selectors differ from the ones the provisioner
+// generates now, and the selectors are immutable. The provisioner creates new Deployments for their Gateways instead.
+func (h *eventHandler) removeLegacyDeployments(ctx context.Context, logger logr.Logger) error {
+	var deps v1.DeploymentList
+
+	if err := h.k8sReader.List(ctx, &deps); err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	for i := range deps.Items {
+		deployment := &deps.Items[i]
+
+		nsname, ok := getLegacyGatewayNsName(deployment)
+		if !ok {
+			continue
+		}
+
+		if err := h.k8sClient.Delete(ctx, deployment); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete legacy deployment %s: %w", client.ObjectKeyFromObject(deployment), err)
+		}
+
+		logger.Info(
+			"Deleted legacy deployment",
+			"deployment", client.ObjectKeyFromObject(deployment),
+			"gateway", nsname,
+		)
+	}
+
+	return nil
+}
+
 func (h *eventHandler) setGatewayClassStatuses(ctx context.Context) {
 	var reqs []status.UpdateRequest
 
@@ -108,79 +222,269 @@ func (h *eventHandler) setGatewayClassStatuses(ctx context.Context) {
 	h.statusUpdater.Update(ctx, reqs...)
 }
 
-func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logger logr.Logger) {
-	var gwsWithoutDeps, removedGwsWithDeps []types.NamespacedName
+// ensureProvisionsMatchGateways ensures each Gateway has its infrastructure and that the infrastructure of
+// the removed Gateways is deleted. The infrastructure of the existing Gateways is updated, which fixes any drift
+// from the desired state.
+// It returns the errors of the Gateways that the provisioner failed to provision or deprovision.
+func (h *eventHandler) ensureProvisionsMatchGateways(
+	ctx context.Context,
+	logger logr.Logger,
+) map[types.NamespacedName]error {
+	errs := make(map[types.NamespacedName]error)
+
+	var removedGwsWithDeps []types.NamespacedName
+
+	for nsname := range h.provisions {
+		if gw, exist := h.store.gateways[nsname]; exist && string(gw.Spec.GatewayClassName) == h.gcName {
+			continue
+		}
+
+		removedGwsWithDeps = append(removedGwsWithDeps, nsname)
+	}
+
+	// Create or update infrastructure
+
+	gc := h.store.gatewayClasses[types.NamespacedName{Name: h.gcName}]
 
 	for nsname, gw := range h.store.gateways {
 		if string(gw.Spec.GatewayClassName) != h.gcName {
 			continue
 		}
-		if _, exist := h.provisions[nsname]; exist {
-			continue
+
+		if err := h.provision(ctx, logger, gw, gc); err != nil {
+			logger.Error(err, "Failed to provision infrastructure", "gateway", nsname)
+			errs[nsname] = err
+		}
+	}
+
+	// Remove unnecessary infrastructure
+
+	for _, nsname := range removedGwsWithDeps {
+		if err := h.deprovision(ctx, logger, nsname); err != nil {
+			logger.Error(err, "Failed to delete infrastructure", "gateway", nsname)
+			errs[nsname] = err
 		}
+	}
+
+	return errs
+}
 
-		gwsWithoutDeps = append(gwsWithoutDeps, nsname)
+// provision creates or updates the infrastructure of the Gateway.
+func (h *eventHandler) provision(
+	ctx context.Context,
+	logger logr.Logger,
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+) error {
+	nsname := client.ObjectKeyFromObject(gw)
+
+	infra, err := prepareInfrastructure(h.staticModeDeploymentYAML, gw, gc, h.hpaCfg)
+	if err != nil {
+		return fmt.Errorf("failed to prepare infrastructure: %w", err)
 	}
 
-	for nsname := range h.provisions {
-		if _, exist := h.store.gateways[nsname]; exist {
-			continue
+	for _, res := range infra.resources() {
+		if err := h.ensureResource(ctx, logger, nsname, res); err != nil {
+			return err
 		}
 
-		removedGwsWithDeps = append(removedGwsWithDeps, nsname)
+		// The Deployment is tracked as soon as it exists, so that it is deleted along with the Gateway
+		// even if provisioning the rest of the infrastructure fails.
+		if res.desired == client.Object(infra.deployment) {
+			h.provisions[nsname] = infra.deployment
+		}
 	}
 
-	// Create new deployments
+	if infra.hpa == nil {
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: infra.deployment.Namespace,
+				Name:      infra.deployment.Name,
+			},
+		}
+		if err := h.removeResource(ctx, logger, nsname, hpa); err != nil {
+			return err
+		}
+	}
 
-	for _, nsname := range gwsWithoutDeps {
-		deployment, err := prepareDeployment(h.staticModeDeploymentYAML, h.generateDeploymentID(), nsname)
-		if err != nil {
-			panic(fmt.Errorf("failed to prepare deployment: %w", err))
+	return nil
+}
+
+// deprovision deletes the infrastructure of the removed Gateway.
+func (h *eventHandler) deprovision(ctx context.Context, logger logr.Logger, gwNsName types.NamespacedName) error {
+	deployment := h.provisions[gwNsName]
+
+	meta := metav1.ObjectMeta{Namespace: deployment.Namespace, Name: deployment.Name}
+
+	// The other resources might not exist if they were created by an older version of the provisioner.
+	for _, obj := range []client.Object{
+		&v1.Deployment{ObjectMeta: meta},
+		&apiv1.Service{ObjectMeta: meta},
+		&apiv1.ServiceAccount{ObjectMeta: meta},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: deployment.Name}},
+		&rbacv1.Role{ObjectMeta: meta},
+		&rbacv1.RoleBinding{ObjectMeta: meta},
+		&policyv1.PodDisruptionBudget{ObjectMeta: meta},
+		&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: meta},
+	} {
+		if err := h.k8sClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %s: %w", getKind(obj), err)
 		}
+	}
 
-		if err = h.k8sClient.Create(ctx, deployment); err != nil {
-			panic(fmt.Errorf("failed to create deployment: %w", err))
+	delete(h.provisions, gwNsName)
+
+	logger.Info(
+		"Deleted infrastructure",
+		"deployment", client.ObjectKeyFromObject(deployment),
+		"gateway", gwNsName,
+	)
+
+	return nil
+}
+
+// scheduleRetry schedules the next attempt to provision the infrastructure if the last attempt failed.
+// The delay between the attempts grows exponentially. Any batch of events also makes the provisioner retry,
+// so a pending retry is replaced by the next one.
+func (h *eventHandler) scheduleRetry(ctx context.Context, logger logr.Logger, failed bool) {
+	if h.retryTimer != nil {
+		h.retryTimer.Stop()
+		h.retryTimer = nil
+	}
+
+	if !failed {
+		h.retryBackoff = newRetryBackoff()
+		return
+	}
+
+	delay := h.retryBackoff.Step()
+
+	logger.Info("Scheduled retry of provisioning", "delay", delay)
+
+	h.retryTimer = time.AfterFunc(delay, func() {
+		select {
+		case <-ctx.Done():
+		case h.eventCh <- &retryEvent{}:
 		}
+	})
+}
 
-		h.provisions[nsname] = deployment
+// ensureResource creates the resource if it doesn't exist, or updates the fields of the existing resource that
+// the provisioner owns. The resource is only updated if they don't match the desired state.
+func (h *eventHandler) ensureResource(
+	ctx context.Context,
+	logger logr.Logger,
+	gwNsName types.NamespacedName,
+	res provisionedResource,
+) error {
+	kind := getKind(res.desired)
+	key := client.ObjectKeyFromObject(res.desired)
+
+	existing := newObject(res.desired)
+
+	err := h.k8sClient.Get(ctx, key, existing)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get %s: %w", kind, err)
+		}
+
+		if err := h.k8sClient.Create(ctx, res.desired); err != nil {
+			return fmt.Errorf("failed to create %s: %w", kind, err)
+		}
 
 		logger.Info(
-			"Created deployment",
-			"deployment", client.ObjectKeyFromObject(deployment),
-			"gateway", nsname,
+			"Created "+kind,
+			strings.ToLower(kind), key,
+			"gateway", gwNsName,
 		)
+
+		return nil
 	}
 
-	// Remove unnecessary deployments
+	if nsname, ok := getGatewayNsName(existing); !ok || nsname != gwNsName {
+		return fmt.Errorf("%s %s is not provisioned for the Gateway %s", kind, key, gwNsName)
+	}
 
-	for _, nsname := range removedGwsWithDeps {
-		deployment := h.provisions[nsname]
+	updated := existing.DeepCopyObject().(client.Object)
+
+	updateObjectMeta(updated, res.desired)
+	if res.update != nil {
+		res.update(updated)
+	}
+
+	if equality.Semantic.DeepEqual(existing, updated) {
+		return nil
+	}
+
+	if err := h.k8sClient.Update(ctx, updated); err != nil {
+		return fmt.Errorf("failed to update %s: %w", kind, err)
+	}
+
+	logger.Info(
+		"Updated "+kind,
+		strings.ToLower(kind), key,
+		"gateway", gwNsName,
+	)
+
+	return nil
+}
 
-		if err := h.k8sClient.Delete(ctx, deployment); err != nil {
-			panic(fmt.Errorf("failed to delete deployment: %w", err))
+// removeResource deletes the resource if it exists.
+func (h *eventHandler) removeResource(
+	ctx context.Context,
+	logger logr.Logger,
+	gwNsName types.NamespacedName,
+	obj client.Object,
+) error {
+	kind := getKind(obj)
+	key := client.ObjectKeyFromObject(obj)
+
+	if err := h.k8sClient.Get(ctx, key, newObject(obj)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
 		}
 
-		delete(h.provisions, nsname)
+		return fmt.Errorf("failed to get %s: %w", kind, err)
+	}
 
-		logger.Info(
-			"Deleted deployment",
-			"deployment", client.ObjectKeyFromObject(deployment),
-			"gateway", nsname,
-		)
+	if err := h.k8sClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete %s: %w", kind, err)
 	}
+
+	logger.Info(
+		"Deleted "+kind,
+		strings.ToLower(kind), key,
+		"gateway", gwNsName,
+	)
+
+	return nil
+}
+
+// newObject returns a new empty object of the same type as the passed object.
+func newObject(obj client.Object) client.Object {
+	return reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+}
+
+// getKind returns the kind of the object based on its Go type.
+func getKind(obj client.Object) string {
+	return reflect.TypeOf(obj).Elem().Name()
 }
 
 func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger, batch events.EventBatch) {
 	h.store.update(batch)
 	h.setGatewayClassStatuses(ctx)
-	h.ensureDeploymentsMatchGateways(ctx, logger)
-}
 
-func (h *eventHandler) generateDeploymentID() string {
-	// This approach will break if the provisioner is restarted, because the existing Gateways might get
-	// IDs different from the previous replica of the provisioner.
-	id := h.gatewayNextID
-	h.gatewayNextID++
+	if !h.provisionsRestored {
+		if err := h.restoreProvisions(ctx, logger); err != nil {
+			// Without the restored provisions, the provisioner would fail to create the existing Deployments
+			// and would not remove the Deployments of the deleted Gateways, so it waits for the next attempt.
+			logger.Error(err, "Failed to restore provisions")
+			h.scheduleRetry(ctx, logger, true)
+			return
+		}
+	}
 
-	return fmt.Sprintf("nginx-gateway-%d", id)
+	provisionErrs := h.ensureProvisionsMatchGateways(ctx, logger)
+	h.setGatewayStatuses(ctx, logger, provisionErrs)
+	h.scheduleRetry(ctx, logger, len(provisionErrs) > 0)
 }
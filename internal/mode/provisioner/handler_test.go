@@ -2,16 +2,28 @@ package provisioner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -38,6 +50,7 @@ var _ = Describe("handler", func() {
 		gc            *gatewayv1.GatewayClass
 
 		fakeTimeNow timeNowFunc
+		retryCh     chan interface{}
 	)
 
 	BeforeEach(OncePerOrdered, func() {
@@ -46,6 +59,10 @@ var _ = Describe("handler", func() {
 		Expect(gatewayv1.AddToScheme(scheme)).Should(Succeed())
 		Expect(v1.AddToScheme(scheme)).Should(Succeed())
 		Expect(apiext.AddToScheme(scheme)).Should(Succeed())
+		Expect(apiv1.AddToScheme(scheme)).Should(Succeed())
+		Expect(rbacv1.AddToScheme(scheme)).Should(Succeed())
+		Expect(policyv1.AddToScheme(scheme)).Should(Succeed())
+		Expect(autoscalingv2.AddToScheme(scheme)).Should(Succeed())
 
 		k8sclient = fake.NewClientBuilder().
 			WithScheme(scheme).
@@ -62,6 +79,8 @@ var _ = Describe("handler", func() {
 
 		statusUpdater = status.NewUpdater(k8sclient, zap.New())
 
+		retryCh = make(chan interface{}, 10)
+
 		// Add GatewayClass CRD to the cluster
 		crd = &metav1.PartialObjectMetadata{
 			TypeMeta: metav1.TypeMeta{
@@ -94,10 +113,100 @@ var _ = Describe("handler", func() {
 			},
 			Spec: gatewayv1.GatewaySpec{
 				GatewayClassName: gcName,
+				Listeners: []gatewayv1.Listener{
+					{
+						Name:     "http",
+						Port:     80,
+						Protocol: gatewayv1.HTTPProtocolType,
+					},
+					{
+						Name:     "https",
+						Port:     443,
+						Protocol: gatewayv1.HTTPSProtocolType,
+					},
+					{
+						Name:     "http-duplicate-port",
+						Port:     80,
+						Protocol: gatewayv1.HTTPProtocolType,
+					},
+				},
 			},
 		}
 	}
 
+	getResource := func(obj client.Object) client.Object {
+		name := obj.GetName()
+		namespace := obj.GetNamespace()
+		err := k8sclient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, obj)
+		Expect(err).ToNot(HaveOccurred())
+
+		return obj
+	}
+
+	itShouldHaveInfrastructure := func(gwNsName types.NamespacedName) {
+		name := generateResourceName(gwNsName)
+		meta := metav1.ObjectMeta{Namespace: "nginx-gateway", Name: name}
+
+		expectProvisionerLabels := func(obj client.Object) {
+			Expect(obj.GetLabels()).To(HaveKeyWithValue(managedByLabel, managedByLabelValue))
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(gatewayNameAnnotation, gwNsName.Name))
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(gatewayNamespaceAnnotation, gwNsName.Namespace))
+		}
+
+		svc := getResource(&apiv1.Service{ObjectMeta: meta}).(*apiv1.Service)
+		expectProvisionerLabels(svc)
+		Expect(svc.Spec.Type).To(Equal(apiv1.ServiceTypeLoadBalancer))
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"app": name}))
+		Expect(svc.Spec.Ports).To(ConsistOf(
+			apiv1.ServicePort{
+				Name:       "port-80",
+				Protocol:   apiv1.ProtocolTCP,
+				Port:       80,
+				TargetPort: intstr.FromInt32(80),
+			},
+			apiv1.ServicePort{
+				Name:       "port-443",
+				Protocol:   apiv1.ProtocolTCP,
+				Port:       443,
+				TargetPort: intstr.FromInt32(443),
+			},
+		))
+
+		sa := getResource(&apiv1.ServiceAccount{ObjectMeta: meta})
+		expectProvisionerLabels(sa)
+
+		crb := getResource(&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}}).(*rbacv1.ClusterRoleBinding)
+		expectProvisionerLabels(crb)
+		Expect(crb.RoleRef.Name).To(Equal(staticModeClusterRoleName))
+		Expect(crb.Subjects).To(ConsistOf(rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      name,
+			Namespace: "nginx-gateway",
+		}))
+
+		role := getResource(&rbacv1.Role{ObjectMeta: meta}).(*rbacv1.Role)
+		expectProvisionerLabels(role)
+		Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"services"},
+			ResourceNames: []string{name},
+			Verbs:         []string{"patch"},
+		}))
+
+		rb := getResource(&rbacv1.RoleBinding{ObjectMeta: meta}).(*rbacv1.RoleBinding)
+		expectProvisionerLabels(rb)
+		Expect(rb.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: name}))
+		Expect(rb.Subjects).To(ConsistOf(rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      name,
+			Namespace: "nginx-gateway",
+		}))
+
+		pdb := getResource(&policyv1.PodDisruptionBudget{ObjectMeta: meta}).(*policyv1.PodDisruptionBudget)
+		expectProvisionerLabels(pdb)
+		Expect(pdb.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": name}))
+	}
+
 	itShouldUpsertGatewayClass := func() {
 		// Add GatewayClass to the cluster
 
@@ -145,7 +254,7 @@ var _ = Describe("handler", func() {
 		Expect(clusterGc.Status.Conditions).To(Equal(expectedConditions))
 	}
 
-	itShouldUpsertGateway := func(gwNsName types.NamespacedName, seqNumber int64) {
+	itShouldUpsertGateway := func(gwNsName types.NamespacedName) {
 		batch := []interface{}{
 			&events.UpsertEvent{
 				Resource: createGateway(gwNsName),
@@ -156,7 +265,7 @@ var _ = Describe("handler", func() {
 
 		depNsName := types.NamespacedName{
 			Namespace: "nginx-gateway",
-			Name:      fmt.Sprintf("nginx-gateway-%d", seqNumber),
+			Name:      generateResourceName(gwNsName),
 		}
 
 		dep := &v1.Deployment{}
@@ -166,12 +275,26 @@ var _ = Describe("handler", func() {
 
 		Expect(dep.ObjectMeta.Namespace).To(Equal("nginx-gateway"))
 		Expect(dep.ObjectMeta.Name).To(Equal(depNsName.Name))
+		Expect(dep.ObjectMeta.Labels).To(HaveKeyWithValue(managedByLabel, managedByLabelValue))
+		Expect(dep.ObjectMeta.Annotations).To(HaveKeyWithValue(gatewayNameAnnotation, gwNsName.Name))
+		Expect(dep.ObjectMeta.Annotations).To(HaveKeyWithValue(gatewayNamespaceAnnotation, gwNsName.Namespace))
+		for _, value := range dep.ObjectMeta.Labels {
+			Expect(validation.IsValidLabelValue(value)).To(BeEmpty())
+		}
+		Expect(dep.ObjectMeta.OwnerReferences).To(HaveLen(1))
+		Expect(dep.ObjectMeta.OwnerReferences[0].Kind).To(Equal("GatewayClass"))
+		Expect(dep.ObjectMeta.OwnerReferences[0].Name).To(Equal(gcName))
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("static-mode"))
 		expectedGwFlag := fmt.Sprintf("--gateway=%s", gwNsName.String())
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedGwFlag))
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--update-gatewayclass-status=false"))
 		expectedLockFlag := fmt.Sprintf("--leader-election-lock-name=%s", gwNsName.Name)
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedLockFlag))
+		expectedServiceFlag := fmt.Sprintf("--service=%s", depNsName.Name)
+		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedServiceFlag))
+		Expect(dep.Spec.Template.Spec.ServiceAccountName).To(Equal(depNsName.Name))
+
+		itShouldHaveInfrastructure(gwNsName)
 	}
 
 	itShouldUpsertCRD := func(version string, accepted bool) {
@@ -255,6 +378,39 @@ var _ = Describe("handler", func() {
 		Expect(handle).Should(Panic())
 	}
 
+	upsertNewGateway := func(gwNsName types.NamespacedName) {
+		gw := createGateway(gwNsName)
+		Expect(k8sclient.Create(context.Background(), gw)).To(Succeed())
+
+		batch := []interface{}{
+			&events.UpsertEvent{
+				Resource: gw,
+			},
+		}
+
+		handler.HandleEventBatch(context.Background(), zap.New(), batch)
+	}
+
+	itShouldHaveProgrammedCondition := func(
+		gwNsName types.NamespacedName,
+		reason gatewayv1.GatewayConditionReason,
+	) *gatewayv1.Gateway {
+		gw := getResource(&gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+		}).(*gatewayv1.Gateway)
+
+		accepted := apimeta.FindStatusCondition(gw.Status.Conditions, string(gatewayv1.GatewayConditionAccepted))
+		Expect(accepted).ToNot(BeNil())
+		Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+
+		programmed := apimeta.FindStatusCondition(gw.Status.Conditions, string(gatewayv1.GatewayConditionProgrammed))
+		Expect(programmed).ToNot(BeNil())
+		Expect(programmed.Status).To(Equal(metav1.ConditionFalse))
+		Expect(programmed.Reason).To(Equal(string(reason)))
+
+		return gw
+	}
+
 	Describe("Core cases", Ordered, func() {
 		var gwNsName1, gwNsName2 types.NamespacedName
 
@@ -272,7 +428,10 @@ var _ = Describe("handler", func() {
 				gcName,
 				statusUpdater,
 				k8sclient,
+				k8sclient,
+				retryCh,
 				embeddedfiles.StaticModeDeploymentYAML,
+				HPAConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -285,19 +444,19 @@ var _ = Describe("handler", func() {
 
 		When("upserting first Gateway", func() {
 			It("should create first Deployment", func() {
-				itShouldUpsertGateway(gwNsName1, 1)
+				itShouldUpsertGateway(gwNsName1)
 			})
 		})
 
 		When("upserting first Gateway again", func() {
 			It("must retain Deployment", func() {
-				itShouldUpsertGateway(gwNsName1, 1)
+				itShouldUpsertGateway(gwNsName1)
 			})
 		})
 
 		When("upserting second Gateway", func() {
 			It("should create second Deployment", func() {
-				itShouldUpsertGateway(gwNsName2, 2)
+				itShouldUpsertGateway(gwNsName2)
 			})
 		})
 
@@ -317,7 +476,7 @@ var _ = Describe("handler", func() {
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(deps.Items).To(HaveLen(1))
-				Expect(deps.Items[0].ObjectMeta.Name).To(Equal("nginx-gateway-2"))
+				Expect(deps.Items[0].ObjectMeta.Name).To(Equal(generateResourceName(gwNsName2)))
 			})
 		})
 
@@ -433,6 +592,514 @@ var _ = Describe("handler", func() {
 		})
 	})
 
+	Describe("Restart", Ordered, func() {
+		var (
+			gwNsName1, gwNsName2 types.NamespacedName
+			unrelatedDep         *v1.Deployment
+		)
+
+		listDeployments := func() []v1.Deployment {
+			deps := &v1.DeploymentList{}
+
+			err := k8sclient.List(context.Background(), deps)
+			Expect(err).ToNot(HaveOccurred())
+
+			return deps.Items
+		}
+
+		BeforeAll(func() {
+			gwNsName1 = types.NamespacedName{
+				Namespace: "test-ns-1",
+				Name:      "test-gw-1",
+			}
+			// The name is longer than a label value allows, so the provisioner must not store it in a label.
+			gwNsName2 = types.NamespacedName{
+				Namespace: "test-ns-2",
+				Name:      "test-gw-2-" + strings.Repeat("a", validation.LabelValueMaxLength),
+			}
+
+			unrelatedDep = &v1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "nginx-gateway",
+					Name:      "unrelated",
+				},
+			}
+
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				k8sclient,
+				retryCh,
+				embeddedfiles.StaticModeDeploymentYAML,
+				HPAConfig{},
+				fakeTimeNow,
+			)
+		})
+
+		When("upserting Gateways", func() {
+			It("should create Deployments", func() {
+				itShouldUpsertGatewayClass()
+				itShouldUpsertGateway(gwNsName1)
+				itShouldUpsertGateway(gwNsName2)
+
+				Expect(k8sclient.Create(context.Background(), unrelatedDep)).To(Succeed())
+
+				Expect(listDeployments()).To(HaveLen(3))
+			})
+		})
+
+		When("restarting after first Gateway was deleted", func() {
+			It("should keep the Deployment of second Gateway and remove the Deployment of first Gateway", func() {
+				dep2 := &v1.Deployment{}
+				err := k8sclient.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "nginx-gateway", Name: generateResourceName(gwNsName2)},
+					dep2,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				handler = newEventHandler(
+					gcName,
+					statusUpdater,
+					k8sclient,
+					k8sclient,
+					retryCh,
+					embeddedfiles.StaticModeDeploymentYAML,
+					HPAConfig{},
+					fakeTimeNow,
+				)
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gc,
+					},
+					&events.UpsertEvent{
+						Resource: crd,
+					},
+					&events.UpsertEvent{
+						Resource: createGateway(gwNsName2),
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				deps := listDeployments()
+				Expect(deps).To(HaveLen(2))
+
+				names := []string{deps[0].Name, deps[1].Name}
+				Expect(names).To(ConsistOf(generateResourceName(gwNsName2), unrelatedDep.Name))
+
+				restoredDep := &v1.Deployment{}
+				err = k8sclient.Get(context.Background(), client.ObjectKeyFromObject(dep2), restoredDep)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(restoredDep.ResourceVersion).To(Equal(dep2.ResourceVersion))
+			})
+		})
+
+		When("restarting after upgrade from the version without stable Deployment names", func() {
+			It("should replace the legacy Deployment of the Gateway and keep the unrelated Deployments", func() {
+				legacyDep := &v1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "nginx-gateway",
+						Name:      "nginx-gateway-1",
+					},
+					Spec: v1.DeploymentSpec{
+						Template: apiv1.PodTemplateSpec{
+							Spec: apiv1.PodSpec{
+								Containers: []apiv1.Container{
+									{
+										Name: "nginx-gateway",
+										Args: []string{
+											"--gateway=" + gwNsName2.String(),
+											"--update-gatewayclass-status=false",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(k8sclient.Create(context.Background(), legacyDep)).To(Succeed())
+
+				// the Deployment has a matching name, but it was not created by the provisioner
+				unrelatedLegacyNameDep := &v1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "nginx-gateway",
+						Name:      "nginx-gateway-2",
+					},
+				}
+				Expect(k8sclient.Create(context.Background(), unrelatedLegacyNameDep)).To(Succeed())
+
+				handler = newEventHandler(
+					gcName,
+					statusUpdater,
+					k8sclient,
+					k8sclient,
+					retryCh,
+					embeddedfiles.StaticModeDeploymentYAML,
+					HPAConfig{},
+					fakeTimeNow,
+				)
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gc,
+					},
+					&events.UpsertEvent{
+						Resource: crd,
+					},
+					&events.UpsertEvent{
+						Resource: createGateway(gwNsName2),
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				deps := listDeployments()
+				Expect(deps).To(HaveLen(3))
+
+				names := []string{deps[0].Name, deps[1].Name, deps[2].Name}
+				Expect(names).To(ConsistOf(
+					generateResourceName(gwNsName2),
+					unrelatedDep.Name,
+					unrelatedLegacyNameDep.Name,
+				))
+
+				Expect(k8sclient.Delete(context.Background(), unrelatedLegacyNameDep)).To(Succeed())
+			})
+		})
+
+		When("deleting second Gateway after restart", func() {
+			It("should remove the Deployment of second Gateway", func() {
+				batch := []interface{}{
+					&events.DeleteEvent{
+						Type:           &gatewayv1.Gateway{},
+						NamespacedName: gwNsName2,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				deps := listDeployments()
+				Expect(deps).To(HaveLen(1))
+				Expect(deps[0].Name).To(Equal(unrelatedDep.Name))
+			})
+		})
+	})
+
+	Describe("Infrastructure", Ordered, func() {
+		var (
+			gwNsName types.NamespacedName
+			meta     metav1.ObjectMeta
+		)
+
+		upsertGateway := func(gw *gatewayv1.Gateway) {
+			batch := []interface{}{
+				&events.UpsertEvent{
+					Resource: gw,
+				},
+			}
+
+			handler.HandleEventBatch(context.Background(), zap.New(), batch)
+		}
+
+		BeforeAll(func() {
+			gwNsName = types.NamespacedName{
+				Namespace: "test-ns",
+				Name:      "test-gw",
+			}
+
+			meta = metav1.ObjectMeta{
+				Namespace: "nginx-gateway",
+				Name:      generateResourceName(gwNsName),
+			}
+
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				k8sclient,
+				retryCh,
+				embeddedfiles.StaticModeDeploymentYAML,
+				HPAConfig{
+					MaxReplicas:                    3,
+					TargetCPUUtilizationPercentage: 50,
+				},
+				fakeTimeNow,
+			)
+		})
+
+		When("upserting Gateway with infrastructure labels and annotations", func() {
+			It("should create infrastructure with labels and annotations and HorizontalPodAutoscaler", func() {
+				itShouldUpsertGatewayClass()
+
+				gw := createGateway(gwNsName)
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					Labels: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						"label":        "value",
+						managedByLabel: "override",
+					},
+					Annotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+						"annotation":          "value",
+						gatewayNameAnnotation: "override",
+					},
+				}
+
+				upsertGateway(gw)
+				itShouldHaveInfrastructure(gwNsName)
+
+				for _, obj := range []client.Object{
+					&v1.Deployment{ObjectMeta: meta},
+					&apiv1.Service{ObjectMeta: meta},
+					&apiv1.ServiceAccount{ObjectMeta: meta},
+					&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: meta.Name}},
+					&rbacv1.Role{ObjectMeta: meta},
+					&rbacv1.RoleBinding{ObjectMeta: meta},
+					&policyv1.PodDisruptionBudget{ObjectMeta: meta},
+					&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: meta},
+				} {
+					obj = getResource(obj)
+					Expect(obj.GetLabels()).To(HaveKeyWithValue("label", "value"))
+					Expect(obj.GetAnnotations()).To(HaveKeyWithValue("annotation", "value"))
+				}
+
+				dep := getResource(&v1.Deployment{ObjectMeta: meta}).(*v1.Deployment)
+				Expect(dep.Spec.Replicas).To(BeNil())
+				Expect(dep.Spec.Template.Labels).To(HaveKeyWithValue("label", "value"))
+				Expect(dep.Spec.Template.Annotations).To(HaveKeyWithValue("annotation", "value"))
+
+				hpa := getResource(
+					&autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: meta},
+				).(*autoscalingv2.HorizontalPodAutoscaler)
+				Expect(hpa.Spec.ScaleTargetRef.Name).To(Equal(meta.Name))
+				Expect(hpa.Spec.MaxReplicas).To(Equal(int32(3)))
+				Expect(*hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(int32(50)))
+			})
+		})
+
+		When("the infrastructure drifts from the desired state", func() {
+			It("should restore the desired state", func() {
+				svc := getResource(&apiv1.Service{ObjectMeta: meta}).(*apiv1.Service)
+				svc.Spec.Type = apiv1.ServiceTypeNodePort
+				svc.Spec.Ports = svc.Spec.Ports[:1]
+				svc.Labels["other"] = "value"
+				Expect(k8sclient.Update(context.Background(), svc)).To(Succeed())
+
+				dep := getResource(&v1.Deployment{ObjectMeta: meta}).(*v1.Deployment)
+				dep.Spec.Template.Spec.Containers[0].Args = []string{"static-mode"}
+				Expect(k8sclient.Update(context.Background(), dep)).To(Succeed())
+
+				pdb := getResource(&policyv1.PodDisruptionBudget{ObjectMeta: meta})
+				Expect(k8sclient.Delete(context.Background(), pdb)).To(Succeed())
+
+				upsertGateway(createGateway(gwNsName))
+				itShouldHaveInfrastructure(gwNsName)
+
+				svc = getResource(&apiv1.Service{ObjectMeta: meta}).(*apiv1.Service)
+				Expect(svc.Labels).To(HaveKeyWithValue("other", "value"))
+
+				dep = getResource(&v1.Deployment{ObjectMeta: meta}).(*v1.Deployment)
+				Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--gateway=" + gwNsName.String()))
+			})
+		})
+
+		When("the infrastructure matches the desired state", func() {
+			It("should not update it", func() {
+				svc := getResource(&apiv1.Service{ObjectMeta: meta})
+
+				upsertGateway(createGateway(gwNsName))
+
+				Expect(getResource(&apiv1.Service{ObjectMeta: meta}).GetResourceVersion()).
+					To(Equal(svc.GetResourceVersion()))
+			})
+		})
+
+		When("disabling HorizontalPodAutoscaler", func() {
+			It("should remove HorizontalPodAutoscaler", func() {
+				handler.hpaCfg = HPAConfig{}
+
+				upsertGateway(createGateway(gwNsName))
+
+				hpas := &autoscalingv2.HorizontalPodAutoscalerList{}
+				Expect(k8sclient.List(context.Background(), hpas)).To(Succeed())
+				Expect(hpas.Items).To(BeEmpty())
+
+				dep := getResource(&v1.Deployment{ObjectMeta: meta}).(*v1.Deployment)
+				Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](1)))
+			})
+		})
+
+		When("deleting Gateway", func() {
+			It("should remove infrastructure", func() {
+				batch := []interface{}{
+					&events.DeleteEvent{
+						Type:           &gatewayv1.Gateway{},
+						NamespacedName: gwNsName,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				for _, list := range []client.ObjectList{
+					&v1.DeploymentList{},
+					&apiv1.ServiceList{},
+					&apiv1.ServiceAccountList{},
+					&rbacv1.ClusterRoleBindingList{},
+					&rbacv1.RoleList{},
+					&rbacv1.RoleBindingList{},
+					&policyv1.PodDisruptionBudgetList{},
+				} {
+					Expect(k8sclient.List(context.Background(), list)).To(Succeed())
+					Expect(apimeta.LenList(list)).To(BeZero())
+				}
+			})
+		})
+	})
+
+	Describe("Gateway status", Ordered, func() {
+		var (
+			gwNsName types.NamespacedName
+			meta     metav1.ObjectMeta
+		)
+
+		BeforeAll(func() {
+			gwNsName = types.NamespacedName{
+				Namespace: "test-ns",
+				Name:      "test-gw",
+			}
+
+			meta = metav1.ObjectMeta{
+				Namespace: "nginx-gateway",
+				Name:      generateResourceName(gwNsName),
+			}
+
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				k8sclient,
+				retryCh,
+				embeddedfiles.StaticModeDeploymentYAML,
+				HPAConfig{},
+				fakeTimeNow,
+			)
+		})
+
+		When("upserting Gateway", func() {
+			It("should set Pending status", func() {
+				itShouldUpsertGatewayClass()
+				upsertNewGateway(gwNsName)
+
+				gw := itShouldHaveProgrammedCondition(gwNsName, gatewayv1.GatewayReasonPending)
+				Expect(gw.Status.Addresses).To(BeEmpty())
+			})
+		})
+
+		When("the load balancer of the Service is provisioned", func() {
+			It("should publish the addresses of the Service", func() {
+				svc := getResource(&apiv1.Service{ObjectMeta: meta}).(*apiv1.Service)
+				svc.Status.LoadBalancer.Ingress = []apiv1.LoadBalancerIngress{
+					{IP: "1.2.3.4"},
+					{Hostname: "example.com"},
+				}
+				Expect(k8sclient.Status().Update(context.Background(), svc)).To(Succeed())
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: svc,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				gw := itShouldHaveProgrammedCondition(gwNsName, gatewayv1.GatewayReasonPending)
+				Expect(gw.Status.Addresses).To(Equal([]gatewayv1.GatewayStatusAddress{
+					{
+						Type:  helpers.GetPointer(gatewayv1.IPAddressType),
+						Value: "1.2.3.4",
+					},
+					{
+						Type:  helpers.GetPointer(gatewayv1.HostnameAddressType),
+						Value: "example.com",
+					},
+				}))
+			})
+		})
+
+		When("the Deployment becomes available", func() {
+			It("should not change the status set by the data plane", func() {
+				dep := getResource(&v1.Deployment{ObjectMeta: meta}).(*v1.Deployment)
+				dep.Status.AvailableReplicas = 1
+				Expect(k8sclient.Status().Update(context.Background(), dep)).To(Succeed())
+
+				gw := getResource(&gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				}).(*gatewayv1.Gateway)
+				gw.Status.Conditions = nil
+				Expect(k8sclient.Status().Update(context.Background(), gw)).To(Succeed())
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: dep,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
+
+				gw = getResource(&gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				}).(*gatewayv1.Gateway)
+				Expect(gw.Status.Conditions).To(BeEmpty())
+			})
+		})
+
+		When("provisioning fails while the Deployment is available", func() {
+			It("should retry without changing the status set by the data plane", func() {
+				gw := getResource(&gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				}).(*gatewayv1.Gateway)
+				dataPlaneConds := []metav1.Condition{
+					{
+						Type:               string(gatewayv1.GatewayConditionProgrammed),
+						Status:             metav1.ConditionTrue,
+						Reason:             string(gatewayv1.GatewayReasonProgrammed),
+						LastTransitionTime: fakeTimeNow(),
+					},
+				}
+				gw.Status.Conditions = dataPlaneConds
+				Expect(k8sclient.Status().Update(context.Background(), gw)).To(Succeed())
+
+				handler.k8sClient = interceptor.NewClient(k8sclient.(client.WithWatch), interceptor.Funcs{
+					Get: func(
+						ctx context.Context,
+						c client.WithWatch,
+						key client.ObjectKey,
+						obj client.Object,
+						opts ...client.GetOption,
+					) error {
+						if _, ok := obj.(*apiv1.Service); ok {
+							return errors.New("get error")
+						}
+						return c.Get(ctx, key, obj, opts...)
+					},
+				})
+				handler.retryBackoff = wait.Backoff{Duration: time.Millisecond, Steps: 1}
+
+				handler.HandleEventBatch(context.Background(), zap.New(), []interface{}{&retryEvent{}})
+
+				Eventually(retryCh).Should(Receive(Equal(&retryEvent{})))
+
+				gw = getResource(&gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				}).(*gatewayv1.Gateway)
+				Expect(gw.Status.Conditions).To(Equal(dataPlaneConds))
+
+				handler.k8sClient = k8sclient
+			})
+		})
+	})
+
 	Describe("Edge cases", func() {
 		var gwNsName types.NamespacedName
 
@@ -446,7 +1113,10 @@ var _ = Describe("handler", func() {
 				gcName,
 				statusUpdater,
 				k8sclient,
+				k8sclient,
+				retryCh,
 				embeddedfiles.StaticModeDeploymentYAML,
+				HPAConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -480,7 +1150,7 @@ var _ = Describe("handler", func() {
 		})
 
 		When("upserting Gateway when Deployment can't be created", func() {
-			It("should panic", func() {
+			It("should set DeploymentFailed status and retry", func() {
 				itShouldUpsertGatewayClass()
 
 				// Create a deployment so that the Handler will fail to create it because it already exists.
@@ -488,28 +1158,77 @@ var _ = Describe("handler", func() {
 				dep := &v1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
 						Namespace: "nginx-gateway",
-						Name:      "nginx-gateway-1",
+						Name:      generateResourceName(gwNsName),
 					},
 				}
 
 				err := k8sclient.Create(context.Background(), dep)
 				Expect(err).ToNot(HaveOccurred())
 
-				itShouldPanicWhenUpsertingGateway(gwNsName)
+				handler.retryBackoff = wait.Backoff{Duration: time.Millisecond, Steps: 1}
+
+				upsertNewGateway(gwNsName)
+
+				itShouldHaveProgrammedCondition(gwNsName, gatewayReasonDeploymentFailed)
+				Eventually(retryCh).Should(Receive(Equal(&retryEvent{})))
 			})
 		})
 
-		When("deleting Gateway when Deployment can't be deleted", func() {
-			It("should panic", func() {
-				itShouldUpsertGatewayClass()
-				itShouldUpsertGateway(gwNsName, 1)
+		When("listing Deployments to restore the provisions fails", func() {
+			It("should not provision the infrastructure and retry", func() {
+				handler.k8sReader = fake.NewClientBuilder().
+					WithScheme(k8sclient.Scheme()).
+					WithInterceptorFuncs(interceptor.Funcs{
+						List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+							return errors.New("list error")
+						},
+					}).
+					Build()
+				handler.retryBackoff = wait.Backoff{Duration: time.Millisecond, Steps: 1}
 
-				// Delete the deployment so that the Handler will fail to delete it because it doesn't exist.
+				Expect(k8sclient.Create(context.Background(), gc)).To(Succeed())
+				gw := createGateway(gwNsName)
+				Expect(k8sclient.Create(context.Background(), gw)).To(Succeed())
+
+				batch := []interface{}{
+					&events.UpsertEvent{Resource: gc},
+					&events.UpsertEvent{Resource: crd},
+					&events.UpsertEvent{Resource: gw},
+				}
+
+				handle := func() {
+					handler.HandleEventBatch(context.Background(), zap.New(), batch)
+				}
+
+				Expect(handle).ShouldNot(Panic())
+				Expect(handler.provisionsRestored).To(BeFalse())
+				Expect(handler.provisions).To(BeEmpty())
+
+				deps := &v1.DeploymentList{}
+				Expect(k8sclient.List(context.Background(), deps)).To(Succeed())
+				Expect(deps.Items).To(BeEmpty())
+
+				Eventually(retryCh).Should(Receive(Equal(&retryEvent{})))
+
+				// the retry provisions the infrastructure once the Deployments can be listed
+				handler.k8sReader = k8sclient
+				handler.HandleEventBatch(context.Background(), zap.New(), []interface{}{&retryEvent{}})
+
+				Expect(handler.provisionsRestored).To(BeTrue())
+				Expect(k8sclient.List(context.Background(), deps)).To(Succeed())
+				Expect(deps.Items).To(HaveLen(1))
+			})
+		})
+
+		When("deleting Gateway when Deployment was already deleted", func() {
+			It("should remove the rest of infrastructure", func() {
+				itShouldUpsertGatewayClass()
+				itShouldUpsertGateway(gwNsName)
 
 				dep := &v1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
 						Namespace: "nginx-gateway",
-						Name:      "nginx-gateway-1",
+						Name:      generateResourceName(gwNsName),
 					},
 				}
 
@@ -523,11 +1242,13 @@ var _ = Describe("handler", func() {
 					},
 				}
 
-				handle := func() {
-					handler.HandleEventBatch(context.Background(), zap.New(), batch)
-				}
+				handler.HandleEventBatch(context.Background(), zap.New(), batch)
 
-				Expect(handle).Should(Panic())
+				svcs := &apiv1.ServiceList{}
+				Expect(k8sclient.List(context.Background(), svcs)).To(Succeed())
+				Expect(svcs.Items).To(BeEmpty())
+				Expect(handler.provisions).To(BeEmpty())
+				Consistently(retryCh, 10*time.Millisecond).ShouldNot(Receive())
 			})
 		})
 
@@ -553,17 +1274,26 @@ var _ = Describe("handler", func() {
 		})
 
 		When("upserting Gateway with broken static Deployment YAML", func() {
-			It("it should panic", func() {
+			It("should set DeploymentFailed status", func() {
 				handler = newEventHandler(
 					gcName,
 					statusUpdater,
 					k8sclient,
+					k8sclient,
+					retryCh,
 					[]byte("broken YAML"),
+					HPAConfig{},
 					fakeTimeNow,
 				)
 
 				itShouldUpsertGatewayClass()
-				itShouldPanicWhenUpsertingGateway(types.NamespacedName{Namespace: "test-ns", Name: "test-gw"})
+				upsertNewGateway(gwNsName)
+
+				itShouldHaveProgrammedCondition(gwNsName, gatewayReasonDeploymentFailed)
+
+				deps := &v1.DeploymentList{}
+				Expect(k8sclient.List(context.Background(), deps)).To(Succeed())
+				Expect(deps.Items).To(BeEmpty())
 			})
 		})
 	})
@@ -0,0 +1,424 @@
+package provisioner
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+// staticModeClusterRoleName is the name of the ClusterRole with the permissions of the static mode.
+// It is created during the installation of NGF.
+const staticModeClusterRoleName = "nginx-gateway"
+
+// HPAConfig is the configuration of the HorizontalPodAutoscaler of each provisioned Deployment.
+type HPAConfig struct {
+	// MaxReplicas is the upper limit for the number of replicas. Zero disables the HorizontalPodAutoscaler.
+	MaxReplicas int32
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the replicas.
+	TargetCPUUtilizationPercentage int32
+}
+
+// Enabled returns true if the HorizontalPodAutoscaler is enabled.
+func (c HPAConfig) Enabled() bool {
+	return c.MaxReplicas > 0
+}
+
+// provisionedResource is a resource provisioned for a Gateway.
+type provisionedResource struct {
+	// desired is the desired state of the resource.
+	desired client.Object
+	// update updates the fields of the existing resource that the provisioner owns to match the desired state.
+	// The metadata is updated separately.
+	update func(existing client.Object)
+}
+
+// infrastructure is the set of resources provisioned for a Gateway.
+type infrastructure struct {
+	deployment         *appsv1.Deployment
+	service            *apiv1.Service
+	serviceAccount     *apiv1.ServiceAccount
+	clusterRoleBinding *rbacv1.ClusterRoleBinding
+	role               *rbacv1.Role
+	roleBinding        *rbacv1.RoleBinding
+	pdb                *policyv1.PodDisruptionBudget
+	// hpa is nil if the HorizontalPodAutoscaler is disabled.
+	hpa *autoscalingv2.HorizontalPodAutoscaler
+}
+
+// prepareInfrastructure prepares the resources for the Gateway. All resources are created in the namespace of
+// the static mode Deployment.
+func prepareInfrastructure(
+	depYAML []byte,
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	hpaCfg HPAConfig,
+) (infrastructure, error) {
+	dep, err := prepareDeployment(depYAML, gw, gc, hpaCfg.Enabled())
+	if err != nil {
+		return infrastructure{}, err
+	}
+
+	infra := infrastructure{
+		deployment:         dep,
+		service:            prepareService(gw, gc, dep.Namespace),
+		serviceAccount:     prepareServiceAccount(gw, gc, dep.Namespace),
+		clusterRoleBinding: prepareClusterRoleBinding(gw, gc, dep.Namespace),
+		role:               prepareRole(gw, gc, dep.Namespace),
+		roleBinding:        prepareRoleBinding(gw, gc, dep.Namespace),
+		pdb:                preparePodDisruptionBudget(gw, gc, dep.Namespace),
+	}
+
+	if hpaCfg.Enabled() {
+		infra.hpa = prepareHPA(gw, gc, dep.Namespace, hpaCfg)
+	}
+
+	return infra, nil
+}
+
+// resources returns the resources of the infrastructure in the order they must be created.
+func (infra infrastructure) resources() []provisionedResource {
+	resources := []provisionedResource{
+		{
+			desired: infra.serviceAccount,
+		},
+		{
+			desired: infra.clusterRoleBinding,
+			update: func(existing client.Object) {
+				crb := existing.(*rbacv1.ClusterRoleBinding)
+				crb.Subjects = infra.clusterRoleBinding.Subjects
+			},
+		},
+		{
+			desired: infra.role,
+			update: func(existing client.Object) {
+				existing.(*rbacv1.Role).Rules = infra.role.Rules
+			},
+		},
+		{
+			desired: infra.roleBinding,
+			update: func(existing client.Object) {
+				rb := existing.(*rbacv1.RoleBinding)
+				rb.Subjects = infra.roleBinding.Subjects
+			},
+		},
+		{
+			desired: infra.service,
+			update: func(existing client.Object) {
+				updateService(existing.(*apiv1.Service), infra.service)
+			},
+		},
+		{
+			desired: infra.deployment,
+			update: func(existing client.Object) {
+				updateDeployment(existing.(*appsv1.Deployment), infra.deployment, infra.hpa != nil)
+			},
+		},
+		{
+			desired: infra.pdb,
+			update: func(existing client.Object) {
+				pdb := existing.(*policyv1.PodDisruptionBudget)
+				pdb.Spec.MaxUnavailable = infra.pdb.Spec.MaxUnavailable
+				pdb.Spec.Selector = infra.pdb.Spec.Selector
+			},
+		},
+	}
+
+	if infra.hpa != nil {
+		resources = append(resources, provisionedResource{
+			desired: infra.hpa,
+			update: func(existing client.Object) {
+				hpa := existing.(*autoscalingv2.HorizontalPodAutoscaler)
+				hpa.Spec.ScaleTargetRef = infra.hpa.Spec.ScaleTargetRef
+				hpa.Spec.MinReplicas = infra.hpa.Spec.MinReplicas
+				hpa.Spec.MaxReplicas = infra.hpa.Spec.MaxReplicas
+				hpa.Spec.Metrics = infra.hpa.Spec.Metrics
+			},
+		})
+	}
+
+	return resources
+}
+
+// prepareObjectMeta prepares the metadata of a resource provisioned for the Gateway.
+// The labels and annotations of the Gateway infrastructure are added to the resource, but they can't override
+// the label that marks the resource as provisioned or the annotations the provisioner uses to find the Gateway of
+// the resource.
+//
+// The resource is owned by the GatewayClass rather than the Gateway, because the Gateway can be in a different
+// namespace, and Kubernetes doesn't support cross-namespace owner references.
+func prepareObjectMeta(
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	namespace string,
+	labels map[string]string,
+) metav1.ObjectMeta {
+	gwNsName := client.ObjectKeyFromObject(gw)
+
+	provisionerLabels := map[string]string{
+		managedByLabel: managedByLabelValue,
+	}
+	provisionerAnnotations := map[string]string{
+		gatewayNameAnnotation:      gwNsName.Name,
+		gatewayNamespaceAnnotation: gwNsName.Namespace,
+	}
+
+	return metav1.ObjectMeta{
+		Name:        generateResourceName(gwNsName),
+		Namespace:   namespace,
+		Labels:      mergeMaps(mergeMaps(labels, getInfrastructureLabels(gw)), provisionerLabels),
+		Annotations: mergeMaps(getInfrastructureAnnotations(gw), provisionerAnnotations),
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion: gatewayv1.GroupVersion.String(),
+				Kind:       "GatewayClass",
+				Name:       gc.Name,
+				UID:        gc.UID,
+			},
+		},
+	}
+}
+
+// updateObjectMeta updates the metadata of the existing resource that the provisioner owns.
+// The labels and annotations added by others are preserved.
+func updateObjectMeta(existing, desired client.Object) {
+	existing.SetLabels(mergeMaps(existing.GetLabels(), desired.GetLabels()))
+	existing.SetAnnotations(mergeMaps(existing.GetAnnotations(), desired.GetAnnotations()))
+
+	existing.SetOwnerReferences(desired.GetOwnerReferences())
+}
+
+// prepareService prepares a LoadBalancer Service that exposes the ports of the listeners of the Gateway.
+func prepareService(gw *gatewayv1.Gateway, gc *gatewayv1.GatewayClass, namespace string) *apiv1.Service {
+	meta := prepareObjectMeta(gw, gc, namespace, nil)
+
+	ports := make([]apiv1.ServicePort, 0, len(gw.Spec.Listeners))
+	seenPorts := make(map[gatewayv1.PortNumber]struct{}, len(gw.Spec.Listeners))
+
+	for _, l := range gw.Spec.Listeners {
+		if _, exists := seenPorts[l.Port]; exists {
+			continue
+		}
+		seenPorts[l.Port] = struct{}{}
+
+		protocol := apiv1.ProtocolTCP
+		if l.Protocol == gatewayv1.UDPProtocolType {
+			protocol = apiv1.ProtocolUDP
+		}
+
+		ports = append(ports, apiv1.ServicePort{
+			Name:       fmt.Sprintf("port-%d", l.Port),
+			Protocol:   protocol,
+			Port:       int32(l.Port),
+			TargetPort: intstr.FromInt32(int32(l.Port)),
+		})
+	}
+
+	return &apiv1.Service{
+		ObjectMeta: meta,
+		Spec: apiv1.ServiceSpec{
+			Type:     apiv1.ServiceTypeLoadBalancer,
+			Selector: map[string]string{"app": meta.Name},
+			Ports:    ports,
+		},
+	}
+}
+
+// updateService updates the type, selector and ports of the existing Service.
+// The node ports allocated by Kubernetes are preserved.
+func updateService(existing, desired *apiv1.Service) {
+	nodePorts := make(map[int32]int32, len(existing.Spec.Ports))
+	for _, p := range existing.Spec.Ports {
+		nodePorts[p.Port] = p.NodePort
+	}
+
+	ports := make([]apiv1.ServicePort, 0, len(desired.Spec.Ports))
+	for _, p := range desired.Spec.Ports {
+		p.NodePort = nodePorts[p.Port]
+		ports = append(ports, p)
+	}
+
+	existing.Spec.Type = desired.Spec.Type
+	existing.Spec.Selector = desired.Spec.Selector
+	existing.Spec.Ports = ports
+}
+
+func prepareServiceAccount(gw *gatewayv1.Gateway, gc *gatewayv1.GatewayClass, namespace string) *apiv1.ServiceAccount {
+	return &apiv1.ServiceAccount{
+		ObjectMeta: prepareObjectMeta(gw, gc, namespace, nil),
+	}
+}
+
+// prepareClusterRoleBinding prepares a ClusterRoleBinding that grants the ServiceAccount of the Gateway
+// the permissions of the static mode.
+func prepareClusterRoleBinding(
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	namespace string,
+) *rbacv1.ClusterRoleBinding {
+	meta := prepareObjectMeta(gw, gc, "", nil)
+
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: meta,
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     staticModeClusterRoleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      meta.Name,
+				Namespace: namespace,
+			},
+		},
+	}
+}
+
+// prepareRole prepares a Role that allows patching the Service of the Gateway. The static mode patches its Service
+// to request the addresses of the Gateway, and the ClusterRole of the static mode doesn't allow patching Services.
+func prepareRole(gw *gatewayv1.Gateway, gc *gatewayv1.GatewayClass, namespace string) *rbacv1.Role {
+	meta := prepareObjectMeta(gw, gc, namespace, nil)
+
+	return &rbacv1.Role{
+		ObjectMeta: meta,
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{""},
+				Resources:     []string{"services"},
+				ResourceNames: []string{meta.Name},
+				Verbs:         []string{"patch"},
+			},
+		},
+	}
+}
+
+// prepareRoleBinding prepares a RoleBinding that grants the ServiceAccount of the Gateway the permissions
+// of its Role.
+func prepareRoleBinding(gw *gatewayv1.Gateway, gc *gatewayv1.GatewayClass, namespace string) *rbacv1.RoleBinding {
+	meta := prepareObjectMeta(gw, gc, namespace, nil)
+
+	return &rbacv1.RoleBinding{
+		ObjectMeta: meta,
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     meta.Name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      meta.Name,
+				Namespace: namespace,
+			},
+		},
+	}
+}
+
+func preparePodDisruptionBudget(
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	namespace string,
+) *policyv1.PodDisruptionBudget {
+	meta := prepareObjectMeta(gw, gc, namespace, nil)
+	maxUnavailable := intstr.FromInt32(1)
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: meta,
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": meta.Name},
+			},
+		},
+	}
+}
+
+func prepareHPA(
+	gw *gatewayv1.Gateway,
+	gc *gatewayv1.GatewayClass,
+	namespace string,
+	hpaCfg HPAConfig,
+) *autoscalingv2.HorizontalPodAutoscaler {
+	meta := prepareObjectMeta(gw, gc, namespace, nil)
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: meta,
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       meta.Name,
+			},
+			MinReplicas: helpers.GetPointer[int32](1),
+			MaxReplicas: hpaCfg.MaxReplicas,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name: apiv1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{
+							Type:               autoscalingv2.UtilizationMetricType,
+							AverageUtilization: helpers.GetPointer(hpaCfg.TargetCPUUtilizationPercentage),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getInfrastructureLabels(gw *gatewayv1.Gateway) map[string]string {
+	if gw.Spec.Infrastructure == nil {
+		return nil
+	}
+
+	labels := make(map[string]string, len(gw.Spec.Infrastructure.Labels))
+	for k, v := range gw.Spec.Infrastructure.Labels {
+		labels[string(k)] = string(v)
+	}
+
+	return labels
+}
+
+func getInfrastructureAnnotations(gw *gatewayv1.Gateway) map[string]string {
+	if gw.Spec.Infrastructure == nil || len(gw.Spec.Infrastructure.Annotations) == 0 {
+		return nil
+	}
+
+	annotations := make(map[string]string, len(gw.Spec.Infrastructure.Annotations))
+	for k, v := range gw.Spec.Infrastructure.Annotations {
+		annotations[string(k)] = string(v)
+	}
+
+	return annotations
+}
+
+// mergeMaps returns a new map with the entries of both maps. The entries of the second map take precedence.
+// If both maps are empty, it returns nil.
+func mergeMaps(first, second map[string]string) map[string]string {
+	if len(first) == 0 && len(second) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]string, len(first)+len(second))
+
+	for k, v := range first {
+		merged[k] = v
+	}
+
+	for k, v := range second {
+		merged[k] = v
+	}
+
+	return merged
+}
@@ -5,12 +5,18 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -28,10 +34,12 @@ type Config struct {
 	Logger           logr.Logger
 	GatewayClassName string
 	GatewayCtlrName  string
+	// HPA is the configuration of the HorizontalPodAutoscaler of each provisioned Deployment.
+	HPA HPAConfig
 }
 
 // StartManager starts a Manager for the provisioner mode, which provisions
-// a Deployment of NGF (static mode) for each Gateway of the provisioner GatewayClass.
+// a Deployment of NGF (static mode) and its infrastructure for each Gateway of the provisioner GatewayClass.
 //
 // The provisioner mode is introduced to allow running Gateway API conformance tests for NGF, which expects
 // an independent data plane instance being provisioned for each Gateway.
@@ -43,10 +51,36 @@ func StartManager(cfg Config) error {
 	utilruntime.Must(gatewayv1.AddToScheme(scheme))
 	utilruntime.Must(v1.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+	utilruntime.Must(rbacv1.AddToScheme(scheme))
+	utilruntime.Must(policyv1.AddToScheme(scheme))
+	utilruntime.Must(autoscalingv2.AddToScheme(scheme))
+
+	// The cache only includes the provisioned resources, so that the provisioner doesn't need to cache
+	// all resources of those types in the cluster.
+	provisionedSelector := labels.SelectorFromSet(labels.Set{managedByLabel: managedByLabelValue})
+	provisionedObjects := []client.Object{
+		&v1.Deployment{},
+		&apiv1.Service{},
+		&apiv1.ServiceAccount{},
+		&rbacv1.ClusterRoleBinding{},
+		&rbacv1.Role{},
+		&rbacv1.RoleBinding{},
+		&policyv1.PodDisruptionBudget{},
+		&autoscalingv2.HorizontalPodAutoscaler{},
+	}
+
+	byObject := make(map[client.Object]cache.ByObject, len(provisionedObjects))
+	for _, obj := range provisionedObjects {
+		byObject[obj] = cache.ByObject{Label: provisionedSelector}
+	}
 
 	options := manager.Options{
 		Scheme: scheme,
 		Logger: cfg.Logger,
+		Cache: cache.Options{
+			ByObject: byObject,
+		},
 	}
 	clusterCfg := ctlr.GetConfigOrDie()
 
@@ -62,10 +96,12 @@ func StartManager(cfg Config) error {
 
 	// Note: for any new object type or a change to the existing one,
 	// make sure to also update firstBatchPreparer creation below
-	controllerRegCfgs := []struct {
+	type ctlrCfg struct {
 		objectType client.Object
 		options    []controller.Option
-	}{
+	}
+
+	controllerRegCfgs := []ctlrCfg{
 		{
 			objectType: &gatewayv1.GatewayClass{},
 			options: []controller.Option{
@@ -86,6 +122,11 @@ func StartManager(cfg Config) error {
 		},
 	}
 
+	// The events of the provisioned resources make the provisioner fix the drift from the desired state.
+	for _, obj := range provisionedObjects {
+		controllerRegCfgs = append(controllerRegCfgs, ctlrCfg{objectType: obj})
+	}
+
 	ctx := ctlr.SetupSignalHandler()
 	eventCh := make(chan interface{})
 
@@ -130,7 +171,10 @@ func StartManager(cfg Config) error {
 		cfg.GatewayClassName,
 		statusUpdater,
 		mgr.GetClient(),
+		mgr.GetAPIReader(),
+		eventCh,
 		embeddedfiles.StaticModeDeploymentYAML,
+		cfg.HPA,
 		func() metav1.Time { return metav1.Now() },
 	)
 
@@ -139,6 +183,8 @@ func StartManager(cfg Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		handler,
 		firstBatchPreparer,
+		nil, /* batching */
+		nil, /* metricsCollector */
 	)
 
 	if err := mgr.Add(eventLoop); err != nil {
@@ -0,0 +1,174 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+)
+
+const (
+	// gatewayReasonDeploymentFailed is used with the Programmed condition when the provisioner fails to
+	// provision the infrastructure of the Gateway.
+	gatewayReasonDeploymentFailed gatewayv1.GatewayConditionReason = "DeploymentFailed"
+)
+
+// newGatewayAccepted returns a Condition that indicates that the Gateway is accepted by the provisioner.
+func newGatewayAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(gatewayv1.GatewayConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(gatewayv1.GatewayReasonAccepted),
+		Message: "Gateway is accepted",
+	}
+}
+
+// newGatewayDeploymentFailed returns a Condition that indicates that the provisioner failed to provision
+// the infrastructure of the Gateway.
+func newGatewayDeploymentFailed(err error) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(gatewayv1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gatewayReasonDeploymentFailed),
+		Message: fmt.Sprintf("Failed to provision the data plane: %v", err),
+	}
+}
+
+// newGatewayDeploymentPending returns a Condition that indicates that the data plane of the Gateway is
+// provisioned but not available yet.
+func newGatewayDeploymentPending() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(gatewayv1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gatewayv1.GatewayReasonPending),
+		Message: "Waiting for the data plane Deployment to become available",
+	}
+}
+
+// setGatewayStatuses sets the statuses of the Gateways of the GatewayClass until their data plane becomes
+// available. After that, the provisioned NGF owns the status of its Gateway, so the provisioner doesn't touch it,
+// even if it fails to provision the Gateway.
+// provisionErrs includes the errors of the Gateways that the provisioner failed to provision.
+func (h *eventHandler) setGatewayStatuses(
+	ctx context.Context,
+	logger logr.Logger,
+	provisionErrs map[types.NamespacedName]error,
+) {
+	var reqs []status.UpdateRequest
+
+	for nsname, gw := range h.store.gateways {
+		if string(gw.Spec.GatewayClassName) != h.gcName {
+			continue
+		}
+
+		available, err := h.isDeploymentAvailable(ctx, nsname)
+		if err != nil {
+			logger.Error(err, "Failed to get the deployment of the gateway", "gateway", nsname)
+			continue
+		}
+
+		// Once the Deployment is available, the provisioned NGF reports the status of the Gateway, even if
+		// the provisioner later fails to update the infrastructure. The provisioner retries and logs the failure,
+		// but it doesn't overwrite the status that NGF owns.
+		if available {
+			continue
+		}
+
+		addresses, err := h.getGatewayAddresses(ctx, nsname)
+		if err != nil {
+			logger.Error(err, "Failed to get the addresses of the gateway", "gateway", nsname)
+			continue
+		}
+
+		conds := []conditions.Condition{newGatewayAccepted(), newGatewayDeploymentPending()}
+		if err, failed := provisionErrs[nsname]; failed {
+			conds = []conditions.Condition{newGatewayAccepted(), newGatewayDeploymentFailed(err)}
+		}
+
+		reqs = append(reqs, status.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &gatewayv1.Gateway{},
+			Setter: func(obj client.Object) bool {
+				gw := helpers.MustCastObject[*gatewayv1.Gateway](obj)
+
+				gwConds := conditions.ConvertConditions(conds, gw.Generation, h.timeNow())
+
+				if status.ConditionsEqual(gw.Status.Conditions, gwConds) &&
+					reflect.DeepEqual(gw.Status.Addresses, addresses) {
+					return false
+				}
+
+				gw.Status.Conditions = gwConds
+				gw.Status.Addresses = addresses
+
+				return true
+			},
+		})
+	}
+
+	h.statusUpdater.Update(ctx, reqs...)
+}
+
+// isDeploymentAvailable returns true if the provisioned Deployment of the Gateway has available replicas.
+func (h *eventHandler) isDeploymentAvailable(ctx context.Context, gwNsName types.NamespacedName) (bool, error) {
+	dep, exists := h.provisions[gwNsName]
+	if !exists {
+		return false, nil
+	}
+
+	var existing v1.Deployment
+
+	if err := h.k8sClient.Get(ctx, client.ObjectKeyFromObject(dep), &existing); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return existing.Status.AvailableReplicas > 0, nil
+}
+
+// getGatewayAddresses returns the addresses of the provisioned LoadBalancer Service of the Gateway.
+// The addresses are empty until the load balancer is provisioned.
+func (h *eventHandler) getGatewayAddresses(
+	ctx context.Context,
+	gwNsName types.NamespacedName,
+) ([]gatewayv1.GatewayStatusAddress, error) {
+	dep, exists := h.provisions[gwNsName]
+	if !exists {
+		return nil, nil
+	}
+
+	var svc apiv1.Service
+
+	if err := h.k8sClient.Get(ctx, client.ObjectKeyFromObject(dep), &svc); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	var addresses []gatewayv1.GatewayStatusAddress
+
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		switch {
+		case ingress.IP != "":
+			addresses = append(addresses, gatewayv1.GatewayStatusAddress{
+				Type:  helpers.GetPointer(gatewayv1.IPAddressType),
+				Value: ingress.IP,
+			})
+		case ingress.Hostname != "":
+			addresses = append(addresses, gatewayv1.GatewayStatusAddress{
+				Type:  helpers.GetPointer(gatewayv1.HostnameAddressType),
+				Value: ingress.Hostname,
+			})
+		}
+	}
+
+	return addresses, nil
+}
@@ -3,6 +3,11 @@ package provisioner
 import (
 	"fmt"
 
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apiv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +42,10 @@ func (s *store) update(batch events.EventBatch) {
 				s.gateways[client.ObjectKeyFromObject(obj)] = obj
 			case *metav1.PartialObjectMetadata:
 				s.crdMetadata[client.ObjectKeyFromObject(obj)] = obj
+			case *appsv1.Deployment, *apiv1.Service, *apiv1.ServiceAccount, *rbacv1.ClusterRoleBinding,
+				*rbacv1.Role, *rbacv1.RoleBinding, *policyv1.PodDisruptionBudget, *autoscalingv2.HorizontalPodAutoscaler:
+				// The provisioned resources are not stored. Their events only make the provisioner fix the drift
+				// from the desired state.
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Resource))
 			}
@@ -48,9 +57,14 @@ func (s *store) update(batch events.EventBatch) {
 				delete(s.gateways, e.NamespacedName)
 			case *metav1.PartialObjectMetadata:
 				delete(s.crdMetadata, e.NamespacedName)
+			case *appsv1.Deployment, *apiv1.Service, *apiv1.ServiceAccount, *rbacv1.ClusterRoleBinding,
+				*rbacv1.Role, *rbacv1.RoleBinding, *policyv1.PodDisruptionBudget, *autoscalingv2.HorizontalPodAutoscaler:
+				// The provisioned resources are not stored. The provisioner recreates them.
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Type))
 			}
+		case *retryEvent:
+			// The retry doesn't change the cluster state.
 		default:
 			panic(fmt.Errorf("unknown event type %T", e))
 		}
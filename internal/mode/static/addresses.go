@@ -0,0 +1,270 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// requestedAddressesAnnotation is the annotation of the NGF Service with the IP address that NGF requested
+// for the Gateway. It allows NGF to remove only its own address from the Service when the Gateway no longer
+// requests it.
+const requestedAddressesAnnotation = "gateway.nginx.org/requested-addresses"
+
+// gatewayAddressRequester requests the addresses from spec.addresses of the Gateway for the NGF Service.
+// Before it is enabled, it only saves the latest requested addresses, so that only the leader writes to the Service.
+// When it is enabled, it requests the saved addresses. Note: it can only be enabled once.
+type gatewayAddressRequester struct {
+	k8sClient client.Client
+	logger    logr.Logger
+	requested []gatewayv1.GatewayAddress
+	svcNsName types.NamespacedName
+	lock      sync.Mutex
+	enabled   bool
+}
+
+// newGatewayAddressRequester creates a new gatewayAddressRequester for the Service with the provided
+// namespaced name.
+func newGatewayAddressRequester(
+	k8sClient client.Client,
+	svcNsName types.NamespacedName,
+	logger logr.Logger,
+) *gatewayAddressRequester {
+	return &gatewayAddressRequester{
+		k8sClient: k8sClient,
+		svcNsName: svcNsName,
+		logger:    logger,
+	}
+}
+
+// Enable enables the gatewayAddressRequester, requesting the latest saved addresses for the Service.
+func (r *gatewayAddressRequester) Enable(ctx context.Context) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.enabled {
+		panic(errors.New("gatewayAddressRequester can only be enabled once"))
+	}
+
+	r.enabled = true
+
+	var svc v1.Service
+	if err := r.k8sClient.Get(ctx, r.svcNsName, &svc); err != nil {
+		r.logger.Error(err, "Failed to get the Service to request the addresses of the Gateway")
+		return
+	}
+
+	ip, err := getRequestedIP(&svc, r.requested)
+	if err == nil {
+		_, err = requestAddress(ctx, r.k8sClient, &svc, ip)
+	}
+
+	if err != nil {
+		r.logger.Error(err, "Failed to request the addresses of the Gateway")
+	}
+}
+
+// Request requests the addresses for the Service and returns the updated Service.
+// The addresses are validated on every replica, so that all replicas report the same problems.
+// Before the gatewayAddressRequester is enabled, the Service is not updated.
+func (r *gatewayAddressRequester) Request(
+	ctx context.Context,
+	svc *v1.Service,
+	requested []gatewayv1.GatewayAddress,
+) (*v1.Service, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.requested = requested
+
+	ip, err := getRequestedIP(svc, requested)
+	if err != nil {
+		return svc, err
+	}
+
+	if !r.enabled {
+		return svc, nil
+	}
+
+	return requestAddress(ctx, r.k8sClient, svc, ip)
+}
+
+// prepareGatewayAddresses requests the addresses from spec.addresses of the Gateway for the NGF Service.
+// It returns the addresses for the Gateway status and the Conditions that report the problems
+// with the requested addresses.
+func (h *eventHandlerImpl) prepareGatewayAddresses(
+	ctx context.Context,
+	logger logr.Logger,
+	svc *v1.Service,
+	gateway *graph.Gateway,
+) ([]gatewayv1.GatewayStatusAddress, []conditions.Condition) {
+	if svc == nil {
+		var gwSvc v1.Service
+
+		key := types.NamespacedName{
+			Name:      h.cfg.gatewayPodConfig.ServiceName,
+			Namespace: h.cfg.gatewayPodConfig.Namespace,
+		}
+		if err := h.cfg.k8sClient.Get(ctx, key, &gwSvc); err == nil {
+			svc = &gwSvc
+		}
+	}
+
+	requested := getRequestedAddresses(gateway)
+
+	var addressConds []conditions.Condition
+
+	if svc != nil {
+		var err error
+
+		svc, err = h.cfg.addressRequester.Request(ctx, svc, requested)
+		if err != nil {
+			logger.Error(err, "Failed to request the addresses of the Gateway")
+			addressConds = append(addressConds, staticConds.NewGatewayAddressNotUsable(err.Error()))
+		}
+	}
+
+	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, svc, h.cfg.gatewayPodConfig)
+	if err != nil {
+		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
+	}
+
+	if len(addressConds) == 0 {
+		addressConds = validateAssignedAddresses(requested, gwAddresses)
+	}
+
+	return gwAddresses, addressConds
+}
+
+// getRequestedAddresses returns the addresses requested in spec.addresses of the Gateway.
+// The addresses of an invalid Gateway are ignored.
+func getRequestedAddresses(gateway *graph.Gateway) []gatewayv1.GatewayAddress {
+	if gateway == nil || !gateway.Valid {
+		return nil
+	}
+
+	return gateway.Source.Spec.Addresses
+}
+
+// getRequestedIP returns the IP address from spec.addresses of the Gateway that the Service requests, or an empty
+// string if no IP address is requested.
+// Only a LoadBalancer Service can request an IP address, in spec.loadBalancerIP, so only one IP address is supported.
+// NGF doesn't set spec.externalIPs of other Services, because any user who can set the addresses of a Gateway
+// could then intercept the traffic to those IP addresses in the cluster (CVE-2020-8554).
+// Hostname addresses can't be requested; they are honored only if the load balancer assigns them.
+func getRequestedIP(svc *v1.Service, requested []gatewayv1.GatewayAddress) (string, error) {
+	var ips []string
+
+	for _, addr := range requested {
+		if addr.Type == nil || *addr.Type == gatewayv1.IPAddressType {
+			ips = append(ips, addr.Value)
+		}
+	}
+
+	if len(ips) == 0 {
+		return "", nil
+	}
+
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+		return "", fmt.Errorf(
+			"the Service %s/%s of type %s can't request IP addresses; only a LoadBalancer Service can",
+			svc.Namespace,
+			svc.Name,
+			svc.Spec.Type,
+		)
+	}
+
+	if len(ips) > 1 {
+		return "", fmt.Errorf(
+			"the LoadBalancer Service %s/%s supports only one requested IP address, got %s",
+			svc.Namespace,
+			svc.Name,
+			strings.Join(ips, ", "),
+		)
+	}
+
+	return ips[0], nil
+}
+
+// requestAddress patches the Service to request the IP address in spec.loadBalancerIP. An empty IP address
+// removes the IP address that NGF requested before, if any.
+// It returns the patched Service.
+func requestAddress(
+	ctx context.Context,
+	k8sClient client.Client,
+	svc *v1.Service,
+	ip string,
+) (*v1.Service, error) {
+	patched := svc.DeepCopy()
+
+	if previous := svc.Annotations[requestedAddressesAnnotation]; previous != "" &&
+		previous == patched.Spec.LoadBalancerIP {
+		patched.Spec.LoadBalancerIP = ""
+	}
+
+	if ip != "" {
+		patched.Spec.LoadBalancerIP = ip
+
+		if patched.Annotations == nil {
+			patched.Annotations = make(map[string]string)
+		}
+		patched.Annotations[requestedAddressesAnnotation] = ip
+	} else {
+		delete(patched.Annotations, requestedAddressesAnnotation)
+	}
+
+	if equality.Semantic.DeepEqual(svc, patched) {
+		return svc, nil
+	}
+
+	if err := k8sClient.Patch(ctx, patched, client.MergeFrom(svc)); err != nil {
+		return svc, fmt.Errorf("failed to patch the Service %s/%s: %w", svc.Namespace, svc.Name, err)
+	}
+
+	return patched, nil
+}
+
+// validateAssignedAddresses returns a Condition if any of the requested addresses is not assigned to the Gateway.
+func validateAssignedAddresses(
+	requested []gatewayv1.GatewayAddress,
+	assigned []gatewayv1.GatewayStatusAddress,
+) []conditions.Condition {
+	var notAssigned []string
+
+	for _, addr := range requested {
+		addrType := gatewayv1.IPAddressType
+		if addr.Type != nil {
+			addrType = *addr.Type
+		}
+
+		found := slices.ContainsFunc(assigned, func(a gatewayv1.GatewayStatusAddress) bool {
+			return a.Type != nil && *a.Type == addrType && a.Value == addr.Value
+		})
+
+		if !found {
+			notAssigned = append(notAssigned, addr.Value)
+		}
+	}
+
+	if len(notAssigned) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("The requested addresses are not assigned: %s", strings.Join(notAssigned, ", "))
+
+	return []conditions.Condition{staticConds.NewGatewayAddressNotAssigned(msg)}
+}
@@ -0,0 +1,252 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createAddressesService(svcType v1.ServiceType, modify func(svc *v1.Service)) *v1.Service {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "nginx-gateway",
+			Name:      "nginx-gateway",
+		},
+		Spec: v1.ServiceSpec{
+			Type: svcType,
+		},
+	}
+
+	if modify != nil {
+		modify(svc)
+	}
+
+	return svc
+}
+
+func ipAddress(value string) gatewayv1.GatewayAddress {
+	return gatewayv1.GatewayAddress{Type: helpers.GetPointer(gatewayv1.IPAddressType), Value: value}
+}
+
+func TestGetRequestedIP(t *testing.T) {
+	tests := []struct {
+		svc       *v1.Service
+		name      string
+		expIP     string
+		requested []gatewayv1.GatewayAddress
+		expErr    bool
+	}{
+		{
+			name: "no requested addresses",
+			svc:  createAddressesService(v1.ServiceTypeNodePort, nil),
+		},
+		{
+			name:      "LoadBalancer Service requests IP address",
+			svc:       createAddressesService(v1.ServiceTypeLoadBalancer, nil),
+			requested: []gatewayv1.GatewayAddress{{Value: "10.0.0.1"}},
+			expIP:     "10.0.0.1",
+		},
+		{
+			name: "hostname addresses are ignored",
+			svc:  createAddressesService(v1.ServiceTypeNodePort, nil),
+			requested: []gatewayv1.GatewayAddress{
+				{Type: helpers.GetPointer(gatewayv1.HostnameAddressType), Value: "example.com"},
+			},
+		},
+		{
+			name: "LoadBalancer Service with multiple requested IP addresses",
+			svc:  createAddressesService(v1.ServiceTypeLoadBalancer, nil),
+			requested: []gatewayv1.GatewayAddress{
+				ipAddress("10.0.0.1"),
+				ipAddress("10.0.0.2"),
+			},
+			expErr: true,
+		},
+		{
+			name:      "NodePort Service can't request IP addresses",
+			svc:       createAddressesService(v1.ServiceTypeNodePort, nil),
+			requested: []gatewayv1.GatewayAddress{ipAddress("10.0.0.1")},
+			expErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ip, err := getRequestedIP(test.svc, test.requested)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(ip).To(Equal(test.expIP))
+		})
+	}
+}
+
+func TestRequestAddress(t *testing.T) {
+	tests := []struct {
+		svc             *v1.Service
+		name            string
+		ip              string
+		expLBIP         string
+		expAnnotation   string
+		expAnnotationOK bool
+	}{
+		{
+			name:            "IP address is requested",
+			svc:             createAddressesService(v1.ServiceTypeLoadBalancer, nil),
+			ip:              "10.0.0.1",
+			expLBIP:         "10.0.0.1",
+			expAnnotation:   "10.0.0.1",
+			expAnnotationOK: true,
+		},
+		{
+			name: "IP address is no longer requested",
+			svc: createAddressesService(v1.ServiceTypeLoadBalancer, func(svc *v1.Service) {
+				svc.Annotations = map[string]string{requestedAddressesAnnotation: "10.0.0.1"}
+				svc.Spec.LoadBalancerIP = "10.0.0.1"
+			}),
+		},
+		{
+			name: "requested IP address changes",
+			svc: createAddressesService(v1.ServiceTypeLoadBalancer, func(svc *v1.Service) {
+				svc.Annotations = map[string]string{requestedAddressesAnnotation: "10.0.0.1"}
+				svc.Spec.LoadBalancerIP = "10.0.0.1"
+			}),
+			ip:              "10.0.0.2",
+			expLBIP:         "10.0.0.2",
+			expAnnotation:   "10.0.0.2",
+			expAnnotationOK: true,
+		},
+		{
+			name: "LoadBalancer IP set by user is kept",
+			svc: createAddressesService(v1.ServiceTypeLoadBalancer, func(svc *v1.Service) {
+				svc.Spec.LoadBalancerIP = "10.0.0.5"
+			}),
+			expLBIP: "10.0.0.5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			k8sClient := fake.NewFakeClient(test.svc)
+
+			result, err := requestAddress(context.Background(), k8sClient, test.svc, test.ip)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var svc v1.Service
+			g.Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(test.svc), &svc)).To(Succeed())
+
+			g.Expect(svc.Spec.LoadBalancerIP).To(Equal(test.expLBIP))
+
+			annotation, exists := svc.Annotations[requestedAddressesAnnotation]
+			g.Expect(exists).To(Equal(test.expAnnotationOK))
+			g.Expect(annotation).To(Equal(test.expAnnotation))
+
+			g.Expect(result.Spec).To(Equal(svc.Spec))
+		})
+	}
+}
+
+func TestGatewayAddressRequester(t *testing.T) {
+	g := NewWithT(t)
+
+	svc := createAddressesService(v1.ServiceTypeLoadBalancer, nil)
+	k8sClient := fake.NewFakeClient(svc)
+
+	requester := newGatewayAddressRequester(k8sClient, client.ObjectKeyFromObject(svc), logr.Discard())
+
+	getLBIP := func() string {
+		var current v1.Service
+		g.Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(svc), &current)).To(Succeed())
+		return current.Spec.LoadBalancerIP
+	}
+
+	// Before the requester is enabled, the addresses are validated, but the Service is not updated.
+	_, err := requester.Request(
+		context.Background(),
+		svc,
+		[]gatewayv1.GatewayAddress{ipAddress("10.0.0.1"), ipAddress("10.0.0.2")},
+	)
+	g.Expect(err).To(HaveOccurred())
+
+	result, err := requester.Request(context.Background(), svc, []gatewayv1.GatewayAddress{ipAddress("10.0.0.1")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(svc))
+	g.Expect(getLBIP()).To(BeEmpty())
+
+	// When the requester is enabled, it requests the latest saved addresses.
+	requester.Enable(context.Background())
+	g.Expect(getLBIP()).To(Equal("10.0.0.1"))
+
+	// After the requester is enabled, it requests the addresses immediately.
+	var current v1.Service
+	g.Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(svc), &current)).To(Succeed())
+
+	result, err = requester.Request(context.Background(), &current, []gatewayv1.GatewayAddress{ipAddress("10.0.0.2")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Spec.LoadBalancerIP).To(Equal("10.0.0.2"))
+	g.Expect(getLBIP()).To(Equal("10.0.0.2"))
+
+	g.Expect(func() { requester.Enable(context.Background()) }).To(Panic())
+}
+
+func TestValidateAssignedAddresses(t *testing.T) {
+	assigned := []gatewayv1.GatewayStatusAddress{
+		{Type: helpers.GetPointer(gatewayv1.IPAddressType), Value: "10.0.0.1"},
+		{Type: helpers.GetPointer(gatewayv1.HostnameAddressType), Value: "example.com"},
+	}
+
+	tests := []struct {
+		name      string
+		requested []gatewayv1.GatewayAddress
+		expConds  []conditions.Condition
+	}{
+		{
+			name: "no requested addresses",
+		},
+		{
+			name: "all requested addresses are assigned",
+			requested: []gatewayv1.GatewayAddress{
+				{Value: "10.0.0.1"},
+				{Type: helpers.GetPointer(gatewayv1.HostnameAddressType), Value: "example.com"},
+			},
+		},
+		{
+			name: "some requested addresses are not assigned",
+			requested: []gatewayv1.GatewayAddress{
+				{Value: "10.0.0.1"},
+				{Value: "10.0.0.2"},
+				{Type: helpers.GetPointer(gatewayv1.HostnameAddressType), Value: "other.example.com"},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayAddressNotAssigned(
+					"The requested addresses are not assigned: 10.0.0.2, other.example.com",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(validateAssignedAddresses(test.requested, assigned)).To(Equal(test.expConds))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -32,20 +33,30 @@ type Config struct {
 	ConfigName string
 	// GatewayClassName is the name of the GatewayClass resource that the Gateway will use.
 	GatewayClassName string
+	// WatchNamespaces specifies the Namespaces in which the control plane watches resources.
+	WatchNamespaces WatchNamespacesConfig
 	// LeaderElection contains the configuration for leader election.
 	LeaderElection LeaderElectionConfig
+	// Webhook specifies the validating admission webhook config.
+	Webhook WebhookConfig
 	// ProductTelemetryConfig contains the configuration for collecting product telemetry.
 	ProductTelemetryConfig ProductTelemetryConfig
 	// MetricsConfig specifies the metrics config.
 	MetricsConfig MetricsConfig
 	// HealthConfig specifies the health probe config.
 	HealthConfig HealthConfig
+	// EventBatching specifies how the control plane batches events.
+	EventBatching EventBatchingConfig
 	// UpdateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	UpdateGatewayClassStatus bool
 	// Plus indicates whether NGINX Plus is being used.
 	Plus bool
 	// ExperimentalFeatures indicates if experimental features are enabled.
 	ExperimentalFeatures bool
+	// SnippetsFilters indicates if SnippetsFilters are enabled.
+	SnippetsFilters bool
+	// ErrorPagePolicies indicates if ErrorPagePolicies are enabled.
+	ErrorPagePolicies bool
 }
 
 // GatewayPodConfig contains information about this Pod.
@@ -58,6 +69,41 @@ type GatewayPodConfig struct {
 	Namespace string
 	// Name is the name of the Pod.
 	Name string
+	// NodeName is the name of the Node the Pod runs on.
+	NodeName string
+}
+
+// WebhookConfig specifies the validating admission webhook config.
+type WebhookConfig struct {
+	// SecretName is the name of the Secret in the Namespace of the Pod that stores the webhook certificates.
+	SecretName string
+	// ServiceName is the name of the Service in the Namespace of the Pod that fronts the webhook.
+	ServiceName string
+	// ConfigurationName is the name of the ValidatingWebhookConfiguration that gets the CA certificate.
+	ConfigurationName string
+	// Port is the port that the webhook server listens on.
+	Port int
+	// Enabled is the flag for toggling the webhook on or off.
+	Enabled bool
+}
+
+// EventBatchingConfig specifies how the control plane batches events.
+// The NginxGateway resource can override it.
+type EventBatchingConfig struct {
+	// Window is how long the control plane waits for another event before it processes a batch of events.
+	Window time.Duration
+	// MaxSize is the number of events at which the control plane stops waiting for the window.
+	MaxSize int
+}
+
+// WatchNamespacesConfig specifies the Namespaces in which the control plane watches routes, Services,
+// EndpointSlices, Secrets, ConfigMaps and ReferenceGrants. If neither Names nor Selector is set,
+// the control plane watches all Namespaces.
+type WatchNamespacesConfig struct {
+	// Selector selects the watched Namespaces by their labels.
+	Selector labels.Selector
+	// Names are the names of the watched Namespaces.
+	Names []string
 }
 
 // MetricsConfig specifies the metrics config.
@@ -68,6 +114,8 @@ type MetricsConfig struct {
 	Enabled bool
 	// Secure is the flag for toggling the metrics endpoint to https.
 	Secure bool
+	// DebugEndpoint is the flag for toggling the debug endpoints on the metrics server on or off.
+	DebugEndpoint bool
 }
 
 // HealthConfig specifies the health probe config.
@@ -90,8 +138,12 @@ type LeaderElectionConfig struct {
 
 // ProductTelemetryConfig contains the configuration for collecting product telemetry.
 type ProductTelemetryConfig struct {
+	// Exporter is the type of the exporter that sends the telemetry reports.
+	Exporter ProductTelemetryExporter
 	// Endpoint is the <host>:<port> of the telemetry service.
 	Endpoint string
+	// FilePath is the path of the file to which the file exporter writes the telemetry reports.
+	FilePath string
 	// ReportPeriod is the period at which telemetry reports are sent.
 	ReportPeriod time.Duration
 	// EndpointInsecure controls if TLS should be used for the telemetry service.
@@ -100,6 +152,21 @@ type ProductTelemetryConfig struct {
 	Enabled bool
 }
 
+// ProductTelemetryExporter is the type of the exporter that sends the product telemetry reports.
+type ProductTelemetryExporter string
+
+const (
+	// ProductTelemetryExporterOTLPGRPC sends the reports to the Endpoint using OTLP over gRPC.
+	// If the Endpoint is empty, the reports are logged instead.
+	ProductTelemetryExporterOTLPGRPC ProductTelemetryExporter = "otlp-grpc"
+	// ProductTelemetryExporterOTLPHTTP sends the reports to the Endpoint using OTLP over HTTP.
+	ProductTelemetryExporterOTLPHTTP ProductTelemetryExporter = "otlp-http"
+	// ProductTelemetryExporterFile writes the reports as JSON lines to a rotated local file.
+	ProductTelemetryExporterFile ProductTelemetryExporter = "file"
+	// ProductTelemetryExporterLogging logs the reports.
+	ProductTelemetryExporterLogging ProductTelemetryExporter = "logging"
+)
+
 // UsageReportConfig contains the configuration for NGINX Plus usage reporting.
 type UsageReportConfig struct {
 	// SecretNsName is the namespaced name of the Secret containing the server credentials.
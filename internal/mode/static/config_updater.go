@@ -3,6 +3,9 @@ package static
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	apiv1 "k8s.io/api/core/v1"
@@ -12,17 +15,28 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . eventBatchingSetter
+
+// eventBatchingSetter defines an interface for setting how the event loop batches events.
+type eventBatchingSetter interface {
+	Store(events.BatchingConfig)
+}
+
 // updateControlPlane updates the control plane configuration with the given user spec.
 // If any fields are not set within the user spec, the default configuration values are used.
+// The default event batching configuration comes from the command-line flags.
 func updateControlPlane(
 	cfg *ngfAPI.NginxGateway,
 	logger logr.Logger,
 	eventRecorder record.EventRecorder,
 	configNSName types.NamespacedName,
 	logLevelSetter logLevelSetter,
+	batchingSetter eventBatchingSetter,
+	defaultBatching events.BatchingConfig,
 ) error {
 	// build up default configuration
 	controlConfig := ngfAPI.NginxGatewaySpec{
@@ -72,9 +86,73 @@ func updateControlPlane(
 		)
 	}
 
+	batching, err := buildEventBatchingConfig(controlConfig.EventBatching, defaultBatching)
+	if err != nil {
+		return err
+	}
+
+	batchingSetter.Store(batching)
+
 	return nil
 }
 
+// buildEventBatchingConfig builds the event batching configuration from the user spec.
+// Fields that are not set within the user spec are set to their default values.
+func buildEventBatchingConfig(
+	spec *ngfAPI.EventBatching,
+	defaultBatching events.BatchingConfig,
+) (events.BatchingConfig, error) {
+	batching := defaultBatching
+
+	if spec == nil {
+		return batching, nil
+	}
+
+	if spec.Window != nil {
+		window, err := parseDuration(*spec.Window)
+		if err != nil {
+			return events.BatchingConfig{}, field.Invalid(
+				field.NewPath("eventBatching.window"),
+				*spec.Window,
+				err.Error(),
+			)
+		}
+
+		batching.Window = window
+	}
+
+	if spec.MaxSize != nil {
+		if *spec.MaxSize < 0 {
+			return events.BatchingConfig{}, field.Invalid(
+				field.NewPath("eventBatching.maxSize"),
+				*spec.MaxSize,
+				"must be greater than or equal to 0",
+			)
+		}
+
+		batching.MaxSize = int(*spec.MaxSize)
+	}
+
+	return batching, nil
+}
+
+// durationRegexp matches a Duration. It is the same as the validation pattern of the Duration type.
+var durationRegexp = regexp.MustCompile(`^\d{1,4}(ms|s)?$`)
+
+// parseDuration parses a Duration. A Duration without a unit is in seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	s := string(d)
+	if !durationRegexp.MatchString(s) {
+		return 0, fmt.Errorf("must match the regular expression %s", durationRegexp)
+	}
+
+	if !strings.HasSuffix(s, "s") {
+		s += "s"
+	}
+
+	return time.ParseDuration(s)
+}
+
 func validateLogLevel(level ngfAPI.ControllerLogLevel) error {
 	switch level {
 	case ngfAPI.ControllerLogLevelInfo, ngfAPI.ControllerLogLevelDebug, ngfAPI.ControllerLogLevelError:
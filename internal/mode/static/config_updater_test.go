@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
@@ -11,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/staticfakes"
 )
@@ -24,6 +26,24 @@ func TestUpdateControlPlane(t *testing.T) {
 		},
 	}
 
+	batchingCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			EventBatching: &ngfAPI.EventBatching{
+				Window: helpers.GetPointer[ngfAPI.Duration]("500ms"),
+			},
+		},
+	}
+
+	invalidBatchingCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			EventBatching: &ngfAPI.EventBatching{
+				Window: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			},
+		},
+	}
+
+	defaultBatching := events.BatchingConfig{Window: time.Second, MaxSize: 100}
+
 	invalidLevelConfig := &ngfAPI.NginxGateway{
 		Spec: ngfAPI.NginxGatewaySpec{
 			Logging: &ngfAPI.Logging{
@@ -39,6 +59,7 @@ func TestUpdateControlPlane(t *testing.T) {
 	tests := []struct {
 		setLevelErr          error
 		nginxGateway         *ngfAPI.NginxGateway
+		expBatching          *events.BatchingConfig
 		name                 string
 		expErrString         string
 		expSetLevelCallCount int
@@ -48,6 +69,7 @@ func TestUpdateControlPlane(t *testing.T) {
 			name:                 "change log level",
 			nginxGateway:         debugLogCfg,
 			expSetLevelCallCount: 1,
+			expBatching:          &defaultBatching,
 		},
 		{
 			name:                 "invalid log level",
@@ -60,6 +82,7 @@ func TestUpdateControlPlane(t *testing.T) {
 			nginxGateway:         nil,
 			expEvent:             true,
 			expSetLevelCallCount: 1,
+			expBatching:          &defaultBatching,
 		},
 		{
 			name:                 "set log level fails",
@@ -68,6 +91,18 @@ func TestUpdateControlPlane(t *testing.T) {
 			expErrString:         "set level failed",
 			expSetLevelCallCount: 1,
 		},
+		{
+			name:                 "change event batching",
+			nginxGateway:         batchingCfg,
+			expSetLevelCallCount: 1,
+			expBatching:          &events.BatchingConfig{Window: 500 * time.Millisecond, MaxSize: 100},
+		},
+		{
+			name:                 "invalid event batching",
+			nginxGateway:         invalidBatchingCfg,
+			expErrString:         "eventBatching.window: Invalid value",
+			expSetLevelCallCount: 1,
+		},
 	}
 
 	for _, test := range tests {
@@ -80,7 +115,17 @@ func TestUpdateControlPlane(t *testing.T) {
 				},
 			}
 
-			err := updateControlPlane(test.nginxGateway, logger, fakeEventRecorder, nsname, fakeLogSetter)
+			fakeBatchingSetter := &staticfakes.FakeEventBatchingSetter{}
+
+			err := updateControlPlane(
+				test.nginxGateway,
+				logger,
+				fakeEventRecorder,
+				nsname,
+				fakeLogSetter,
+				fakeBatchingSetter,
+				defaultBatching,
+			)
 
 			if test.expErrString != "" {
 				g.Expect(err).To(HaveOccurred())
@@ -98,6 +143,87 @@ func TestUpdateControlPlane(t *testing.T) {
 			}
 
 			g.Expect(fakeLogSetter.SetLevelCallCount()).To(Equal(test.expSetLevelCallCount))
+
+			if test.expBatching != nil {
+				g.Expect(fakeBatchingSetter.StoreCallCount()).To(Equal(1))
+				g.Expect(fakeBatchingSetter.StoreArgsForCall(0)).To(Equal(*test.expBatching))
+			} else {
+				g.Expect(fakeBatchingSetter.StoreCallCount()).To(BeZero())
+			}
+		})
+	}
+}
+
+func TestBuildEventBatchingConfig(t *testing.T) {
+	defaultBatching := events.BatchingConfig{Window: time.Second, MaxSize: 100}
+
+	tests := []struct {
+		spec         *ngfAPI.EventBatching
+		name         string
+		expErrString string
+		expected     events.BatchingConfig
+	}{
+		{
+			name:     "nil spec",
+			expected: defaultBatching,
+		},
+		{
+			name:     "empty spec",
+			spec:     &ngfAPI.EventBatching{},
+			expected: defaultBatching,
+		},
+		{
+			name: "all fields set",
+			spec: &ngfAPI.EventBatching{
+				Window:  helpers.GetPointer[ngfAPI.Duration]("200ms"),
+				MaxSize: helpers.GetPointer[int32](50),
+			},
+			expected: events.BatchingConfig{Window: 200 * time.Millisecond, MaxSize: 50},
+		},
+		{
+			name: "window without unit",
+			spec: &ngfAPI.EventBatching{
+				Window: helpers.GetPointer[ngfAPI.Duration]("2"),
+			},
+			expected: events.BatchingConfig{Window: 2 * time.Second, MaxSize: 100},
+		},
+		{
+			name: "waiting disabled",
+			spec: &ngfAPI.EventBatching{
+				Window:  helpers.GetPointer[ngfAPI.Duration]("0s"),
+				MaxSize: helpers.GetPointer[int32](0),
+			},
+			expected: events.BatchingConfig{},
+		},
+		{
+			name: "invalid window",
+			spec: &ngfAPI.EventBatching{
+				Window: helpers.GetPointer[ngfAPI.Duration]("1m"),
+			},
+			expErrString: "eventBatching.window",
+		},
+		{
+			name: "invalid max size",
+			spec: &ngfAPI.EventBatching{
+				MaxSize: helpers.GetPointer[int32](-1),
+			},
+			expErrString: "eventBatching.maxSize",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			batching, err := buildEventBatchingConfig(test.spec, defaultBatching)
+			if test.expErrString != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(test.expErrString))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(batching).To(Equal(test.expected))
 		})
 	}
 }
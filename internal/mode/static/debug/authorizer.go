@@ -0,0 +1,100 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Authorizer
+
+// Authorizer authorizes requests to the debug endpoints.
+type Authorizer interface {
+	// Authorize returns nil if the bearer token belongs to a user that is allowed to get the path.
+	// It returns ErrUnauthenticated if the token is not valid and ErrForbidden if the user is not allowed
+	// to get the path.
+	Authorize(ctx context.Context, token, path string) error
+}
+
+var (
+	// ErrUnauthenticated is returned by an Authorizer when the bearer token is not valid.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden is returned by an Authorizer when the user is not allowed to get the path.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// TokenReviewAuthorizer is an Authorizer that authenticates bearer tokens with TokenReviews and authorizes
+// users with SubjectAccessReviews of the non-resource URL of the path, like the Kubernetes API server does.
+type TokenReviewAuthorizer struct {
+	k8sClient client.Client
+}
+
+// NewTokenReviewAuthorizer creates a new TokenReviewAuthorizer.
+func NewTokenReviewAuthorizer(k8sClient client.Client) *TokenReviewAuthorizer {
+	return &TokenReviewAuthorizer{k8sClient: k8sClient}
+}
+
+// Authorize implements Authorizer.
+func (a *TokenReviewAuthorizer) Authorize(ctx context.Context, token, path string) error {
+	review := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+
+	if err := a.k8sClient.Create(ctx, review); err != nil {
+		return fmt.Errorf("error creating TokenReview: %w", err)
+	}
+
+	if !review.Status.Authenticated {
+		return ErrUnauthenticated
+	}
+
+	user := review.Status.User
+
+	extra := make(map[string]authzv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authzv1.ExtraValue(v)
+	}
+
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			NonResourceAttributes: &authzv1.NonResourceAttributes{
+				Path: path,
+				Verb: "get",
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			Extra:  extra,
+			UID:    user.UID,
+		},
+	}
+
+	if err := a.k8sClient.Create(ctx, accessReview); err != nil {
+		return fmt.Errorf("error creating SubjectAccessReview: %w", err)
+	}
+
+	if !accessReview.Status.Allowed {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// bearerToken returns the bearer token of the request or an empty string if the request doesn't have one.
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(header[len(prefix):])
+}
@@ -0,0 +1,173 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestTokenReviewAuthorizer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tokenReviewErr   error
+		accessReviewErr  error
+		expErr           error
+		name             string
+		expErrSubstring  string
+		authenticated    bool
+		allowed          bool
+		expAccessReviews int
+	}{
+		{
+			name:             "allowed",
+			authenticated:    true,
+			allowed:          true,
+			expAccessReviews: 1,
+		},
+		{
+			name:          "unauthenticated",
+			authenticated: false,
+			expErr:        ErrUnauthenticated,
+		},
+		{
+			name:             "forbidden",
+			authenticated:    true,
+			allowed:          false,
+			expErr:           ErrForbidden,
+			expAccessReviews: 1,
+		},
+		{
+			name:            "TokenReview error",
+			tokenReviewErr:  errors.New("test error"),
+			expErrSubstring: "error creating TokenReview",
+		},
+		{
+			name:             "SubjectAccessReview error",
+			authenticated:    true,
+			accessReviewErr:  errors.New("test error"),
+			expErrSubstring:  "error creating SubjectAccessReview",
+			expAccessReviews: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(authnv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(authzv1.AddToScheme(scheme)).To(Succeed())
+
+			var accessReviews []*authzv1.SubjectAccessReview
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+						switch o := obj.(type) {
+						case *authnv1.TokenReview:
+							g.Expect(o.Spec.Token).To(Equal("token"))
+							o.Status.Authenticated = test.authenticated
+							o.Status.User = authnv1.UserInfo{
+								Username: "system:serviceaccount:test:debug",
+								UID:      "uid",
+								Groups:   []string{"system:serviceaccounts"},
+								Extra:    map[string]authnv1.ExtraValue{"key": {"value"}},
+							}
+							return test.tokenReviewErr
+						case *authzv1.SubjectAccessReview:
+							accessReviews = append(accessReviews, o)
+							o.Status.Allowed = test.allowed
+							return test.accessReviewErr
+						}
+						return errors.New("unexpected object")
+					},
+				}).
+				Build()
+
+			err := NewTokenReviewAuthorizer(k8sClient).Authorize(context.Background(), "token", GraphPath)
+
+			switch {
+			case test.expErr != nil:
+				g.Expect(err).To(MatchError(test.expErr))
+			case test.expErrSubstring != "":
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrSubstring)))
+			default:
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			g.Expect(accessReviews).To(HaveLen(test.expAccessReviews))
+			if test.expAccessReviews == 0 {
+				return
+			}
+
+			g.Expect(accessReviews[0].Spec).To(Equal(authzv1.SubjectAccessReviewSpec{
+				NonResourceAttributes: &authzv1.NonResourceAttributes{
+					Path: GraphPath,
+					Verb: "get",
+				},
+				User:   "system:serviceaccount:test:debug",
+				Groups: []string{"system:serviceaccounts"},
+				Extra:  map[string]authzv1.ExtraValue{"key": {"value"}},
+				UID:    "uid",
+			}))
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		expToken string
+	}{
+		{
+			name:     "bearer token",
+			header:   "Bearer token",
+			expToken: "token",
+		},
+		{
+			name:     "lowercase scheme",
+			header:   "bearer token",
+			expToken: "token",
+		},
+		{
+			name:   "no header",
+			header: "",
+		},
+		{
+			name:   "basic auth",
+			header: "Basic dXNlcjpwYXNz",
+		},
+		{
+			name:   "no token",
+			header: "Bearer ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			req := httptest.NewRequest(http.MethodGet, GraphPath, nil)
+			req.Header.Set("Authorization", test.header)
+
+			g.Expect(bearerToken(req)).To(Equal(test.expToken))
+		})
+	}
+}
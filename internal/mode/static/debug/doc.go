@@ -0,0 +1,5 @@
+/*
+Package debug contains the debug endpoints of the control plane. They serve the latest Graph, the latest dataplane
+Configuration and the NGINX configuration files generated from it, with private keys and certificates redacted.
+*/
+package debug
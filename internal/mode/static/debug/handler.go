@@ -0,0 +1,186 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// GraphPath is the path of the endpoint that serves the latest Graph as JSON.
+	GraphPath = "/debug/graph"
+	// ConfigurationPath is the path of the endpoint that serves the latest dataplane Configuration as JSON.
+	ConfigurationPath = "/debug/configuration"
+	// NginxPath is the path of the endpoint that serves the NGINX configuration files generated from
+	// the latest dataplane Configuration as text.
+	NginxPath = "/debug/nginx"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . GraphGetter
+
+// GraphGetter gets the latest Graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ConfigurationGetter
+
+// ConfigurationGetter gets the latest Configuration.
+type ConfigurationGetter interface {
+	GetLatestConfiguration() *dataplane.Configuration
+}
+
+// HandlerConfig holds the dependencies of the Handler.
+type HandlerConfig struct {
+	// Authorizer authorizes the requests.
+	Authorizer Authorizer
+	// GraphGetter gets the latest Graph.
+	GraphGetter GraphGetter
+	// ConfigurationGetter gets the latest Configuration.
+	ConfigurationGetter ConfigurationGetter
+	// Generator generates the NGINX configuration files.
+	Generator ngxcfg.Generator
+}
+
+// Handler serves the debug endpoints.
+// The Handler is created before its dependencies, so that its endpoints can be registered with the metrics server
+// when the manager is created. Until the Handler is enabled, the endpoints respond with 503 Service Unavailable.
+type Handler struct {
+	cfg    *HandlerConfig
+	logger logr.Logger
+	lock   sync.RWMutex
+}
+
+// NewHandler creates a new Handler.
+func NewHandler(logger logr.Logger) *Handler {
+	return &Handler{
+		logger: logger,
+	}
+}
+
+// Enable enables the endpoints of the Handler.
+func (h *Handler) Enable(cfg HandlerConfig) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.cfg = &cfg
+}
+
+// Handlers returns the http.Handlers of the debug endpoints by their paths.
+func (h *Handler) Handlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		GraphPath:         h.authorize(h.serveGraph),
+		ConfigurationPath: h.authorize(h.serveConfiguration),
+		NginxPath:         h.authorize(h.serveNginx),
+	}
+}
+
+func (h *Handler) getConfig() *HandlerConfig {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.cfg
+}
+
+// authorize wraps the handler function, so that it only handles GET requests that the Authorizer allows.
+func (h *Handler) authorize(handle func(http.ResponseWriter, HandlerConfig)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		cfg := h.getConfig()
+		if cfg == nil {
+			http.Error(w, "debug endpoints are not ready yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		token := bearerToken(r)
+		if token == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		if err := cfg.Authorizer.Authorize(r.Context(), token, r.URL.Path); err != nil {
+			switch {
+			case errors.Is(err, ErrUnauthenticated):
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			case errors.Is(err, ErrForbidden):
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			default:
+				h.logger.Error(err, "Failed to authorize debug request", "path", r.URL.Path)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		handle(w, *cfg)
+	})
+}
+
+func (h *Handler) serveGraph(w http.ResponseWriter, cfg HandlerConfig) {
+	g := cfg.GraphGetter.GetLatestGraph()
+	if g == nil {
+		http.Error(w, "no graph has been built yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	h.writeJSON(w, newGraphView(g))
+}
+
+func (h *Handler) serveConfiguration(w http.ResponseWriter, cfg HandlerConfig) {
+	conf := cfg.ConfigurationGetter.GetLatestConfiguration()
+	if conf == nil {
+		http.Error(w, "no configuration has been built yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	h.writeJSON(w, redactConfiguration(*conf))
+}
+
+func (h *Handler) serveNginx(w http.ResponseWriter, cfg HandlerConfig) {
+	conf := cfg.ConfigurationGetter.GetLatestConfiguration()
+	if conf == nil {
+		http.Error(w, "no configuration has been built yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	// The files are generated from the redacted Configuration, so that the files with the keys and certificates
+	// are redacted too.
+	files := cfg.Generator.Generate(redactConfiguration(*conf))
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	for _, f := range files {
+		if _, err := fmt.Fprintf(w, "# %s\n%s\n", f.Path, f.Content); err != nil {
+			h.logger.Error(err, "Failed to write debug response", "path", NginxPath)
+			return
+		}
+	}
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		h.logger.Error(err, "Failed to marshal debug response")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Error(err, "Failed to write debug response")
+	}
+}
@@ -0,0 +1,300 @@
+package debug_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug/debugfakes"
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func newRequest(method, path, token string) *http.Request {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return req
+}
+
+func serve(h *debug.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.Handlers()[req.URL.Path].ServeHTTP(rec, req)
+
+	return rec
+}
+
+func createEnabledHandler(g *graph.Graph, conf *dataplane.Configuration) (*debug.Handler, *debugfakes.FakeAuthorizer) {
+	authorizer := &debugfakes.FakeAuthorizer{}
+
+	graphGetter := &debugfakes.FakeGraphGetter{}
+	graphGetter.GetLatestGraphReturns(g)
+
+	confGetter := &debugfakes.FakeConfigurationGetter{}
+	confGetter.GetLatestConfigurationReturns(conf)
+
+	h := debug.NewHandler(logr.Discard())
+	h.Enable(debug.HandlerConfig{
+		Authorizer:          authorizer,
+		GraphGetter:         graphGetter,
+		ConfigurationGetter: confGetter,
+		Generator:           ngxcfg.NewGeneratorImpl(false),
+	})
+
+	return h, authorizer
+}
+
+func TestHandlerNotEnabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	h := debug.NewHandler(logr.Discard())
+
+	for path := range h.Handlers() {
+		rec := serve(h, newRequest(http.MethodGet, path, "token"))
+		g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	}
+}
+
+func TestHandlerAuthorization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		authorizeErr error
+		name         string
+		method       string
+		token        string
+		expCode      int
+		expAuthorize bool
+	}{
+		{
+			name:         "allowed",
+			method:       http.MethodGet,
+			token:        "token",
+			expCode:      http.StatusOK,
+			expAuthorize: true,
+		},
+		{
+			name:    "no token",
+			method:  http.MethodGet,
+			expCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "invalid token",
+			method:       http.MethodGet,
+			token:        "token",
+			authorizeErr: debug.ErrUnauthenticated,
+			expCode:      http.StatusUnauthorized,
+			expAuthorize: true,
+		},
+		{
+			name:         "forbidden",
+			method:       http.MethodGet,
+			token:        "token",
+			authorizeErr: debug.ErrForbidden,
+			expCode:      http.StatusForbidden,
+			expAuthorize: true,
+		},
+		{
+			name:         "authorization error",
+			method:       http.MethodGet,
+			token:        "token",
+			authorizeErr: errors.New("test error"),
+			expCode:      http.StatusInternalServerError,
+			expAuthorize: true,
+		},
+		{
+			name:    "method not allowed",
+			method:  http.MethodPost,
+			token:   "token",
+			expCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			h, authorizer := createEnabledHandler(&graph.Graph{}, &dataplane.Configuration{})
+			authorizer.AuthorizeReturns(test.authorizeErr)
+
+			rec := serve(h, newRequest(test.method, debug.GraphPath, test.token))
+			g.Expect(rec.Code).To(Equal(test.expCode))
+
+			if !test.expAuthorize {
+				g.Expect(authorizer.AuthorizeCallCount()).To(BeZero())
+				return
+			}
+
+			g.Expect(authorizer.AuthorizeCallCount()).To(Equal(1))
+			_, token, path := authorizer.AuthorizeArgsForCall(0)
+			g.Expect(token).To(Equal(test.token))
+			g.Expect(path).To(Equal(debug.GraphPath))
+		})
+	}
+}
+
+func TestHandlerNothingBuiltYet(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	h, _ := createEnabledHandler(nil, nil)
+
+	for path := range h.Handlers() {
+		rec := serve(h, newRequest(http.MethodGet, path, "token"))
+		g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	}
+}
+
+func TestHandlerGraph(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+	routeKey := graph.RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "route"},
+		RouteType:      graph.RouteTypeHTTP,
+	}
+
+	latestGraph := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{
+			Source: &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			Valid:  true,
+		},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			routeKey: {
+				RouteType: graph.RouteTypeHTTP,
+				Source:    &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route"}},
+				Valid:     true,
+			},
+		},
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+			secretNsName: {
+				Source: &apiv1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "secret"},
+					Data: map[string][]byte{
+						apiv1.TLSCertKey:       []byte("secret-cert"),
+						apiv1.TLSPrivateKeyKey: []byte("secret-key"),
+					},
+				},
+			},
+		},
+	}
+
+	h, _ := createEnabledHandler(latestGraph, nil)
+
+	rec := serve(h, newRequest(http.MethodGet, debug.GraphPath, "token"))
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	body := rec.Body.String()
+	g.Expect(body).To(ContainSubstring(`"http/test/route"`))
+	g.Expect(body).To(ContainSubstring(`"test/secret"`))
+	g.Expect(body).ToNot(ContainSubstring("secret-key"))
+	g.Expect(body).ToNot(ContainSubstring("secret-cert"))
+
+	// the Graph itself is not redacted
+	g.Expect(latestGraph.ReferencedSecrets[secretNsName].Source.Data[apiv1.TLSPrivateKeyKey]).To(
+		Equal([]byte("secret-key")),
+	)
+}
+
+func TestHandlerConfiguration(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := &dataplane.Configuration{
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_secret": {
+				Cert: []byte("secret-cert"),
+				Key:  []byte("secret-key"),
+			},
+		},
+		CertBundles: map[dataplane.CertBundleID]dataplane.CertBundle{
+			"cert_bundle_test_configmap": []byte("bundle-cert"),
+		},
+		Version: 3,
+	}
+
+	h, _ := createEnabledHandler(nil, conf)
+
+	rec := serve(h, newRequest(http.MethodGet, debug.ConfigurationPath, "token"))
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	body := rec.Body.String()
+	g.Expect(body).To(ContainSubstring("ssl_keypair_test_secret"))
+	g.Expect(body).To(ContainSubstring("cert_bundle_test_configmap"))
+	g.Expect(body).To(ContainSubstring(`"Version": 3`))
+	g.Expect(body).ToNot(ContainSubstring("secret-key"))
+	g.Expect(body).ToNot(ContainSubstring("secret-cert"))
+	g.Expect(body).ToNot(ContainSubstring("bundle-cert"))
+
+	// the latest Configuration itself is not redacted
+	g.Expect(conf.SSLKeyPairs["ssl_keypair_test_secret"].Key).To(Equal([]byte("secret-key")))
+	g.Expect(conf.CertBundles["cert_bundle_test_configmap"]).To(Equal(dataplane.CertBundle("bundle-cert")))
+}
+
+func TestHandlerNginx(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := &dataplane.Configuration{
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_secret": {
+				Cert: []byte("secret-cert"),
+				Key:  []byte("secret-key"),
+			},
+		},
+		Version: 3,
+	}
+
+	h, _ := createEnabledHandler(nil, conf)
+
+	rec := serve(h, newRequest(http.MethodGet, debug.NginxPath, "token"))
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+
+	body := rec.Body.String()
+	g.Expect(body).To(ContainSubstring("# /etc/nginx/conf.d/http.conf\n"))
+	g.Expect(body).To(ContainSubstring("# /etc/nginx/secrets/ssl_keypair_test_secret.pem\nREDACTED\nREDACTED\n"))
+	g.Expect(body).ToNot(ContainSubstring("secret-key"))
+	g.Expect(body).ToNot(ContainSubstring("secret-cert"))
+}
+
+func TestHandlerEnableAfterRegistration(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	h := debug.NewHandler(logr.Discard())
+	handlers := h.Handlers()
+
+	authorizer := &debugfakes.FakeAuthorizer{}
+	confGetter := &debugfakes.FakeConfigurationGetter{}
+	confGetter.GetLatestConfigurationReturns(&dataplane.Configuration{})
+
+	h.Enable(debug.HandlerConfig{
+		Authorizer:          authorizer,
+		GraphGetter:         &debugfakes.FakeGraphGetter{},
+		ConfigurationGetter: confGetter,
+		Generator:           ngxcfg.NewGeneratorImpl(false),
+	})
+
+	rec := httptest.NewRecorder()
+	handlers[debug.ConfigurationPath].ServeHTTP(rec, newRequest(http.MethodGet, debug.ConfigurationPath, "token"))
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(authorizer.AuthorizeCallCount()).To(Equal(1))
+}
@@ -0,0 +1,119 @@
+package debug
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// lastAppliedConfigAnnotation is set by kubectl apply. For Secrets, it includes the data of the Secret.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// redacted replaces the contents of private keys, certificates and Secrets.
+var redacted = []byte("REDACTED")
+
+// graphView is the JSON representation of a graph.Graph. JSON objects can only have string keys, so the maps
+// of the Graph that are keyed by NamespacedNames are keyed by "namespace/name" strings.
+type graphView struct {
+	GatewayClass                  *graph.GatewayClass                 `json:"gatewayClass"`
+	Gateway                       *graph.Gateway                      `json:"gateway"`
+	IgnoredGatewayClasses         map[string]*gatewayv1.GatewayClass  `json:"ignoredGatewayClasses"`
+	IgnoredGateways               map[string]*gatewayv1.Gateway       `json:"ignoredGateways"`
+	Routes                        map[graph.RouteKey]*graph.L7Route   `json:"routes"`
+	ReferencedSecrets             map[string]*graph.Secret            `json:"referencedSecrets"`
+	ReferencedNamespaces          map[string]*apiv1.Namespace         `json:"referencedNamespaces"`
+	ReferencedServices            map[string]struct{}                 `json:"referencedServices"`
+	ReferencedCaCertConfigMaps    map[string]*graph.CaCertConfigMap   `json:"referencedCaCertConfigMaps"`
+	ReferencedErrorPageConfigMaps map[string]struct{}                 `json:"referencedErrorPageConfigMaps"`
+	BackendTLSPolicies            map[string]*graph.BackendTLSPolicy  `json:"backendTLSPolicies"`
+	NginxProxy                    *ngfAPI.NginxProxy                  `json:"nginxProxy"`
+	CompressionPolicies           map[string]*graph.CompressionPolicy `json:"compressionPolicies"`
+	ErrorPagePolicies             map[string]*graph.ErrorPagePolicy   `json:"errorPagePolicies"`
+	SnippetsFilters               map[string]*graph.SnippetsFilter    `json:"snippetsFilters"`
+}
+
+// newGraphView creates the JSON representation of the Graph with the data of the referenced Secrets redacted.
+func newGraphView(g *graph.Graph) graphView {
+	secrets := make(map[types.NamespacedName]*graph.Secret, len(g.ReferencedSecrets))
+	for nsname, secret := range g.ReferencedSecrets {
+		secrets[nsname] = redactSecret(secret)
+	}
+
+	return graphView{
+		GatewayClass:                  g.GatewayClass,
+		Gateway:                       g.Gateway,
+		IgnoredGatewayClasses:         byNsName(g.IgnoredGatewayClasses),
+		IgnoredGateways:               byNsName(g.IgnoredGateways),
+		Routes:                        g.Routes,
+		ReferencedSecrets:             byNsName(secrets),
+		ReferencedNamespaces:          byNsName(g.ReferencedNamespaces),
+		ReferencedServices:            byNsName(g.ReferencedServices),
+		ReferencedCaCertConfigMaps:    byNsName(g.ReferencedCaCertConfigMaps),
+		ReferencedErrorPageConfigMaps: byNsName(g.ReferencedErrorPageConfigMaps),
+		BackendTLSPolicies:            byNsName(g.BackendTLSPolicies),
+		NginxProxy:                    g.NginxProxy,
+		CompressionPolicies:           byNsName(g.CompressionPolicies),
+		ErrorPagePolicies:             byNsName(g.ErrorPagePolicies),
+		SnippetsFilters:               byNsName(g.SnippetsFilters),
+	}
+}
+
+func byNsName[T any](m map[types.NamespacedName]T) map[string]T {
+	if m == nil {
+		return nil
+	}
+
+	result := make(map[string]T, len(m))
+	for nsname, v := range m {
+		result[nsname.String()] = v
+	}
+
+	return result
+}
+
+// redactSecret returns a copy of the Secret with its data redacted. The keys of the data are kept.
+func redactSecret(secret *graph.Secret) *graph.Secret {
+	if secret == nil || secret.Source == nil {
+		return secret
+	}
+
+	source := secret.Source.DeepCopy()
+
+	for k := range source.Data {
+		source.Data[k] = redacted
+	}
+	for k := range source.StringData {
+		source.StringData[k] = string(redacted)
+	}
+	if _, exists := source.Annotations[lastAppliedConfigAnnotation]; exists {
+		source.Annotations[lastAppliedConfigAnnotation] = string(redacted)
+	}
+
+	return &graph.Secret{Source: source}
+}
+
+// redactConfiguration returns a copy of the Configuration with the certificates and keys of the SSLKeyPairs
+// and the CertBundles redacted.
+func redactConfiguration(conf dataplane.Configuration) dataplane.Configuration {
+	keyPairs := make(map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair, len(conf.SSLKeyPairs))
+	for id := range conf.SSLKeyPairs {
+		keyPairs[id] = dataplane.SSLKeyPair{
+			Cert: redacted,
+			Key:  redacted,
+		}
+	}
+
+	bundles := make(map[dataplane.CertBundleID]dataplane.CertBundle, len(conf.CertBundles))
+	for id := range conf.CertBundles {
+		bundles[id] = redacted
+	}
+
+	conf.SSLKeyPairs = keyPairs
+	conf.CertBundles = bundles
+
+	return conf
+}
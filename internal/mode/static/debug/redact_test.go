@@ -0,0 +1,82 @@
+package debug
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestRedactSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		secret *graph.Secret
+		expect *graph.Secret
+		name   string
+	}{
+		{
+			name:   "nil secret",
+			secret: nil,
+			expect: nil,
+		},
+		{
+			name:   "secret does not exist",
+			secret: &graph.Secret{},
+			expect: &graph.Secret{},
+		},
+		{
+			name: "secret with data",
+			secret: &graph.Secret{
+				Source: &apiv1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "secret",
+						Annotations: map[string]string{
+							lastAppliedConfigAnnotation: `{"data":{"tls.key":"c2VjcmV0"}}`,
+							"other":                     "value",
+						},
+					},
+					Data: map[string][]byte{
+						apiv1.TLSCertKey:       []byte("cert"),
+						apiv1.TLSPrivateKeyKey: []byte("key"),
+					},
+					StringData: map[string]string{
+						"password": "secret",
+					},
+					Type: apiv1.SecretTypeTLS,
+				},
+			},
+			expect: &graph.Secret{
+				Source: &apiv1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "secret",
+						Annotations: map[string]string{
+							lastAppliedConfigAnnotation: "REDACTED",
+							"other":                     "value",
+						},
+					},
+					Data: map[string][]byte{
+						apiv1.TLSCertKey:       []byte("REDACTED"),
+						apiv1.TLSPrivateKeyKey: []byte("REDACTED"),
+					},
+					StringData: map[string]string{
+						"password": "REDACTED",
+					},
+					Type: apiv1.SecretTypeTLS,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(redactSecret(test.secret)).To(Equal(test.expect))
+		})
+	}
+}
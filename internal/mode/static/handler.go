@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
@@ -32,8 +34,23 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/status"
 )
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . handlerMetricsCollector
+
 type handlerMetricsCollector interface {
+	events.MetricsCollector
 	ObserveLastEventBatchProcessTime(time.Duration)
+	IncSkippedReloadCount()
+	ObserveGraphBuildTime(time.Duration)
+	ObserveConfigGenerationTime(time.Duration)
+	ObserveGraph(*graph.Graph)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . eventEmitter
+
+// eventEmitter emits Kubernetes Events for the problems of the resources in the Graph.
+type eventEmitter interface {
+	// Emit emits Events for the new problems of the resources in the Graph and for a failed NGINX reload.
+	Emit(g *graph.Graph, nginxReloadRes status.NginxReloadResult)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . secretStorer
@@ -46,14 +63,26 @@ type secretStorer interface {
 	Delete()
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . configTester
+
+// configTester tests the NGINX configuration.
+type configTester interface {
+	// TestConfig tests the NGINX configuration and returns the errors that NGINX reported.
+	TestConfig(ctx context.Context) error
+}
+
 // eventHandlerConfig holds configuration parameters for eventHandlerImpl.
 type eventHandlerConfig struct {
 	// gatewayCtlrName is the name of the NGF controller.
 	gatewayCtlrName string
 	// k8sClient is a Kubernetes API client
 	k8sClient client.Client
+	// addressRequester requests the addresses of the Gateway for the NGF Service.
+	addressRequester *gatewayAddressRequester
 	// gatewayPodConfig contains information about this Pod.
 	gatewayPodConfig ngfConfig.GatewayPodConfig
+	// zone is the zone of the Node that this Pod runs on. Empty if unknown.
+	zone string
 	// usageReportConfig contains the configuration for NGINX Plus usage reporting.
 	usageReportConfig *config.UsageReportConfig
 	// usageSecret contains the Secret for the NGINX Plus reporting credentials.
@@ -68,20 +97,31 @@ type eventHandlerConfig struct {
 	nginxFileMgr file.Manager
 	// nginxRuntimeMgr manages nginx runtime.
 	nginxRuntimeMgr runtime.Manager
+	// nginxConfigTester tests the NGINX configuration after a failed reload, to find the invalid snippet
+	// that caused the failure. It is nil if SnippetsFilters are disabled.
+	nginxConfigTester configTester
 	// statusUpdater updates statuses on Kubernetes resources.
 	statusUpdater frameworkStatus.GroupUpdater
 	// eventRecorder records events for Kubernetes resources.
 	eventRecorder record.EventRecorder
+	// eventEmitter emits Events for the problems of the resources in the Graph.
+	eventEmitter eventEmitter
 	// logLevelSetter is used to update the logging level.
 	logLevelSetter logLevelSetter
+	// eventBatchingSetter is used to update how the event loop batches events.
+	eventBatchingSetter eventBatchingSetter
 	// metricsCollector collects metrics for this controller.
 	metricsCollector handlerMetricsCollector
 	// nginxConfiguredOnStartChecker sets the health of the Pod to Ready once we've written out our initial config.
 	nginxConfiguredOnStartChecker *nginxConfiguredOnStartChecker
 	// controlConfigNSName is the NamespacedName of the NginxGateway config for this controller.
 	controlConfigNSName types.NamespacedName
+	// defaultEventBatching is the event batching configuration from the command-line flags.
+	defaultEventBatching events.BatchingConfig
 	// updateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	updateGatewayClassStatus bool
+	// experimentalFeatures indicates if experimental features, like GRPCRoutes, are enabled.
+	experimentalFeatures bool
 }
 
 const (
@@ -89,6 +129,7 @@ const (
 	groupAllExceptGateways = "all-graphs-except-gateways"
 	groupGateways          = "gateways"
 	groupControlPlane      = "control-plane"
+	groupNginxConfig       = "nginx-config"
 )
 
 // filterKey is the `kind_namespace_name" of an object being filtered.
@@ -117,11 +158,17 @@ type eventHandlerImpl struct {
 
 	latestReloadResult status.NginxReloadResult
 
+	// latestConfigHash is the hash of the NGINX configuration files that were last applied successfully.
+	latestConfigHash string
+
 	cfg  eventHandlerConfig
 	lock sync.Mutex
 
 	// version is the current version number of the nginx config.
 	version int
+
+	// nginxReloadCount is the number of successful NGINX reloads.
+	nginxReloadCount int64
 }
 
 // newEventHandlerImpl creates a new eventHandlerImpl.
@@ -181,8 +228,14 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 		h.parseAndCaptureEvent(ctx, logger, event)
 	}
 
+	processStart := time.Now()
 	changeType, graph := h.cfg.processor.Process()
 
+	// The graph is only built when the cluster state changed.
+	if changeType != state.NoChange {
+		h.cfg.metricsCollector.ObserveGraphBuildTime(time.Since(processStart))
+	}
+
 	var err error
 	switch changeType {
 	case state.NoChange:
@@ -191,33 +244,17 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 			h.cfg.nginxConfiguredOnStartChecker.setAsReady()
 		}
 		return
-	case state.EndpointsOnlyChange:
-		h.version++
-		cfg := dataplane.BuildConfiguration(ctx, graph, h.cfg.serviceResolver, h.version)
-
-		h.setLatestConfiguration(&cfg)
-
-		err = h.updateUpstreamServers(
-			ctx,
-			logger,
-			cfg,
-		)
-	case state.ClusterStateChange:
-		h.version++
-		cfg := dataplane.BuildConfiguration(ctx, graph, h.cfg.serviceResolver, h.version)
-
-		h.setLatestConfiguration(&cfg)
-
-		err = h.updateNginxConf(
-			ctx,
-			cfg,
-		)
+	case state.EndpointsOnlyChange, state.ClusterStateChange:
+		err = h.updateNginx(ctx, logger, changeType, graph)
 	}
 
+	h.cfg.metricsCollector.ObserveGraph(graph)
+
 	var nginxReloadRes status.NginxReloadResult
 	if err != nil {
 		logger.Error(err, "Failed to update NGINX configuration")
 		nginxReloadRes.Error = err
+		nginxReloadRes.InvalidSnippet = h.findInvalidSnippet(ctx, logger)
 		if !h.cfg.nginxConfiguredOnStartChecker.ready {
 			h.cfg.nginxConfiguredOnStartChecker.firstBatchError = err
 		}
@@ -231,33 +268,94 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 	h.latestReloadResult = nginxReloadRes
 
 	h.updateStatuses(ctx, logger, graph)
+	h.cfg.eventEmitter.Emit(graph, nginxReloadRes)
 }
 
-func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logger, graph *graph.Graph) {
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, h.cfg.gatewayPodConfig)
-	if err != nil {
-		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
+// findInvalidSnippet tests the NGINX configuration and returns the snippet that NGINX reported as invalid, if any.
+func (h *eventHandlerImpl) findInvalidSnippet(ctx context.Context, logger logr.Logger) *status.InvalidSnippet {
+	if h.cfg.nginxConfigTester == nil {
+		return nil
+	}
+
+	err := h.cfg.nginxConfigTester.TestConfig(ctx)
+	if err == nil {
+		return nil
+	}
+
+	kind, owner, found := ngxConfig.FindSnippetOwner(err.Error())
+	if !found {
+		logger.Error(err, "NGINX configuration test failed")
+		return nil
 	}
 
+	logger.Error(err, "NGINX configuration test failed because of an invalid snippet", "kind", kind, "owner", owner)
+
+	return &status.InvalidSnippet{
+		Error:     err,
+		OwnerKind: kind,
+		Owner:     owner,
+	}
+}
+
+func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logger, graph *graph.Graph) {
+	gwAddresses, addressConds := h.prepareGatewayAddresses(ctx, logger, nil, graph.Gateway)
+
 	transitionTime := metav1.Now()
 
 	var gcReqs []frameworkStatus.UpdateRequest
 	if h.cfg.updateGatewayClassStatus {
 		gcReqs = status.PrepareGatewayClassRequests(graph.GatewayClass, graph.IgnoredGatewayClasses, transitionTime)
 	}
+
+	detachedRoutes, err := findDetachedRoutes(
+		ctx,
+		h.cfg.k8sClient,
+		h.cfg.gatewayCtlrName,
+		h.cfg.experimentalFeatures,
+		graph.Routes,
+	)
+	if err != nil {
+		logger.Error(err, "Failed to find the Routes that are no longer attached to the Gateway")
+	}
+
 	routeReqs := status.PrepareRouteRequests(
 		graph.Routes,
+		detachedRoutes,
 		transitionTime,
 		h.latestReloadResult,
 		h.cfg.gatewayCtlrName,
 	)
 
 	polReqs := status.PrepareBackendTLSPolicyRequests(graph.BackendTLSPolicies, transitionTime, h.cfg.gatewayCtlrName)
+	compressionPolReqs := status.PrepareCompressionPolicyRequests(
+		graph.CompressionPolicies,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+
+	errorPagePolReqs := status.PrepareErrorPagePolicyRequests(
+		graph.ErrorPagePolicies,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
 
-	reqs := make([]frameworkStatus.UpdateRequest, 0, len(gcReqs)+len(routeReqs)+len(polReqs))
+	snippetsFilterReqs := status.PrepareSnippetsFilterRequests(
+		graph.SnippetsFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+
+	reqs := make(
+		[]frameworkStatus.UpdateRequest,
+		0,
+		len(gcReqs)+len(routeReqs)+len(polReqs)+len(compressionPolReqs)+len(errorPagePolReqs)+len(snippetsFilterReqs),
+	)
 	reqs = append(reqs, gcReqs...)
 	reqs = append(reqs, routeReqs...)
 	reqs = append(reqs, polReqs...)
+	reqs = append(reqs, compressionPolReqs...)
+	reqs = append(reqs, errorPagePolReqs...)
+	reqs = append(reqs, snippetsFilterReqs...)
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, reqs...)
 
@@ -268,11 +366,64 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 		graph.IgnoredGateways,
 		transitionTime,
 		gwAddresses,
+		addressConds,
 		h.latestReloadResult,
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gwReqs...)
 }
 
+// findDetachedRoutes returns the keys of the Routes in the cluster that have a RouteParentStatus written by
+// the Gateway controller but are not in the current Routes. Because the Routes are listed from the cluster rather
+// than tracked in memory, the stale statuses are also found for the Routes that detached while NGF was not running
+// or while this Pod was not the leader.
+func findDetachedRoutes(
+	ctx context.Context,
+	k8sClient client.Reader,
+	gatewayCtlrName string,
+	experimentalFeatures bool,
+	routes map[graph.RouteKey]*graph.L7Route,
+) ([]graph.RouteKey, error) {
+	var detached []graph.RouteKey
+
+	addIfDetached := func(route client.Object, parents []gatewayv1.RouteParentStatus) {
+		key := graph.CreateRouteKey(route)
+		if _, exists := routes[key]; exists {
+			return
+		}
+
+		hasStatus := slices.ContainsFunc(parents, func(p gatewayv1.RouteParentStatus) bool {
+			return string(p.ControllerName) == gatewayCtlrName
+		})
+		if hasStatus {
+			detached = append(detached, key)
+		}
+	}
+
+	var httpRoutes gatewayv1.HTTPRouteList
+	if err := k8sClient.List(ctx, &httpRoutes); err != nil {
+		return nil, fmt.Errorf("error listing HTTPRoutes: %w", err)
+	}
+
+	for i := range httpRoutes.Items {
+		addIfDetached(&httpRoutes.Items[i], httpRoutes.Items[i].Status.Parents)
+	}
+
+	if !experimentalFeatures {
+		return detached, nil
+	}
+
+	var grpcRoutes gatewayv1alpha2.GRPCRouteList
+	if err := k8sClient.List(ctx, &grpcRoutes); err != nil {
+		return detached, fmt.Errorf("error listing GRPCRoutes: %w", err)
+	}
+
+	for i := range grpcRoutes.Items {
+		addIfDetached(&grpcRoutes.Items[i], grpcRoutes.Items[i].Status.Parents)
+	}
+
+	return detached, nil
+}
+
 func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr.Logger, event interface{}) {
 	switch e := event.(type) {
 	case *events.UpsertEvent:
@@ -302,38 +453,118 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 	}
 }
 
+// updateNginx builds the NGINX configuration from the graph and applies it to NGINX.
+// If the generated configuration files are the same as the last applied files, it doesn't write the files or
+// reload NGINX, and the configuration version stays the same.
+func (h *eventHandlerImpl) updateNginx(
+	ctx context.Context,
+	logger logr.Logger,
+	changeType state.ChangeType,
+	graph *graph.Graph,
+) error {
+	generationStart := time.Now()
+	cfg := dataplane.BuildConfiguration(ctx, graph, h.cfg.serviceResolver, h.version+1, h.cfg.zone)
+	files := h.cfg.generator.Generate(cfg)
+	h.cfg.metricsCollector.ObserveConfigGenerationTime(time.Since(generationStart))
+
+	hash := ngxConfig.HashFiles(files)
+	if hash == h.latestConfigHash {
+		logger.Info("NGINX configuration didn't change, skipping reload", "configHash", hash)
+		h.cfg.metricsCollector.IncSkippedReloadCount()
+		return nil
+	}
+
+	h.version++
+	h.setLatestConfiguration(&cfg)
+
+	var err error
+	if changeType == state.EndpointsOnlyChange {
+		err = h.updateUpstreamServers(ctx, logger, cfg, files)
+	} else {
+		err = h.updateNginxConf(ctx, cfg, files)
+	}
+
+	if err != nil {
+		// NGINX might not run the configuration in the files, so the next configuration must be applied
+		// even if it's the same.
+		h.latestConfigHash = ""
+		return err
+	}
+
+	h.latestConfigHash = hash
+
+	h.cfg.statusUpdater.UpdateGroup(
+		ctx,
+		groupNginxConfig,
+		status.PrepareNginxGatewayConfigHashStatus(h.cfg.controlConfigNSName, hash),
+	)
+
+	return nil
+}
+
 // updateNginxConf updates nginx conf files and reloads nginx
-func (h *eventHandlerImpl) updateNginxConf(ctx context.Context, conf dataplane.Configuration) error {
-	files := h.cfg.generator.Generate(conf)
+func (h *eventHandlerImpl) updateNginxConf(
+	ctx context.Context,
+	conf dataplane.Configuration,
+	files []file.File,
+) error {
 	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
 		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
 	}
 
+	return h.reload(ctx, conf)
+}
+
+// reload reloads NGINX. If NGINX open source uses dynamic upstreams, it then removes the servers of the dynamic
+// upstreams from shared memory, so that NGINX uses the servers of the reloaded upstream configuration.
+func (h *eventHandlerImpl) reload(ctx context.Context, conf dataplane.Configuration) error {
 	if err := h.cfg.nginxRuntimeMgr.Reload(ctx, conf.Version); err != nil {
 		return fmt.Errorf("failed to reload NGINX: %w", err)
 	}
 
+	h.lock.Lock()
+	h.nginxReloadCount++
+	h.lock.Unlock()
+
+	if conf.DynamicUpstreams && !h.cfg.nginxRuntimeMgr.IsPlus() {
+		if err := h.cfg.nginxRuntimeMgr.ResetDynamicUpstreams(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // updateUpstreamServers is called only when endpoints have changed. It updates nginx conf files and then:
 // - if using NGINX Plus, determines which servers have changed and uses the N+ API to update them;
-// - otherwise if not using NGINX Plus, or an error was returned from the API, reloads nginx
+// - if using NGINX open source with dynamic upstreams, updates the servers of the upstreams in shared memory;
+// - otherwise, or if the servers couldn't be updated, reloads nginx
 func (h *eventHandlerImpl) updateUpstreamServers(
 	ctx context.Context,
 	logger logr.Logger,
 	conf dataplane.Configuration,
+	files []file.File,
 ) error {
 	isPlus := h.cfg.nginxRuntimeMgr.IsPlus()
 
-	files := h.cfg.generator.Generate(conf)
 	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
 		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
 	}
 
 	reload := func() error {
-		if err := h.cfg.nginxRuntimeMgr.Reload(ctx, conf.Version); err != nil {
-			return fmt.Errorf("failed to reload NGINX: %w", err)
+		return h.reload(ctx, conf)
+	}
+
+	if !isPlus && conf.DynamicUpstreams {
+		upstreams, ok := ngxConfig.ConvertDynamicUpstreams(conf.Upstreams)
+		if !ok {
+			logger.Info("An upstream has no endpoints, reloading configuration instead")
+			return reload()
+		}
+
+		if err := h.cfg.nginxRuntimeMgr.UpdateDynamicUpstreams(ctx, upstreams); err != nil {
+			logger.Error(err, "couldn't update dynamic upstreams, reloading configuration instead")
+			return reload()
 		}
 
 		return nil
@@ -353,6 +584,12 @@ func (h *eventHandlerImpl) updateUpstreamServers(
 		}
 
 		for _, u := range conf.Upstreams {
+			// The servers of upstreams with endpoints that NGINX resolves by DNS are only managed by the upstream
+			// configuration, so the API must not replace them with the unresolved hostnames.
+			if slices.ContainsFunc(u.Endpoints, func(ep resolver.Endpoint) bool { return ep.Resolve }) {
+				continue
+			}
+
 			upstream := upstream{
 				name:    u.Name,
 				servers: ngxConfig.ConvertEndpoints(u.Endpoints),
@@ -384,18 +621,30 @@ func (h *eventHandlerImpl) updateUpstreamServers(
 	return reload()
 }
 
+// peerStateDraining is the state of an upstream server in the NGINX Plus API when it is draining.
+const peerStateDraining = "draining"
+
 func serversEqual(newServers []ngxclient.UpstreamServer, oldServers []ngxclient.Peer) bool {
 	if len(newServers) != len(oldServers) {
 		return false
 	}
 
-	diff := make(map[string]struct{}, len(newServers))
+	type serverState struct {
+		backup bool
+		drain  bool
+	}
+
+	diff := make(map[string]serverState, len(newServers))
 	for _, s := range newServers {
-		diff[s.Server] = struct{}{}
+		diff[s.Server] = serverState{
+			backup: s.Backup != nil && *s.Backup,
+			drain:  s.Drain,
+		}
 	}
 
 	for _, s := range oldServers {
-		if _, ok := diff[s.Server]; !ok {
+		state, ok := diff[s.Server]
+		if !ok || state.backup != s.Backup || state.drain != (s.State == peerStateDraining) {
 			return false
 		}
 	}
@@ -418,6 +667,8 @@ func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
 		h.cfg.eventRecorder,
 		h.cfg.controlConfigNSName,
 		h.cfg.logLevelSetter,
+		h.cfg.eventBatchingSetter,
+		h.cfg.defaultEventBatching,
 	); err != nil {
 		msg := "Failed to update control plane configuration"
 		logger.Error(err, msg)
@@ -479,6 +730,12 @@ func getGatewayAddresses(
 		}
 	}
 
+	for _, ip := range gwSvc.Spec.ExternalIPs {
+		if !slices.Contains(addresses, ip) {
+			addresses = append(addresses, ip)
+		}
+	}
+
 	gwAddresses := make([]gatewayv1.GatewayStatusAddress, 0, len(addresses)+len(hostnames))
 	for _, addr := range addresses {
 		statusAddr := gatewayv1.GatewayStatusAddress{
@@ -507,6 +764,14 @@ func (h *eventHandlerImpl) GetLatestConfiguration() *dataplane.Configuration {
 	return h.latestConfiguration
 }
 
+// GetNginxReloadCount gets the number of successful NGINX reloads.
+func (h *eventHandlerImpl) GetNginxReloadCount() int64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.nginxReloadCount
+}
+
 // setLatestConfiguration sets the latest configuration.
 func (h *eventHandlerImpl) setLatestConfiguration(cfg *dataplane.Configuration) {
 	h.lock.Lock()
@@ -551,22 +816,20 @@ func (h *eventHandlerImpl) nginxGatewayServiceUpsert(ctx context.Context, logger
 		panic(fmt.Errorf("obj type mismatch: got %T, expected %T", svc, &v1.Service{}))
 	}
 
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, svc, h.cfg.gatewayPodConfig)
-	if err != nil {
-		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
-	}
-
 	graph := h.cfg.processor.GetLatestGraph()
 	if graph == nil {
 		return
 	}
 
+	gwAddresses, addressConds := h.prepareGatewayAddresses(ctx, logger, svc, graph.Gateway)
+
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
 		graph.Gateway,
 		graph.IgnoredGateways,
 		transitionTime,
 		gwAddresses,
+		addressConds,
 		h.latestReloadResult,
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gatewayStatuses...)
@@ -577,22 +840,20 @@ func (h *eventHandlerImpl) nginxGatewayServiceDelete(
 	logger logr.Logger,
 	_ types.NamespacedName,
 ) {
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, h.cfg.gatewayPodConfig)
-	if err != nil {
-		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
-	}
-
 	graph := h.cfg.processor.GetLatestGraph()
 	if graph == nil {
 		return
 	}
 
+	gwAddresses, addressConds := h.prepareGatewayAddresses(ctx, logger, nil, graph.Gateway)
+
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
 		graph.Gateway,
 		graph.IgnoredGateways,
 		transitionTime,
 		gwAddresses,
+		addressConds,
 		h.latestReloadResult,
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gatewayStatuses...)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/go-logr/logr"
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,7 +12,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -21,18 +24,23 @@ import (
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status/statusfakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
+	ngxConfig "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file/filefakes"
+	ngxruntime "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/statefakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/staticfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/status"
 )
 
 var _ = Describe("eventHandler", func() {
@@ -44,6 +52,7 @@ var _ = Describe("eventHandler", func() {
 		fakeNginxRuntimeMgr *runtimefakes.FakeManager
 		fakeStatusUpdater   *statusfakes.FakeGroupUpdater
 		fakeEventRecorder   *record.FakeRecorder
+		fakeEventEmitter    *staticfakes.FakeEventEmitter
 		fakeK8sClient       client.WithWatch
 		namespace           = "nginx-gateway"
 		configName          = "nginx-gateway-config"
@@ -73,12 +82,17 @@ var _ = Describe("eventHandler", func() {
 
 		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).Should(Equal(1))
 
-		Expect(fakeStatusUpdater.UpdateGroupCallCount()).Should(Equal(2))
+		Expect(fakeStatusUpdater.UpdateGroupCallCount()).Should(Equal(3))
 		_, name, reqs := fakeStatusUpdater.UpdateGroupArgsForCall(0)
+		Expect(name).To(Equal(groupNginxConfig))
+		Expect(reqs).To(HaveLen(1))
+		Expect(reqs[0].NsName).To(Equal(types.NamespacedName{Namespace: namespace, Name: configName}))
+
+		_, name, reqs = fakeStatusUpdater.UpdateGroupArgsForCall(1)
 		Expect(name).To(Equal(groupAllExceptGateways))
 		Expect(reqs).To(BeEmpty())
 
-		_, name, reqs = fakeStatusUpdater.UpdateGroupArgsForCall(1)
+		_, name, reqs = fakeStatusUpdater.UpdateGroupArgsForCall(2)
 		Expect(name).To(Equal(groupGateways))
 		Expect(reqs).To(BeEmpty())
 	}
@@ -91,8 +105,12 @@ var _ = Describe("eventHandler", func() {
 		fakeNginxRuntimeMgr = &runtimefakes.FakeManager{}
 		fakeStatusUpdater = &statusfakes.FakeGroupUpdater{}
 		fakeEventRecorder = record.NewFakeRecorder(1)
+		fakeEventEmitter = &staticfakes.FakeEventEmitter{}
 		zapLogLevelSetter = newZapLogLevelSetter(zap.NewAtomicLevel())
-		fakeK8sClient = fake.NewFakeClient()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1.Install(scheme)).To(Succeed())
+		fakeK8sClient = fake.NewClientBuilder().WithScheme(scheme).Build()
 
 		// Needed because handler checks the service from the API on every HandleEventBatch
 		Expect(fakeK8sClient.Create(context.Background(), createService(nginxGatewayServiceName))).To(Succeed())
@@ -102,10 +120,12 @@ var _ = Describe("eventHandler", func() {
 			processor:                     fakeProcessor,
 			generator:                     fakeGenerator,
 			logLevelSetter:                zapLogLevelSetter,
+			eventBatchingSetter:           events.NewAtomicBatchingConfig(events.BatchingConfig{}),
 			nginxFileMgr:                  fakeNginxFileMgr,
 			nginxRuntimeMgr:               fakeNginxRuntimeMgr,
 			statusUpdater:                 fakeStatusUpdater,
 			eventRecorder:                 fakeEventRecorder,
+			eventEmitter:                  fakeEventEmitter,
 			nginxConfiguredOnStartChecker: newNginxConfiguredOnStartChecker(),
 			controlConfigNSName:           types.NamespacedName{Namespace: namespace, Name: configName},
 			gatewayPodConfig: config.GatewayPodConfig{
@@ -114,6 +134,11 @@ var _ = Describe("eventHandler", func() {
 			},
 			metricsCollector:         collectors.NewControllerNoopCollector(),
 			updateGatewayClassStatus: true,
+			addressRequester: newGatewayAddressRequester(
+				fakeK8sClient,
+				types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway"},
+				logr.Discard(),
+			),
 		})
 		Expect(handler.cfg.nginxConfiguredOnStartChecker.ready).To(BeFalse())
 	})
@@ -194,7 +219,229 @@ var _ = Describe("eventHandler", func() {
 				checkDeleteEventExpectations(deleteEvent)
 
 				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
+			})
+		})
+
+		When("collecting metrics", func() {
+			It("should observe the graph build time only when the graph is built", func() {
+				fakeMetricsCollector := &staticfakes.FakeHandlerMetricsCollector{}
+				handler.cfg.metricsCollector = fakeMetricsCollector
+
+				batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				Expect(fakeMetricsCollector.ObserveGraphBuildTimeCallCount()).To(Equal(1))
+
+				fakeProcessor.ProcessReturns(state.NoChange, &graph.Graph{})
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				Expect(fakeMetricsCollector.ObserveGraphBuildTimeCallCount()).To(Equal(1))
+
+				fakeProcessor.ProcessReturns(state.EndpointsOnlyChange, &graph.Graph{})
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				Expect(fakeMetricsCollector.ObserveGraphBuildTimeCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the NGINX configuration doesn't change", func() {
+			It("should not write the files or reload NGINX", func() {
+				batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(2))
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
+				Expect(handler.latestConfigHash).To(Equal(ngxConfig.HashFiles(fakeCfgFiles)))
+			})
+
+			It("should reload NGINX if the configuration changes", func() {
+				batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				newFiles := []file.File{{Type: file.TypeRegular, Path: "test.conf", Content: []byte("new")}}
+				fakeGenerator.GenerateReturns(newFiles)
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 2})).To(BeEmpty())
+				Expect(handler.latestConfigHash).To(Equal(ngxConfig.HashFiles(newFiles)))
+			})
+
+			It("should reload NGINX if the last reload failed", func() {
+				batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+				fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				Expect(handler.latestConfigHash).To(BeEmpty())
+
+				Expect(fakeEventEmitter.EmitCallCount()).To(Equal(1))
+				_, reloadRes := fakeEventEmitter.EmitArgsForCall(0)
+				Expect(reloadRes.Error).To(MatchError(ContainSubstring("reload error")))
+
+				fakeNginxRuntimeMgr.ReloadReturns(nil)
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeEventEmitter.EmitCallCount()).To(Equal(2))
+				_, reloadRes = fakeEventEmitter.EmitArgsForCall(1)
+				Expect(reloadRes.Error).ToNot(HaveOccurred())
+
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
 				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 2})).To(BeEmpty())
+				Expect(handler.GetNginxReloadCount()).To(Equal(int64(1)))
+			})
+
+			It("should find the invalid snippet if the reload failed", func() {
+				fakeConfigTester := &staticfakes.FakeConfigTester{}
+				handler.cfg.nginxConfigTester = fakeConfigTester
+
+				batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+				fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+				fakeConfigTester.TestConfigReturns(errors.New(
+					`[emerg] unknown directive "invalid" in /etc/nginx/conf.d/SnippetsFilter_http_test_sf.conf:1`,
+				))
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeConfigTester.TestConfigCallCount()).To(Equal(1))
+				Expect(fakeEventEmitter.EmitCallCount()).To(Equal(1))
+				_, reloadRes := fakeEventEmitter.EmitArgsForCall(0)
+				Expect(reloadRes.InvalidSnippet).To(Equal(&status.InvalidSnippet{
+					Error: errors.New(
+						`[emerg] unknown directive "invalid" in /etc/nginx/conf.d/SnippetsFilter_http_test_sf.conf:1`,
+					),
+					OwnerKind: graph.SnippetsFilterKind,
+					Owner:     types.NamespacedName{Namespace: "test", Name: "sf"},
+				}))
+
+				fakeConfigTester.TestConfigReturns(errors.New("[emerg] host not found in upstream"))
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeConfigTester.TestConfigCallCount()).To(Equal(2))
+				_, reloadRes = fakeEventEmitter.EmitArgsForCall(1)
+				Expect(reloadRes.Error).To(HaveOccurred())
+				Expect(reloadRes.InvalidSnippet).To(BeNil())
+
+				fakeNginxRuntimeMgr.ReloadReturns(nil)
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeConfigTester.TestConfigCallCount()).To(Equal(2))
+			})
+		})
+
+		When("Routes are no longer attached", func() {
+			const gatewayCtlrName = "gateway.nginx.org/nginx-gateway"
+
+			latestReqs := func() []frameworkStatus.UpdateRequest {
+				for i := fakeStatusUpdater.UpdateGroupCallCount() - 1; i >= 0; i-- {
+					_, name, reqs := fakeStatusUpdater.UpdateGroupArgsForCall(i)
+					if name == groupAllExceptGateways {
+						return reqs
+					}
+				}
+				return nil
+			}
+
+			createRoute := func(name string, ctlrName gatewayv1.GatewayController) *gatewayv1.HTTPRoute {
+				hr := &gatewayv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+					Status: gatewayv1.HTTPRouteStatus{
+						RouteStatus: gatewayv1.RouteStatus{
+							Parents: []gatewayv1.RouteParentStatus{
+								{
+									ParentRef:      gatewayv1.ParentReference{Name: "gateway"},
+									ControllerName: ctlrName,
+								},
+							},
+						},
+					},
+				}
+				Expect(fakeK8sClient.Create(context.Background(), hr)).To(Succeed())
+
+				return hr
+			}
+
+			var hr *gatewayv1.HTTPRoute
+
+			batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+			BeforeEach(func() {
+				handler.cfg.gatewayCtlrName = gatewayCtlrName
+
+				// the Route has the status written by NGF, but it no longer references the Gateway
+				hr = createRoute("hr", gatewayCtlrName)
+				fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+			})
+
+			It("should remove the statuses of the Routes", func() {
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				reqs := latestReqs()
+				Expect(reqs).To(HaveLen(1))
+				Expect(reqs[0].NsName).To(Equal(client.ObjectKeyFromObject(hr)))
+				Expect(reqs[0].ResourceType).To(BeAssignableToTypeOf(&gatewayv1.HTTPRoute{}))
+
+				// the leader removed the status of the Route
+				hr.Status.Parents = nil
+				Expect(fakeK8sClient.Update(context.Background(), hr)).To(Succeed())
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(latestReqs()).To(BeEmpty())
+			})
+
+			It("should keep requesting the removal until the status is removed", func() {
+				// not the leader: the status updater doesn't write the requests
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				reqs := latestReqs()
+				Expect(reqs).To(HaveLen(1))
+				Expect(reqs[0].NsName).To(Equal(client.ObjectKeyFromObject(hr)))
+			})
+
+			It("should remove the statuses of the Routes that detached while NGF was not running", func() {
+				// a new handler, like after a restart, has no knowledge of the previous Routes
+				freshHandler := newEventHandlerImpl(handler.cfg)
+
+				freshHandler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				reqs := latestReqs()
+				Expect(reqs).To(HaveLen(1))
+				Expect(reqs[0].NsName).To(Equal(client.ObjectKeyFromObject(hr)))
+			})
+
+			It("should not request the removal of the statuses of other controllers", func() {
+				Expect(fakeK8sClient.Delete(context.Background(), hr)).To(Succeed())
+				createRoute("other", "example.com/other-controller")
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(latestReqs()).To(BeEmpty())
+			})
+
+			It("should not request the removal when the Route is attached", func() {
+				fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(hr): {
+							Source:    hr,
+							RouteType: graph.RouteTypeHTTP,
+						},
+					},
+				})
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				// only the request with the status of the attached Route
+				Expect(latestReqs()).To(HaveLen(1))
 			})
 		})
 	})
@@ -238,9 +485,9 @@ var _ = Describe("eventHandler", func() {
 
 			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
 
-			Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(2))
+			Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(3))
 
-			_, name, reqs := fakeStatusUpdater.UpdateGroupArgsForCall(0)
+			_, name, reqs := fakeStatusUpdater.UpdateGroupArgsForCall(1)
 			Expect(name).To(Equal(groupAllExceptGateways))
 			Expect(reqs).To(HaveLen(expectedReqsCount))
 			for _, req := range reqs {
@@ -447,6 +694,11 @@ var _ = Describe("eventHandler", func() {
 						Namespace:   "nginx-gateway",
 					},
 					metricsCollector: collectors.NewControllerNoopCollector(),
+					addressRequester: newGatewayAddressRequester(
+						fake.NewFakeClient(),
+						types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway"},
+						logr.Discard(),
+					),
 				})
 			})
 
@@ -548,14 +800,13 @@ var _ = Describe("eventHandler", func() {
 		}
 
 		type callCounts struct {
-			generate int
-			update   int
-			reload   int
+			replace int
+			update  int
+			reload  int
 		}
 
 		assertCallCounts := func(cc callCounts) {
-			Expect(fakeGenerator.GenerateCallCount()).To(Equal(cc.generate))
-			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(cc.generate))
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(cc.replace))
 			Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(cc.update))
 			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(cc.reload))
 		}
@@ -577,38 +828,109 @@ var _ = Describe("eventHandler", func() {
 			})
 
 			It("should update servers using the NGINX Plus API", func() {
-				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf)).To(Succeed())
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf, nil)).To(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 1, reload: 0})
+			})
+
+			It("should not update servers of upstreams resolved by DNS", func() {
+				resolveConf := dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name: "one",
+							Endpoints: []resolver.Endpoint{
+								{Address: "example.com", Port: 80, Resolve: true},
+							},
+						},
+					},
+				}
 
-				assertCallCounts(callCounts{generate: 1, update: 1, reload: 0})
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), resolveConf, nil)).To(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 0})
 			})
 
 			It("should reload when GET API returns an error", func() {
 				fakeNginxRuntimeMgr.GetUpstreamsReturns(nil, errors.New("error"))
-				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf)).To(Succeed())
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf, nil)).To(Succeed())
 
-				assertCallCounts(callCounts{generate: 1, update: 0, reload: 1})
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
 			})
 
 			It("should reload when POST API returns an error", func() {
 				fakeNginxRuntimeMgr.UpdateHTTPServersReturns(errors.New("error"))
-				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf)).To(Succeed())
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf, nil)).To(Succeed())
 
-				assertCallCounts(callCounts{generate: 1, update: 1, reload: 1})
+				assertCallCounts(callCounts{replace: 1, update: 1, reload: 1})
 			})
 		})
 
 		When("not running NGINX Plus", func() {
 			It("should update servers by reloading", func() {
-				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf)).To(Succeed())
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf, nil)).To(Succeed())
 
-				assertCallCounts(callCounts{generate: 1, update: 0, reload: 1})
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
 			})
 
 			It("should return an error when reloading fails", func() {
 				fakeNginxRuntimeMgr.ReloadReturns(errors.New("error"))
-				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf)).ToNot(Succeed())
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), conf, nil)).ToNot(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
+			})
+		})
+
+		When("using dynamic upstreams with NGINX open source", func() {
+			dynamicConf := dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{
+						Name: "one",
+						Endpoints: []resolver.Endpoint{
+							{Address: "10.0.0.1", Port: 80},
+						},
+					},
+				},
+				DynamicUpstreams: true,
+			}
+
+			It("should update servers without reloading", func() {
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), dynamicConf, nil)).To(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 0})
+				Expect(fakeNginxRuntimeMgr.UpdateDynamicUpstreamsCallCount()).To(Equal(1))
+				_, upstreams := fakeNginxRuntimeMgr.UpdateDynamicUpstreamsArgsForCall(0)
+				Expect(upstreams).To(Equal(map[string]ngxruntime.DynamicUpstream{
+					"one": {Servers: []string{"10.0.0.1:80"}},
+				}))
+				Expect(fakeNginxRuntimeMgr.ResetDynamicUpstreamsCallCount()).To(Equal(0))
+			})
 
-				assertCallCounts(callCounts{generate: 1, update: 0, reload: 1})
+			It("should reload and reset the dynamic upstreams when the update fails", func() {
+				fakeNginxRuntimeMgr.UpdateDynamicUpstreamsReturns(errors.New("error"))
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), dynamicConf, nil)).To(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
+				Expect(fakeNginxRuntimeMgr.ResetDynamicUpstreamsCallCount()).To(Equal(1))
+			})
+
+			It("should reload when an upstream has no endpoints", func() {
+				noEndpointsConf := dataplane.Configuration{
+					Upstreams:        []dataplane.Upstream{{Name: "one"}},
+					DynamicUpstreams: true,
+				}
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), noEndpointsConf, nil)).To(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
+				Expect(fakeNginxRuntimeMgr.UpdateDynamicUpstreamsCallCount()).To(Equal(0))
+				Expect(fakeNginxRuntimeMgr.ResetDynamicUpstreamsCallCount()).To(Equal(1))
+			})
+
+			It("should return an error when resetting the dynamic upstreams fails", func() {
+				fakeNginxRuntimeMgr.UpdateDynamicUpstreamsReturns(errors.New("error"))
+				fakeNginxRuntimeMgr.ResetDynamicUpstreamsReturns(errors.New("error"))
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), dynamicConf, nil)).ToNot(Succeed())
+
+				assertCallCounts(callCounts{replace: 1, update: 0, reload: 1})
 			})
 		})
 	})
@@ -728,6 +1050,50 @@ var _ = Describe("serversEqual", func() {
 			},
 			true,
 		),
+		Entry("differing backup flags",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Backup: helpers.GetPointer(true)},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2"},
+			},
+			false,
+		),
+		Entry("differing drain flags",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2", State: "up"},
+			},
+			false,
+		),
+		Entry("same drain flags",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2", State: "draining"},
+			},
+			true,
+		),
+		Entry("same backup flags",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Backup: helpers.GetPointer(true)},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2", Backup: true},
+			},
+			true,
+		),
 	)
 })
 
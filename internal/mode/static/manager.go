@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -11,7 +12,11 @@ import (
 	tel "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	admregv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -22,14 +27,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	crwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -45,21 +54,41 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/runnables"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	ngxvalidation "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	ngxruntime "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/sandbox"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+	ngfstatus "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/status"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/usage"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/webhook"
 )
 
 const (
 	// clusterTimeout is a timeout for connections to the Kubernetes API
 	clusterTimeout = 10 * time.Second
+	// webhookCertDir is the directory where the serving certificate and key of the webhook are written.
+	webhookCertDir = "/var/run/nginx-gateway/webhook"
+	// webhookCertRenewalPeriod is how often the certificates of the webhook are checked and renewed if they are
+	// about to expire.
+	webhookCertRenewalPeriod = time.Hour
+	// snippetsValidationDir is the directory that is shared with the snippets-validator container, which tests
+	// the snippets and the NGINX configuration with nginx -t.
+	snippetsValidationDir = "/var/run/nginx-validation"
+	// snippetsValidatorReadyTimeout is the time to wait on startup for the snippets-validator container to test
+	// a configuration.
+	snippetsValidatorReadyTimeout = time.Minute
+	// eventsQPS is the sustained rate of the Events about configuration and reload problems.
+	eventsQPS = 1
+	// eventsBurst is the maximum number of Events about configuration and reload problems that are emitted at once.
+	eventsBurst = 25
 )
 
 var scheme = runtime.NewScheme()
@@ -73,12 +102,37 @@ func init() {
 	utilruntime.Must(ngfAPI.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(admregv1.AddToScheme(scheme))
+	utilruntime.Must(authnv1.AddToScheme(scheme))
+	utilruntime.Must(authzv1.AddToScheme(scheme))
 }
 
 // nolint:gocyclo
 func StartManager(cfg config.Config) error {
 	nginxChecker := newNginxConfiguredOnStartChecker()
-	mgr, err := createManager(cfg, nginxChecker)
+	debugHandler := debug.NewHandler(cfg.Logger.WithName("debug"))
+
+	clusterCfg := ctlr.GetConfigOrDie()
+	clusterCfg.Timeout = clusterTimeout
+
+	// The cache and the graph use the same watched Namespaces, so that the graph doesn't consider a Namespace
+	// watched while the cache doesn't have its resources, or the other way around.
+	var watchedNamespaces []string
+	if isWatchNamespacesSet(cfg.WatchNamespaces) {
+		reader, err := client.New(clusterCfg, client.Options{Scheme: scheme})
+		if err != nil {
+			return fmt.Errorf("error creating client for Namespaces: %w", err)
+		}
+
+		watchedNamespaces, err = getWatchedNamespaceNames(reader, cfg)
+		if err != nil {
+			return fmt.Errorf("error determining watched Namespaces: %w", err)
+		}
+
+		cfg.Logger.Info("Watching resources in a subset of Namespaces", "namespaces", watchedNamespaces)
+	}
+
+	mgr, err := createManager(cfg, clusterCfg, watchedNamespaces, nginxChecker, debugHandler)
 	if err != nil {
 		return fmt.Errorf("cannot build runtime manager: %w", err)
 	}
@@ -93,6 +147,9 @@ func StartManager(cfg config.Config) error {
 
 	logLevelSetter := newMultiLogLevelSetter(newZapLogLevelSetter(cfg.AtomicLevel), newPromLogLevelSetter(promLogger))
 
+	defaultEventBatching := getDefaultEventBatching(cfg.EventBatching)
+	eventBatching := events.NewAtomicBatchingConfig(defaultEventBatching)
+
 	ctx := ctlr.SetupSignalHandler()
 
 	eventCh := make(chan interface{})
@@ -100,27 +157,55 @@ func StartManager(cfg config.Config) error {
 		Namespace: cfg.GatewayPodConfig.Namespace,
 		Name:      cfg.ConfigName,
 	}
-	if err := registerControllers(ctx, cfg, mgr, recorder, logLevelSetter, eventCh, controlConfigNSName); err != nil {
+	if err := registerControllers(
+		ctx,
+		cfg,
+		mgr,
+		recorder,
+		logLevelSetter,
+		eventBatching,
+		eventCh,
+		controlConfigNSName,
+	); err != nil {
 		return err
 	}
 
-	// protectedPorts is the map of ports that may not be configured by a listener, and the name of what it is used for
-	protectedPorts := map[int32]string{
-		int32(cfg.MetricsConfig.Port): "MetricsPort",
-		int32(cfg.HealthConfig.Port):  "HealthPort",
+	validators := validation.Validators{
+		HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+		GenericValidator:    ngxvalidation.GenericValidator{},
+	}
+
+	// nginxConfigTester must stay a nil interface if SnippetsFilters are disabled.
+	var nginxConfigTester configTester
+	if cfg.SnippetsFilters {
+		sandboxValidator := sandbox.NewValidator(snippetsValidationDir)
+		// Snippets are validated while the graph is built, so a missing snippets-validator container would
+		// delay every event batch. Fail fast instead.
+		if err := sandboxValidator.WaitUntilReady(ctx, snippetsValidatorReadyTimeout); err != nil {
+			return fmt.Errorf("snippets-validator container is not ready: %w", err)
+		}
+		validators.SnippetValidator = sandboxValidator
+		nginxConfigTester = sandboxValidator
+	}
+
+	// The processor runs the same validation, so invalid resources are still rejected if the webhook is disabled.
+	if cfg.Webhook.Enabled {
+		if err := setupWebhook(ctx, cfg, mgr, validators); err != nil {
+			return fmt.Errorf("cannot set up webhook: %w", err)
+		}
 	}
 
 	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
-		GatewayCtlrName:  cfg.GatewayCtlrName,
-		GatewayClassName: cfg.GatewayClassName,
-		Logger:           cfg.Logger.WithName("changeProcessor"),
-		Validators: validation.Validators{
-			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
-			GenericValidator:    ngxvalidation.GenericValidator{},
-		},
-		EventRecorder:  recorder,
-		Scheme:         scheme,
-		ProtectedPorts: protectedPorts,
+		GatewayCtlrName:   cfg.GatewayCtlrName,
+		GatewayClassName:  cfg.GatewayClassName,
+		Logger:            cfg.Logger.WithName("changeProcessor"),
+		Validators:        validators,
+		EventRecorder:     recorder,
+		Scheme:            scheme,
+		ProtectedPorts:    getProtectedPorts(cfg),
+		WatchedNamespaces: graph.WatchedNamespaces{Names: watchedNamespaces},
+		Plus:              cfg.Plus,
+		SnippetsFilters:   cfg.SnippetsFilters,
 	})
 
 	// Clear the configuration folders to ensure that no files are left over in case the control plane was restarted
@@ -141,6 +226,7 @@ func StartManager(cfg config.Config) error {
 	var (
 		ngxruntimeCollector ngxruntime.MetricsCollector = collectors.NewManagerNoopCollector()
 		handlerCollector    handlerMetricsCollector     = collectors.NewControllerNoopCollector()
+		usageCollector      usage.MetricsCollector      = collectors.NewUsageReportNoopCollector()
 	)
 
 	var ngxPlusClient *ngxclient.NginxClient
@@ -150,22 +236,6 @@ func StartManager(cfg config.Config) error {
 		if err != nil {
 			return fmt.Errorf("error creating NGINX plus client: %w", err)
 		}
-
-		if cfg.UsageReportConfig != nil {
-			usageSecret = usage.NewUsageSecret()
-			reporter, err := createUsageReporterJob(mgr.GetAPIReader(), cfg, usageSecret, nginxChecker.getReadyCh())
-			if err != nil {
-				return fmt.Errorf("error creating usage reporter job")
-			}
-
-			if err = mgr.Add(reporter); err != nil {
-				return fmt.Errorf("cannot register usage reporter: %w", err)
-			}
-		} else {
-			if err = mgr.Add(createUsageWarningJob(cfg, nginxChecker.getReadyCh())); err != nil {
-				return fmt.Errorf("cannot register usage warning job: %w", err)
-			}
-		}
 	}
 
 	if cfg.MetricsConfig.Enabled {
@@ -187,6 +257,36 @@ func StartManager(cfg config.Config) error {
 			ngxruntimeCollector.(prometheus.Collector),
 			handlerCollector.(prometheus.Collector),
 		)
+
+		if cfg.Plus && cfg.UsageReportConfig != nil {
+			usageCollector = collectors.NewUsageReportCollector(constLabels)
+			metrics.Registry.MustRegister(usageCollector.(prometheus.Collector))
+		}
+	}
+
+	if cfg.Plus {
+		if cfg.UsageReportConfig != nil {
+			usageSecret = usage.NewUsageSecret()
+			reporter, err := createUsageReporterJob(
+				mgr.GetAPIReader(),
+				mgr.GetClient(),
+				cfg,
+				usageSecret,
+				usageCollector,
+				nginxChecker.getReadyCh(),
+			)
+			if err != nil {
+				return fmt.Errorf("error creating usage reporter job: %w", err)
+			}
+
+			if err = mgr.Add(reporter); err != nil {
+				return fmt.Errorf("cannot register usage reporter: %w", err)
+			}
+		} else {
+			if err = mgr.Add(createUsageWarningJob(cfg, nginxChecker.getReadyCh())); err != nil {
+				return fmt.Errorf("cannot register usage warning job: %w", err)
+			}
+		}
 	}
 
 	statusUpdater := status.NewUpdater(
@@ -196,12 +296,42 @@ func StartManager(cfg config.Config) error {
 
 	groupStatusUpdater := status.NewLeaderAwareGroupUpdater(statusUpdater)
 
+	if err = registerStatusRestoreControllers(cfg, mgr, groupStatusUpdater); err != nil {
+		return err
+	}
+
+	eventEmitter := ngfstatus.NewEventEmitter(
+		recorder,
+		flowcontrol.NewTokenBucketRateLimiter(eventsQPS, eventsBurst),
+		cfg.Logger.WithName("eventEmitter"),
+	)
+
+	addressRequester := newGatewayAddressRequester(
+		mgr.GetClient(),
+		types.NamespacedName{Namespace: cfg.GatewayPodConfig.Namespace, Name: cfg.GatewayPodConfig.ServiceName},
+		cfg.Logger.WithName("addressRequester"),
+	)
+
+	zone, err := getNodeZone(ctx, mgr.GetAPIReader(), cfg.GatewayPodConfig.NodeName)
+	if err != nil {
+		cfg.Logger.Error(err, "Cannot determine the zone of NGINX; topology-aware routing is not possible")
+	} else if zone == "" {
+		cfg.Logger.Info(
+			"The Node of NGINX has no zone label; topology-aware routing is not possible",
+			"label", apiv1.LabelTopologyZone,
+		)
+	}
+
+	generator := ngxcfg.NewGeneratorImpl(cfg.Plus)
+
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
-		k8sClient:       mgr.GetClient(),
-		processor:       processor,
-		serviceResolver: resolver.NewServiceResolverImpl(mgr.GetClient()),
-		generator:       ngxcfg.NewGeneratorImpl(cfg.Plus),
-		logLevelSetter:  logLevelSetter,
+		k8sClient:            mgr.GetClient(),
+		processor:            processor,
+		serviceResolver:      resolver.NewServiceResolverImpl(mgr.GetClient()),
+		generator:            generator,
+		logLevelSetter:       logLevelSetter,
+		eventBatchingSetter:  eventBatching,
+		defaultEventBatching: defaultEventBatching,
 		nginxFileMgr: file.NewManagerImpl(
 			cfg.Logger.WithName("nginxFileManager"),
 			file.NewStdLibOSFileManager(),
@@ -213,20 +343,34 @@ func StartManager(cfg config.Config) error {
 		),
 		statusUpdater:                 groupStatusUpdater,
 		eventRecorder:                 recorder,
+		eventEmitter:                  eventEmitter,
+		addressRequester:              addressRequester,
 		nginxConfiguredOnStartChecker: nginxChecker,
 		controlConfigNSName:           controlConfigNSName,
 		gatewayPodConfig:              cfg.GatewayPodConfig,
+		zone:                          zone,
 		metricsCollector:              handlerCollector,
 		usageReportConfig:             cfg.UsageReportConfig,
 		usageSecret:                   usageSecret,
 		gatewayCtlrName:               cfg.GatewayCtlrName,
 		updateGatewayClassStatus:      cfg.UpdateGatewayClassStatus,
+		experimentalFeatures:          cfg.ExperimentalFeatures,
+		nginxConfigTester:             nginxConfigTester,
+	})
+
+	debugHandler.Enable(debug.HandlerConfig{
+		Authorizer:          debug.NewTokenReviewAuthorizer(mgr.GetClient()),
+		GraphGetter:         processor,
+		ConfigurationGetter: eventHandler,
+		Generator:           generator,
 	})
 
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(
 		cfg.GatewayClassName,
 		cfg.GatewayNsName,
 		cfg.ExperimentalFeatures,
+		cfg.SnippetsFilters,
+		cfg.ErrorPagePolicies,
 	)
 	firstBatchPreparer := events.NewFirstEventBatchPreparerImpl(mgr.GetCache(), objects, objectLists)
 	eventLoop := events.NewEventLoop(
@@ -234,6 +378,8 @@ func StartManager(cfg config.Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		eventHandler,
 		firstBatchPreparer,
+		eventBatching,
+		handlerCollector,
 	)
 
 	if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: eventLoop}); err != nil {
@@ -244,18 +390,28 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot register status updater: %w", err)
 	}
 
+	if err = mgr.Add(runnables.NewEnableAfterBecameLeader(eventEmitter.Enable)); err != nil {
+		return fmt.Errorf("cannot register event emitter: %w", err)
+	}
+
+	if err = mgr.Add(runnables.NewEnableAfterBecameLeader(addressRequester.Enable)); err != nil {
+		return fmt.Errorf("cannot register address requester: %w", err)
+	}
+
 	if cfg.ProductTelemetryConfig.Enabled {
 		dataCollector := telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
-			K8sClientReader:     mgr.GetAPIReader(),
-			GraphGetter:         processor,
-			ConfigurationGetter: eventHandler,
-			Version:             cfg.Version,
+			K8sClientReader:        mgr.GetAPIReader(),
+			GraphGetter:            processor,
+			ConfigurationGetter:    eventHandler,
+			NginxReloadCountGetter: eventHandler,
+			Version:                cfg.Version,
 			PodNSName: types.NamespacedName{
 				Namespace: cfg.GatewayPodConfig.Namespace,
 				Name:      cfg.GatewayPodConfig.Name,
 			},
 			ImageSource: cfg.ImageSource,
 			Flags:       cfg.Flags,
+			Plus:        cfg.Plus,
 		})
 
 		job, err := createTelemetryJob(cfg, dataCollector, nginxChecker.getReadyCh())
@@ -272,11 +428,19 @@ func StartManager(cfg config.Config) error {
 	return mgr.Start(ctx)
 }
 
-func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecker) (manager.Manager, error) {
+// createManager creates the manager. If watchedNamespaces is not empty, the cache of the namespaced resources
+// is restricted to them.
+func createManager(
+	cfg config.Config,
+	clusterCfg *rest.Config,
+	watchedNamespaces []string,
+	nginxChecker *nginxConfiguredOnStartChecker,
+	debugHandler *debug.Handler,
+) (manager.Manager, error) {
 	options := manager.Options{
 		Scheme:  scheme,
 		Logger:  cfg.Logger,
-		Metrics: getMetricsOptions(cfg.MetricsConfig),
+		Metrics: getMetricsOptions(cfg.MetricsConfig, debugHandler),
 		// Note: when the leadership is lost, the manager will return an error in the Start() method.
 		// However, it will not wait for any Runnable it starts to finish, meaning any in-progress operations
 		// might get terminated half-way.
@@ -298,8 +462,18 @@ func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecke
 		options.HealthProbeBindAddress = fmt.Sprintf(":%d", cfg.HealthConfig.Port)
 	}
 
-	clusterCfg := ctlr.GetConfigOrDie()
-	clusterCfg.Timeout = clusterTimeout
+	if cfg.Webhook.Enabled {
+		options.WebhookServer = crwebhook.NewServer(crwebhook.Options{
+			Port:    cfg.Webhook.Port,
+			CertDir: webhookCertDir,
+		})
+	}
+
+	if len(watchedNamespaces) > 0 {
+		options.Cache = cache.Options{
+			ByObject: createNamespacedCacheByObject(cfg, watchedNamespaces),
+		}
+	}
 
 	mgr, err := manager.New(clusterCfg, options)
 	if err != nil {
@@ -315,12 +489,73 @@ func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecke
 	return mgr, nil
 }
 
+// setupWebhook makes sure the webhook has a serving certificate and registers the validators with the webhook server.
+func setupWebhook(
+	ctx context.Context,
+	cfg config.Config,
+	mgr manager.Manager,
+	validators validation.Validators,
+) error {
+	// The cache of the manager isn't started yet, so the certificates are managed with a non-cached client.
+	k8sClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+
+	certCfg := webhook.CertificateConfig{
+		SecretNsName: types.NamespacedName{
+			Namespace: cfg.GatewayPodConfig.Namespace,
+			Name:      cfg.Webhook.SecretName,
+		},
+		ServiceNsName: types.NamespacedName{
+			Namespace: cfg.GatewayPodConfig.Namespace,
+			Name:      cfg.Webhook.ServiceName,
+		},
+		WebhookConfigurationName: cfg.Webhook.ConfigurationName,
+		CertDir:                  webhookCertDir,
+	}
+
+	certLogger := cfg.Logger.WithName("webhookCertificate")
+
+	if err := webhook.EnsureCertificate(ctx, k8sClient, certLogger, certCfg); err != nil {
+		return err
+	}
+
+	// Every replica serves the webhook with its own copy of the certificate files, so every replica renews them.
+	readyCh := make(chan struct{})
+	close(readyCh)
+
+	renewal := &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  webhook.CreateCertificateRenewalWorker(k8sClient, certLogger, certCfg),
+			Logger:  certLogger,
+			Period:  webhookCertRenewalPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+
+	if err := mgr.Add(renewal); err != nil {
+		return fmt.Errorf("cannot register webhook certificate renewal: %w", err)
+	}
+
+	// The Gateways are read through the API reader, because the cache might not be synced when the webhook
+	// server starts admitting requests.
+	webhook.Register(mgr.GetWebhookServer(), scheme, validators, cfg.Plus, webhook.GatewayConfig{
+		Reader:           mgr.GetAPIReader(),
+		GatewayNsName:    cfg.GatewayNsName,
+		GatewayClassName: cfg.GatewayClassName,
+	})
+
+	return nil
+}
+
 func registerControllers(
 	ctx context.Context,
 	cfg config.Config,
 	mgr manager.Manager,
 	recorder record.EventRecorder,
 	logLevelSetter logLevelSetter,
+	eventBatching *events.AtomicBatchingConfig,
 	eventCh chan interface{},
 	controlConfigNSName types.NamespacedName,
 ) error {
@@ -421,6 +656,30 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPI.CompressionPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.HostnameBackend{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+	}
+
+	// ConfigMaps hold the CA certificates of BackendTLSPolicies and the content of the error pages of
+	// ErrorPagePolicies.
+	if cfg.ExperimentalFeatures || cfg.ErrorPagePolicies {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
+				// FIXME(ciarams87): If possible, use only metadata predicate
+				// https://github.com/nginxinc/nginx-gateway-fabric/issues/1545
+				objectType: &apiv1.ConfigMap{},
+			},
+		)
 	}
 
 	if cfg.ExperimentalFeatures {
@@ -431,11 +690,6 @@ func registerControllers(
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
-			{
-				// FIXME(ciarams87): If possible, use only metadata predicate
-				// https://github.com/nginxinc/nginx-gateway-fabric/issues/1545
-				objectType: &apiv1.ConfigMap{},
-			},
 			{
 				objectType: &gatewayv1alpha2.GRPCRoute{},
 				options: []controller.Option{
@@ -446,6 +700,28 @@ func registerControllers(
 		controllerRegCfgs = append(controllerRegCfgs, gwExpFeatures...)
 	}
 
+	if cfg.SnippetsFilters {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
+				objectType: &ngfAPI.SnippetsFilter{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		)
+	}
+
+	if cfg.ErrorPagePolicies {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
+				objectType: &ngfAPI.ErrorPagePolicy{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		)
+	}
+
 	if cfg.ConfigName != "" {
 		controllerRegCfgs = append(controllerRegCfgs,
 			ctlrCfg{
@@ -459,6 +735,8 @@ func registerControllers(
 			cfg.Logger,
 			recorder,
 			logLevelSetter,
+			eventBatching,
+			getDefaultEventBatching(cfg.EventBatching),
 			controlConfigNSName,
 		); err != nil {
 			return fmt.Errorf("error setting initial control plane configuration: %w", err)
@@ -479,6 +757,41 @@ func registerControllers(
 	return nil
 }
 
+// registerStatusRestoreControllers registers the controllers that restore the statuses written by NGF
+// when another actor changes them.
+func registerStatusRestoreControllers(cfg config.Config, mgr manager.Manager, restorer status.Restorer) error {
+	objectTypes := []client.Object{
+		&gatewayv1.GatewayClass{},
+		&gatewayv1.Gateway{},
+		&gatewayv1.HTTPRoute{},
+		&ngfAPI.CompressionPolicy{},
+	}
+
+	if cfg.ExperimentalFeatures {
+		objectTypes = append(objectTypes, &gatewayv1alpha2.BackendTLSPolicy{}, &gatewayv1alpha2.GRPCRoute{})
+	}
+
+	if cfg.SnippetsFilters {
+		objectTypes = append(objectTypes, &ngfAPI.SnippetsFilter{})
+	}
+
+	if cfg.ErrorPagePolicies {
+		objectTypes = append(objectTypes, &ngfAPI.ErrorPagePolicy{})
+	}
+
+	if cfg.ConfigName != "" {
+		objectTypes = append(objectTypes, &ngfAPI.NginxGateway{})
+	}
+
+	for _, objectType := range objectTypes {
+		if err := status.RegisterRestoreController(mgr, restorer, objectType); err != nil {
+			return fmt.Errorf("cannot register status restore controller for %T: %w", objectType, err)
+		}
+	}
+
+	return nil
+}
+
 // 10 min jitter is enough per telemetry destination recommendation
 // For the default period of 24 hours, jitter will be 10min /(24*60)min  = 0.0069
 const telemetryJitterFactor = 10.0 / (24 * 60) // added jitter is bound by jitterFactor * period
@@ -490,54 +803,104 @@ func createTelemetryJob(
 ) (*runnables.Leader, error) {
 	logger := cfg.Logger.WithName("telemetryJob")
 
-	var exporter telemetry.Exporter
+	exporter, err := createTelemetryExporter(cfg.ProductTelemetryConfig, logger)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create telemetry exporter: %w", err)
+	}
+
+	return &runnables.Leader{
+		Runnable: runnables.NewCronJob(
+			runnables.CronJobConfig{
+				Worker:       telemetry.CreateTelemetryJobWorker(logger, exporter, dataCollector),
+				Logger:       logger,
+				Period:       cfg.ProductTelemetryConfig.ReportPeriod,
+				JitterFactor: telemetryJitterFactor,
+				ReadyCh:      readyCh,
+			},
+		),
+	}, nil
+}
 
-	if cfg.ProductTelemetryConfig.Endpoint != "" {
-		errorHandler := tel.NewErrorHandler()
+const (
+	// telemetryFileMaxSize is the size at which the file of the file telemetry exporter is rotated.
+	// A report is about 1KB, so the file holds reports for years with the default period of 24 hours.
+	telemetryFileMaxSize = 1024 * 1024
+	// telemetryFileMaxBackups is the number of the rotated telemetry files to keep.
+	telemetryFileMaxBackups = 3
+)
+
+func createTelemetryExporter(cfg config.ProductTelemetryConfig, logger logr.Logger) (telemetry.Exporter, error) {
+	switch cfg.Exporter {
+	case config.ProductTelemetryExporterOTLPGRPC, "":
+		if cfg.Endpoint == "" {
+			return telemetry.NewLoggingExporter(logger.WithName("telemetryExporter").V(1 /* debug */)), nil
+		}
 
 		options := []otlptracegrpc.Option{
-			otlptracegrpc.WithEndpoint(cfg.ProductTelemetryConfig.Endpoint),
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
 			otlptracegrpc.WithHeaders(map[string]string{
 				"X-F5-OTEL": "GRPC",
 			}),
 		}
-		if cfg.ProductTelemetryConfig.EndpointInsecure {
+		if cfg.EndpointInsecure {
 			options = append(options, otlptracegrpc.WithInsecure())
 		}
 
-		var err error
-		exporter, err = tel.NewExporter(
-			tel.ExporterConfig{
-				SpanProvider: tel.CreateOTLPSpanProvider(options...),
-			},
-			tel.WithGlobalOTelLogger(logger.WithName("otel")),
-			tel.WithGlobalOTelErrorHandler(errorHandler),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("cannot create telemetry exporter: %w", err)
+		return createOTLPExporter(tel.CreateOTLPSpanProvider(options...), logger)
+	case config.ProductTelemetryExporterOTLPHTTP:
+		if cfg.Endpoint == "" {
+			return nil, errors.New("the endpoint is required for the OTLP HTTP exporter")
 		}
 
-	} else {
-		exporter = telemetry.NewLoggingExporter(cfg.Logger.WithName("telemetryExporter").V(1 /* debug */))
+		options := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(cfg.Endpoint),
+			otlptracehttp.WithHeaders(map[string]string{
+				"X-F5-OTEL": "HTTP",
+			}),
+		}
+		if cfg.EndpointInsecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+
+		return createOTLPExporter(telemetry.CreateOTLPHTTPSpanProvider(options...), logger)
+	case config.ProductTelemetryExporterFile:
+		if cfg.FilePath == "" {
+			return nil, errors.New("the file path is required for the file exporter")
+		}
+
+		return telemetry.NewFileExporter(telemetry.FileExporterConfig{
+			Path:       cfg.FilePath,
+			MaxSize:    telemetryFileMaxSize,
+			MaxBackups: telemetryFileMaxBackups,
+		}), nil
+	case config.ProductTelemetryExporterLogging:
+		return telemetry.NewLoggingExporter(logger.WithName("telemetryExporter")), nil
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", cfg.Exporter)
 	}
+}
 
-	return &runnables.Leader{
-		Runnable: runnables.NewCronJob(
-			runnables.CronJobConfig{
-				Worker:       telemetry.CreateTelemetryJobWorker(logger, exporter, dataCollector),
-				Logger:       logger,
-				Period:       cfg.ProductTelemetryConfig.ReportPeriod,
-				JitterFactor: telemetryJitterFactor,
-				ReadyCh:      readyCh,
-			},
-		),
-	}, nil
+func createOTLPExporter(spanProvider tel.SpanProvider, logger logr.Logger) (telemetry.Exporter, error) {
+	exporter, err := tel.NewExporter(
+		tel.ExporterConfig{
+			SpanProvider: spanProvider,
+		},
+		tel.WithGlobalOTelLogger(logger.WithName("otel")),
+		tel.WithGlobalOTelErrorHandler(tel.NewErrorHandler()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporter, nil
 }
 
 func createUsageReporterJob(
-	k8sClient client.Reader,
+	k8sReader client.Reader,
+	k8sClient client.Client,
 	cfg config.Config,
 	usageSecret *usage.Secret,
+	metricsCollector usage.MetricsCollector,
 	readyCh <-chan struct{},
 ) (*runnables.Leader, error) {
 	logger := cfg.Logger.WithName("usageReporter")
@@ -552,7 +915,24 @@ func createUsageReporterJob(
 
 	return &runnables.Leader{
 		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
-			Worker:       usage.CreateUsageJobWorker(logger, k8sClient, reporter, cfg),
+			Worker: usage.CreateUsageJobWorker(usage.JobWorkerConfig{
+				Logger:          logger,
+				K8sClientReader: k8sReader,
+				Reporter:        reporter,
+				Now:             time.Now,
+				// The ConfigMap is read through the API reader, because it might not be in the watched Namespaces.
+				ReportStore: usage.NewConfigMapReportStore(
+					k8sReader,
+					k8sClient,
+					types.NamespacedName{
+						Namespace: cfg.GatewayPodConfig.Namespace,
+						Name:      getUsageReportConfigMapName(cfg),
+					},
+				),
+				MetricsCollector: metricsCollector,
+				RetryBackoff:     usage.DefaultRetryBackoff(),
+				Config:           cfg,
+			}),
 			Logger:       logger,
 			Period:       cfg.ProductTelemetryConfig.ReportPeriod,
 			JitterFactor: telemetryJitterFactor,
@@ -561,6 +941,17 @@ func createUsageReporterJob(
 	}, nil
 }
 
+// getUsageReportConfigMapName returns the name of the ConfigMap that stores the unsent NGINX Plus usage reports.
+// The name is derived from the name of the Service, which is unique to every NGF Deployment in the Namespace.
+func getUsageReportConfigMapName(cfg config.Config) string {
+	name := cfg.GatewayPodConfig.ServiceName
+	if name == "" {
+		name = "nginx-gateway"
+	}
+
+	return name + "-usage-reports"
+}
+
 func createUsageWarningJob(cfg config.Config, readyCh <-chan struct{}) *runnables.LeaderOrNonLeader {
 	logger := cfg.Logger.WithName("usageReporter")
 	worker := func(_ context.Context) {
@@ -580,10 +971,40 @@ func createUsageWarningJob(cfg config.Config, readyCh <-chan struct{}) *runnable
 	}
 }
 
+// getProtectedPorts returns the map of ports that may not be configured by a listener,
+// and the name of what it is used for.
+func getProtectedPorts(cfg config.Config) map[int32]string {
+	protectedPorts := map[int32]string{
+		int32(cfg.MetricsConfig.Port): "MetricsPort",
+		int32(cfg.HealthConfig.Port):  "HealthPort",
+	}
+	if cfg.Webhook.Enabled {
+		protectedPorts[int32(cfg.Webhook.Port)] = "WebhookPort"
+	}
+
+	return protectedPorts
+}
+
+// getNodeZone returns the zone of the Node from its "topology.kubernetes.io/zone" label.
+func getNodeZone(ctx context.Context, reader client.Reader, nodeName string) (string, error) {
+	if nodeName == "" {
+		return "", errors.New("the name of the Node is unknown")
+	}
+
+	var node apiv1.Node
+	if err := reader.Get(ctx, types.NamespacedName{Name: nodeName}, &node); err != nil {
+		return "", fmt.Errorf("error getting Node %s: %w", nodeName, err)
+	}
+
+	return node.Labels[apiv1.LabelTopologyZone], nil
+}
+
 func prepareFirstEventBatchPreparerArgs(
 	gcName string,
 	gwNsName *types.NamespacedName,
 	enableExperimentalFeatures bool,
+	enableSnippetsFilters bool,
+	enableErrorPagePolicies bool,
 ) ([]client.Object, []client.ObjectList) {
 	objects := []client.Object{
 		&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: gcName}},
@@ -606,18 +1027,31 @@ func prepareFirstEventBatchPreparerArgs(
 		&gatewayv1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
 		&ngfAPI.NginxProxyList{},
+		&ngfAPI.CompressionPolicyList{},
+		&ngfAPI.HostnameBackendList{},
 		partialObjectMetadataList,
 	}
 
+	if enableExperimentalFeatures || enableErrorPagePolicies {
+		objectLists = append(objectLists, &apiv1.ConfigMapList{})
+	}
+
 	if enableExperimentalFeatures {
 		objectLists = append(
 			objectLists,
 			&gatewayv1alpha2.BackendTLSPolicyList{},
-			&apiv1.ConfigMapList{},
 			&gatewayv1alpha2.GRPCRouteList{},
 		)
 	}
 
+	if enableSnippetsFilters {
+		objectLists = append(objectLists, &ngfAPI.SnippetsFilterList{})
+	}
+
+	if enableErrorPagePolicies {
+		objectLists = append(objectLists, &ngfAPI.ErrorPagePolicyList{})
+	}
+
 	if gwNsName == nil {
 		objectLists = append(objectLists, &gatewayv1.GatewayList{})
 	} else {
@@ -635,6 +1069,8 @@ func setInitialConfig(
 	logger logr.Logger,
 	eventRecorder record.EventRecorder,
 	logLevelSetter logLevelSetter,
+	batchingSetter eventBatchingSetter,
+	defaultBatching events.BatchingConfig,
 	configName types.NamespacedName,
 ) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -661,16 +1097,106 @@ func setInitialConfig(
 
 	// status is not updated until the status updater's cache is started and the
 	// resource is processed by the controller
-	return updateControlPlane(&config, logger, eventRecorder, configName, logLevelSetter)
+	return updateControlPlane(
+		&config,
+		logger,
+		eventRecorder,
+		configName,
+		logLevelSetter,
+		batchingSetter,
+		defaultBatching,
+	)
 }
 
-func getMetricsOptions(cfg config.MetricsConfig) metricsserver.Options {
+func isWatchNamespacesSet(cfg config.WatchNamespacesConfig) bool {
+	return cfg.Selector != nil || len(cfg.Names) > 0
+}
+
+// getWatchedNamespaceNames returns the names of the Namespaces in which the control plane watches resources.
+// If the Namespaces are selected by labels, the selector is evaluated once, so that Namespaces that start or stop
+// matching the selector later are only watched, or no longer watched, after the control plane restarts.
+// The Namespace of the control plane is always watched.
+func getWatchedNamespaceNames(reader client.Reader, cfg config.Config) ([]string, error) {
+	names := slices.Clone(cfg.WatchNamespaces.Names)
+
+	if cfg.WatchNamespaces.Selector != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+		defer cancel()
+
+		var nsList apiv1.NamespaceList
+		if err := reader.List(
+			ctx,
+			&nsList,
+			client.MatchingLabelsSelector{Selector: cfg.WatchNamespaces.Selector},
+		); err != nil {
+			return nil, fmt.Errorf("error listing Namespaces: %w", err)
+		}
+
+		names = make([]string, 0, len(nsList.Items))
+		for _, ns := range nsList.Items {
+			names = append(names, ns.Name)
+		}
+	}
+
+	if !slices.Contains(names, cfg.GatewayPodConfig.Namespace) {
+		names = append(names, cfg.GatewayPodConfig.Namespace)
+	}
+
+	return names, nil
+}
+
+// createNamespacedCacheByObject restricts the cache of routes, Services, EndpointSlices, Secrets, ConfigMaps
+// and ReferenceGrants to the Namespaces.
+func createNamespacedCacheByObject(cfg config.Config, namespaces []string) map[client.Object]cache.ByObject {
+	createNamespaces := func(names ...string) map[string]cache.Config {
+		nsConfigs := make(map[string]cache.Config, len(names))
+		for _, name := range names {
+			nsConfigs[name] = cache.Config{}
+		}
+		return nsConfigs
+	}
+
+	secretNamespaces := namespaces
+	// The usage reporting Secret is needed even if its Namespace is not watched.
+	if cfg.UsageReportConfig != nil {
+		secretNamespaces = append(slices.Clone(namespaces), cfg.UsageReportConfig.SecretNsName.Namespace)
+	}
+
+	byObject := map[client.Object]cache.ByObject{
+		&gatewayv1.HTTPRoute{}:           {Namespaces: createNamespaces(namespaces...)},
+		&apiv1.Service{}:                 {Namespaces: createNamespaces(namespaces...)},
+		&discoveryV1.EndpointSlice{}:     {Namespaces: createNamespaces(namespaces...)},
+		&apiv1.ConfigMap{}:               {Namespaces: createNamespaces(namespaces...)},
+		&gatewayv1beta1.ReferenceGrant{}: {Namespaces: createNamespaces(namespaces...)},
+		&apiv1.Secret{}:                  {Namespaces: createNamespaces(secretNamespaces...)},
+	}
+
+	// The cache can only be configured for the types the API server knows about.
+	if cfg.ExperimentalFeatures {
+		byObject[&gatewayv1alpha2.GRPCRoute{}] = cache.ByObject{Namespaces: createNamespaces(namespaces...)}
+	}
+
+	return byObject
+}
+
+// getDefaultEventBatching returns the event batching configuration from the command-line flags.
+func getDefaultEventBatching(cfg config.EventBatchingConfig) events.BatchingConfig {
+	return events.BatchingConfig{
+		Window:  cfg.Window,
+		MaxSize: cfg.MaxSize,
+	}
+}
+
+func getMetricsOptions(cfg config.MetricsConfig, debugHandler *debug.Handler) metricsserver.Options {
 	metricsOptions := metricsserver.Options{BindAddress: "0"}
 
 	if cfg.Enabled {
 		if cfg.Secure {
 			metricsOptions.SecureServing = true
 		}
+		if cfg.DebugEndpoint {
+			metricsOptions.ExtraHandlers = debugHandler.Handlers()
+		}
 		metricsOptions.BindAddress = fmt.Sprintf(":%v", cfg.Port)
 	}
 
@@ -1,16 +1,23 @@
 package static
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"testing"
 
+	"github.com/go-logr/logr"
+	tel "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
 	. "github.com/onsi/gomega"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -18,6 +25,8 @@ import (
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
 )
 
 func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
@@ -38,6 +47,8 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 		expectedObjects     []client.Object
 		expectedObjectLists []client.ObjectList
 		experimentalEnabled bool
+		snippetsFilters     bool
+		errorPagePolicies   bool
 	}{
 		{
 			name:     "gwNsName is nil",
@@ -54,6 +65,8 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1.GatewayList{},
 				&gatewayv1beta1.ReferenceGrantList{},
 				&ngfAPI.NginxProxyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.HostnameBackendList{},
 				partialObjectMetadataList,
 			},
 		},
@@ -75,6 +88,8 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
 				&ngfAPI.NginxProxyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.HostnameBackendList{},
 				partialObjectMetadataList,
 			},
 		},
@@ -92,24 +107,83 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
-				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
 				&ngfAPI.NginxProxyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.HostnameBackendList{},
 				partialObjectMetadataList,
+				&apiv1.ConfigMapList{},
 				&gatewayv1alpha2.BackendTLSPolicyList{},
 				&gatewayv1alpha2.GRPCRouteList{},
 			},
 			experimentalEnabled: true,
 		},
+		{
+			name: "gwNsName is not nil and snippets filters enabled",
+			gwNsName: &types.NamespacedName{
+				Namespace: "test",
+				Name:      "my-gateway",
+			},
+			expectedObjects: []client.Object{
+				&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+				&gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "my-gateway", Namespace: "test"}},
+			},
+			expectedObjectLists: []client.ObjectList{
+				&apiv1.ServiceList{},
+				&apiv1.SecretList{},
+				&apiv1.NamespaceList{},
+				&discoveryV1.EndpointSliceList{},
+				&gatewayv1.HTTPRouteList{},
+				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.NginxProxyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.HostnameBackendList{},
+				partialObjectMetadataList,
+				&ngfAPI.SnippetsFilterList{},
+			},
+			snippetsFilters: true,
+		},
+		{
+			name: "gwNsName is not nil and error page policies enabled",
+			gwNsName: &types.NamespacedName{
+				Namespace: "test",
+				Name:      "my-gateway",
+			},
+			expectedObjects: []client.Object{
+				&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+				&gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "my-gateway", Namespace: "test"}},
+			},
+			expectedObjectLists: []client.ObjectList{
+				&apiv1.ServiceList{},
+				&apiv1.SecretList{},
+				&apiv1.NamespaceList{},
+				&discoveryV1.EndpointSliceList{},
+				&gatewayv1.HTTPRouteList{},
+				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.NginxProxyList{},
+				&ngfAPI.CompressionPolicyList{},
+				&ngfAPI.HostnameBackendList{},
+				partialObjectMetadataList,
+				&apiv1.ConfigMapList{},
+				&ngfAPI.ErrorPagePolicyList{},
+			},
+			errorPagePolicies: true,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			objects, objectLists := prepareFirstEventBatchPreparerArgs(gcName, test.gwNsName, test.experimentalEnabled)
+			objects, objectLists := prepareFirstEventBatchPreparerArgs(
+				gcName,
+				test.gwNsName,
+				test.experimentalEnabled,
+				test.snippetsFilters,
+				test.errorPagePolicies,
+			)
 
 			g.Expect(objects).To(ConsistOf(test.expectedObjects))
 			g.Expect(objectLists).To(ConsistOf(test.expectedObjectLists))
@@ -158,9 +232,306 @@ func TestGetMetricsOptions(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			metricsServerOptions := getMetricsOptions(test.metricsConfig)
+			metricsServerOptions := getMetricsOptions(test.metricsConfig, debug.NewHandler(logr.Discard()))
 
 			g.Expect(metricsServerOptions).To(Equal(test.expectedOptions))
 		})
 	}
 }
+
+func TestGetMetricsOptionsDebugEndpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	metricsConfig := config.MetricsConfig{
+		Port:          9113,
+		Enabled:       true,
+		DebugEndpoint: true,
+	}
+
+	metricsServerOptions := getMetricsOptions(metricsConfig, debug.NewHandler(logr.Discard()))
+
+	g.Expect(metricsServerOptions.BindAddress).To(Equal(":9113"))
+	g.Expect(metricsServerOptions.ExtraHandlers).To(HaveLen(3))
+	g.Expect(metricsServerOptions.ExtraHandlers).To(HaveKey(debug.GraphPath))
+	g.Expect(metricsServerOptions.ExtraHandlers).To(HaveKey(debug.ConfigurationPath))
+	g.Expect(metricsServerOptions.ExtraHandlers).To(HaveKey(debug.NginxPath))
+
+	metricsConfig.Enabled = false
+	metricsServerOptions = getMetricsOptions(metricsConfig, debug.NewHandler(logr.Discard()))
+
+	g.Expect(metricsServerOptions.ExtraHandlers).To(BeEmpty())
+}
+
+func TestGetNodeZone(t *testing.T) {
+	node := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node1",
+			Labels: map[string]string{apiv1.LabelTopologyZone: "zone-a"},
+		},
+	}
+	nodeNoZone := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node2",
+		},
+	}
+
+	k8sClient := fake.NewFakeClient(node, nodeNoZone)
+
+	tests := []struct {
+		name      string
+		nodeName  string
+		expZone   string
+		expectErr bool
+	}{
+		{
+			name:     "node with zone",
+			nodeName: "node1",
+			expZone:  "zone-a",
+		},
+		{
+			name:     "node without zone",
+			nodeName: "node2",
+			expZone:  "",
+		},
+		{
+			name:      "node does not exist",
+			nodeName:  "node3",
+			expectErr: true,
+		},
+		{
+			name:      "node name unknown",
+			nodeName:  "",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			zone, err := getNodeZone(context.Background(), k8sClient, test.nodeName)
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(zone).To(Equal(test.expZone))
+		})
+	}
+}
+
+func TestGetWatchedNamespaceNames(t *testing.T) {
+	teamA := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"team": "a"},
+		},
+	}
+	teamB := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-b",
+			Labels: map[string]string{"team": "b"},
+		},
+	}
+
+	k8sClient := fake.NewFakeClient(teamA, teamB)
+
+	tests := []struct {
+		name     string
+		cfg      config.WatchNamespacesConfig
+		expNames []string
+	}{
+		{
+			name: "names",
+			cfg: config.WatchNamespacesConfig{
+				Names: []string{"team-b", "unknown"},
+			},
+			expNames: []string{"team-b", "unknown", "nginx-gateway"},
+		},
+		{
+			name: "names include the namespace of the control plane",
+			cfg: config.WatchNamespacesConfig{
+				Names: []string{"nginx-gateway", "team-a"},
+			},
+			expNames: []string{"nginx-gateway", "team-a"},
+		},
+		{
+			name: "selector",
+			cfg: config.WatchNamespacesConfig{
+				Selector: labels.SelectorFromSet(map[string]string{"team": "a"}),
+			},
+			expNames: []string{"team-a", "nginx-gateway"},
+		},
+		{
+			name: "selector matches nothing",
+			cfg: config.WatchNamespacesConfig{
+				Selector: labels.SelectorFromSet(map[string]string{"team": "c"}),
+			},
+			expNames: []string{"nginx-gateway"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cfg := config.Config{
+				WatchNamespaces: test.cfg,
+				GatewayPodConfig: config.GatewayPodConfig{
+					Namespace: "nginx-gateway",
+				},
+			}
+
+			names, err := getWatchedNamespaceNames(k8sClient, cfg)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(names).To(Equal(test.expNames))
+		})
+	}
+}
+
+func TestCreateNamespacedCacheByObject(t *testing.T) {
+	tests := []struct {
+		expNamespaces map[string][]string
+		name          string
+		cfg           config.Config
+	}{
+		{
+			name: "default",
+			cfg:  config.Config{},
+			expNamespaces: map[string][]string{
+				"*v1.HTTPRoute":           {"ns1", "ns2"},
+				"*v1.Service":             {"ns1", "ns2"},
+				"*v1.EndpointSlice":       {"ns1", "ns2"},
+				"*v1.ConfigMap":           {"ns1", "ns2"},
+				"*v1beta1.ReferenceGrant": {"ns1", "ns2"},
+				"*v1.Secret":              {"ns1", "ns2"},
+			},
+		},
+		{
+			name: "experimental features and usage reporting",
+			cfg: config.Config{
+				ExperimentalFeatures: true,
+				UsageReportConfig: &config.UsageReportConfig{
+					SecretNsName: types.NamespacedName{Namespace: "usage", Name: "secret"},
+				},
+			},
+			expNamespaces: map[string][]string{
+				"*v1.HTTPRoute":           {"ns1", "ns2"},
+				"*v1alpha2.GRPCRoute":     {"ns1", "ns2"},
+				"*v1.Service":             {"ns1", "ns2"},
+				"*v1.EndpointSlice":       {"ns1", "ns2"},
+				"*v1.ConfigMap":           {"ns1", "ns2"},
+				"*v1beta1.ReferenceGrant": {"ns1", "ns2"},
+				"*v1.Secret":              {"ns1", "ns2", "usage"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			byObject := createNamespacedCacheByObject(test.cfg, []string{"ns1", "ns2"})
+
+			namespaces := make(map[string][]string, len(byObject))
+			for obj, objCfg := range byObject {
+				names := make([]string, 0, len(objCfg.Namespaces))
+				for name := range objCfg.Namespaces {
+					names = append(names, name)
+				}
+				slices.Sort(names)
+
+				namespaces[fmt.Sprintf("%T", obj)] = names
+			}
+
+			g.Expect(namespaces).To(Equal(test.expNamespaces))
+		})
+	}
+}
+
+func TestCreateTelemetryExporter(t *testing.T) {
+	tests := []struct {
+		expExporter interface{}
+		name        string
+		cfg         config.ProductTelemetryConfig
+		expErr      bool
+	}{
+		{
+			name: "OTLP gRPC exporter without endpoint logs the data",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterOTLPGRPC,
+			},
+			expExporter: &telemetry.LoggingExporter{},
+		},
+		{
+			name: "OTLP gRPC exporter",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterOTLPGRPC,
+				Endpoint: "telemetry.example.com:443",
+			},
+			expExporter: &tel.Exporter{},
+		},
+		{
+			name: "OTLP HTTP exporter",
+			cfg: config.ProductTelemetryConfig{
+				Exporter:         config.ProductTelemetryExporterOTLPHTTP,
+				Endpoint:         "telemetry.example.com:4318",
+				EndpointInsecure: true,
+			},
+			expExporter: &tel.Exporter{},
+		},
+		{
+			name: "OTLP HTTP exporter without endpoint",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterOTLPHTTP,
+			},
+			expErr: true,
+		},
+		{
+			name: "file exporter",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterFile,
+				FilePath: "/var/log/nginx-gateway/telemetry.json",
+			},
+			expExporter: &telemetry.FileExporter{},
+		},
+		{
+			name: "file exporter without file path",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterFile,
+			},
+			expErr: true,
+		},
+		{
+			name: "logging exporter",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: config.ProductTelemetryExporterLogging,
+				Endpoint: "telemetry.example.com:443",
+			},
+			expExporter: &telemetry.LoggingExporter{},
+		},
+		{
+			name: "unknown exporter",
+			cfg: config.ProductTelemetryConfig{
+				Exporter: "unknown",
+			},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			exporter, err := createTelemetryExporter(test.cfg, logr.Discard())
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(exporter).To(BeNil())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(exporter).To(BeAssignableToTypeOf(test.expExporter))
+		})
+	}
+}
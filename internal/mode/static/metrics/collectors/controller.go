@@ -6,6 +6,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 // ControllerCollector collects metrics for the NGF controller.
@@ -13,6 +14,17 @@ import (
 type ControllerCollector struct {
 	// Metrics
 	eventBatchProcessDuration prometheus.Histogram
+	eventBatchSize            prometheus.Histogram
+	eventBatchWaitDuration    prometheus.Histogram
+	skippedReloads            prometheus.Counter
+	graphBuildDuration        prometheus.Histogram
+	configGenerationDuration  prometheus.Histogram
+	gateways                  *prometheus.GaugeVec
+	listeners                 *prometheus.GaugeVec
+	routes                    *prometheus.GaugeVec
+	invalidBackendRefs        prometheus.Gauge
+	policyConflicts           *prometheus.GaugeVec
+	referencedSecrets         prometheus.Gauge
 }
 
 // NewControllerCollector creates a new ControllerCollector
@@ -27,6 +39,102 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		eventBatchSize: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "event_batch_size",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Kubernetes events in an event batch",
+				ConstLabels: constLabels,
+				Buckets:     []float64{1, 5, 10, 50, 100, 500, 1000},
+			},
+		),
+		eventBatchWaitDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "event_batch_wait_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds between the first event of an event batch and the start of its processing",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 100, 500, 1000, 5000, 10000},
+			},
+		),
+		skippedReloads: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "nginx_skipped_reloads_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of NGINX reloads skipped because the NGINX configuration didn't change",
+				ConstLabels: constLabels,
+			},
+		),
+		graphBuildDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "graph_build_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of building the graph of the Kubernetes resources",
+				ConstLabels: constLabels,
+				Buckets:     []float64{1, 5, 10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		configGenerationDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "nginx_config_generation_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of generating the NGINX configuration from the graph",
+				ConstLabels: constLabels,
+				Buckets:     []float64{1, 5, 10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		gateways: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "gateways",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Gateways of the GatewayClass by status (accepted, invalid or ignored)",
+				ConstLabels: constLabels,
+			},
+			[]string{"status"},
+		),
+		listeners: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "listeners",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Listeners of the Gateway by status (valid or invalid)",
+				ConstLabels: constLabels,
+			},
+			[]string{"status"},
+		),
+		routes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "routes",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Routes by type, status (accepted or rejected) and reason of the Accepted condition",
+				ConstLabels: constLabels,
+			},
+			[]string{"type", "status", "reason"},
+		),
+		invalidBackendRefs: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "invalid_backend_refs",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of invalid backendRefs of the Routes",
+				ConstLabels: constLabels,
+			},
+		),
+		policyConflicts: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "policy_conflicts",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of policies that are not applied because of a conflict with another policy, by kind",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
+		referencedSecrets: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "referenced_secrets",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Secrets referenced by the Gateway, including the missing ones",
+				ConstLabels: constLabels,
+			},
+		),
 	}
 	return nc
 }
@@ -36,14 +144,91 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// ObserveEventBatchSize adds the number of events in an event batch to the histogram.
+func (c *ControllerCollector) ObserveEventBatchSize(size int) {
+	c.eventBatchSize.Observe(float64(size))
+}
+
+// ObserveEventBatchWaitTime adds the time an event batch waited before its processing to the histogram.
+func (c *ControllerCollector) ObserveEventBatchWaitTime(duration time.Duration) {
+	c.eventBatchWaitDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// IncSkippedReloadCount increments the counter of NGINX reloads skipped because the configuration didn't change.
+func (c *ControllerCollector) IncSkippedReloadCount() {
+	c.skippedReloads.Inc()
+}
+
+// ObserveGraphBuildTime adds the time of building the graph to the histogram.
+func (c *ControllerCollector) ObserveGraphBuildTime(duration time.Duration) {
+	c.graphBuildDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveConfigGenerationTime adds the time of generating the NGINX configuration to the histogram.
+func (c *ControllerCollector) ObserveConfigGenerationTime(duration time.Duration) {
+	c.configGenerationDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveGraph sets the gauges of the state of the Gateway API resources from the graph.
+func (c *ControllerCollector) ObserveGraph(g *graph.Graph) {
+	if g == nil {
+		return
+	}
+
+	stats := computeGraphStats(g)
+
+	for status, count := range stats.gateways {
+		c.gateways.WithLabelValues(status).Set(float64(count))
+	}
+
+	for status, count := range stats.listeners {
+		c.listeners.WithLabelValues(status).Set(float64(count))
+	}
+
+	// The reasons of the Routes change, so the series of the previous graph are removed.
+	c.routes.Reset()
+	for key, count := range stats.routes {
+		c.routes.WithLabelValues(key.routeType, key.status, key.reason).Set(float64(count))
+	}
+
+	for kind, count := range stats.policyConflicts {
+		c.policyConflicts.WithLabelValues(kind).Set(float64(count))
+	}
+
+	c.invalidBackendRefs.Set(float64(stats.invalidBackendRefs))
+	c.referencedSecrets.Set(float64(stats.referencedSecrets))
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.eventBatchSize.Describe(ch)
+	c.eventBatchWaitDuration.Describe(ch)
+	c.skippedReloads.Describe(ch)
+	c.graphBuildDuration.Describe(ch)
+	c.configGenerationDuration.Describe(ch)
+	c.gateways.Describe(ch)
+	c.listeners.Describe(ch)
+	c.routes.Describe(ch)
+	c.invalidBackendRefs.Describe(ch)
+	c.policyConflicts.Describe(ch)
+	c.referencedSecrets.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.eventBatchSize.Collect(ch)
+	c.eventBatchWaitDuration.Collect(ch)
+	c.skippedReloads.Collect(ch)
+	c.graphBuildDuration.Collect(ch)
+	c.configGenerationDuration.Collect(ch)
+	c.gateways.Collect(ch)
+	c.listeners.Collect(ch)
+	c.routes.Collect(ch)
+	c.invalidBackendRefs.Collect(ch)
+	c.policyConflicts.Collect(ch)
+	c.referencedSecrets.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +241,15 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveEventBatchSize(_ int) {}
+
+func (c *ControllerNoopCollector) ObserveEventBatchWaitTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) IncSkippedReloadCount() {}
+
+func (c *ControllerNoopCollector) ObserveGraphBuildTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveConfigGenerationTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveGraph(_ *graph.Graph) {}
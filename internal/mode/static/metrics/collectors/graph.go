@@ -0,0 +1,151 @@
+package collectors
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	statusAccepted = "accepted"
+	statusRejected = "rejected"
+	statusInvalid  = "invalid"
+	statusIgnored  = "ignored"
+	statusValid    = "valid"
+
+	policyKindCompression = "CompressionPolicy"
+	policyKindErrorPage   = "ErrorPagePolicy"
+)
+
+// routeStatusKey identifies the Routes of the same type with the same acceptance status and reason.
+type routeStatusKey struct {
+	routeType string
+	status    string
+	reason    string
+}
+
+// graphStats are the statistics of a Graph that are exposed as metrics.
+type graphStats struct {
+	// gateways are the counts of Gateways by status.
+	gateways map[string]int
+	// listeners are the counts of the Listeners of the Gateway by status.
+	listeners map[string]int
+	// routes are the counts of Routes by type, status and reason.
+	routes map[routeStatusKey]int
+	// policyConflicts are the counts of the policies that conflict with other policies, by policy kind.
+	policyConflicts map[string]int
+	// invalidBackendRefs is the count of the invalid backendRefs of the Routes.
+	invalidBackendRefs int
+	// referencedSecrets is the count of the Secrets referenced by the Gateway, including the missing ones.
+	referencedSecrets int
+}
+
+func computeGraphStats(g *graph.Graph) graphStats {
+	stats := graphStats{
+		gateways: map[string]int{
+			statusAccepted: 0,
+			statusInvalid:  0,
+			statusIgnored:  len(g.IgnoredGateways),
+		},
+		listeners: map[string]int{
+			statusValid:   0,
+			statusInvalid: 0,
+		},
+		routes: make(map[routeStatusKey]int),
+		policyConflicts: map[string]int{
+			policyKindCompression: 0,
+			policyKindErrorPage:   0,
+		},
+		referencedSecrets: len(g.ReferencedSecrets),
+	}
+
+	if g.Gateway != nil {
+		if g.Gateway.Valid {
+			stats.gateways[statusAccepted]++
+		} else {
+			stats.gateways[statusInvalid]++
+		}
+
+		for _, l := range g.Gateway.Listeners {
+			if l.Valid {
+				stats.listeners[statusValid]++
+			} else {
+				stats.listeners[statusInvalid]++
+			}
+		}
+	}
+
+	for _, route := range g.Routes {
+		accepted, reason := getRouteAcceptance(route)
+
+		status := statusRejected
+		if accepted {
+			status = statusAccepted
+		}
+
+		stats.routes[routeStatusKey{routeType: string(route.RouteType), status: status, reason: reason}]++
+
+		for _, rule := range route.Spec.Rules {
+			for _, ref := range rule.BackendRefs {
+				if !ref.Valid {
+					stats.invalidBackendRefs++
+				}
+			}
+		}
+	}
+
+	for _, policy := range g.CompressionPolicies {
+		if isConflicted(policy.Conditions) {
+			stats.policyConflicts[policyKindCompression]++
+		}
+	}
+
+	for _, policy := range g.ErrorPagePolicies {
+		if isConflicted(policy.Conditions) {
+			stats.policyConflicts[policyKindErrorPage]++
+		}
+	}
+
+	return stats
+}
+
+// getRouteAcceptance returns whether the Route is accepted by at least one of its parents, and the reason of
+// the Accepted condition. Like in the status of the Route, the conditions of the Route take precedence over the
+// conditions of the attachment to the parents.
+func getRouteAcceptance(route *graph.L7Route) (bool, string) {
+	for _, cond := range conditions.DeduplicateConditions(route.Conditions) {
+		if cond.Type == string(v1.RouteConditionAccepted) && cond.Status != metav1.ConditionTrue {
+			return false, cond.Reason
+		}
+	}
+
+	reason := string(v1.RouteReasonNoMatchingParent)
+	for _, ref := range route.ParentRefs {
+		if ref.Attachment == nil {
+			continue
+		}
+
+		if ref.Attachment.Attached {
+			return true, string(v1.RouteReasonAccepted)
+		}
+
+		if reason == string(v1.RouteReasonNoMatchingParent) {
+			reason = ref.Attachment.FailedCondition.Reason
+		}
+	}
+
+	return false, reason
+}
+
+func isConflicted(conds []conditions.Condition) bool {
+	for _, cond := range conds {
+		if cond.Reason == string(v1alpha2.PolicyReasonConflicted) {
+			return true
+		}
+	}
+
+	return false
+}
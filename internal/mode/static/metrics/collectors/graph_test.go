@@ -0,0 +1,159 @@
+package collectors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestComputeGraphStats(t *testing.T) {
+	attached := []graph.ParentRef{
+		{Attachment: &graph.ParentRefAttachmentStatus{Attached: true}},
+	}
+
+	notAttached := []graph.ParentRef{
+		{
+			Attachment: &graph.ParentRefAttachmentStatus{
+				FailedCondition: staticConds.NewRouteNotAllowedByListeners(),
+			},
+		},
+	}
+
+	testGraph := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Valid: true,
+			Listeners: []*graph.Listener{
+				{Name: "http", Valid: true},
+				{Name: "https", Valid: true},
+				{Name: "invalid", Valid: false},
+			},
+		},
+		IgnoredGateways: map[types.NamespacedName]*v1.Gateway{
+			{Namespace: "test", Name: "ignored"}: {},
+		},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {
+				RouteType:  graph.RouteTypeHTTP,
+				ParentRefs: attached,
+				Spec: graph.L7RouteSpec{
+					Rules: []graph.RouteRule{
+						{
+							BackendRefs: []graph.BackendRef{{Valid: true}, {Valid: false}},
+						},
+					},
+				},
+				// invalid backendRefs don't make the Route rejected
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteBackendRefRefBackendNotFound("not found"),
+				},
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-2"}}: {
+				RouteType:  graph.RouteTypeHTTP,
+				ParentRefs: attached,
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-3"}}: {
+				RouteType:  graph.RouteTypeHTTP,
+				ParentRefs: notAttached,
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-4"}}: {
+				RouteType:  graph.RouteTypeHTTP,
+				ParentRefs: []graph.ParentRef{{}},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue("unsupported"),
+				},
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-1"}}: {
+				RouteType: graph.RouteTypeGRPC,
+				ParentRefs: []graph.ParentRef{
+					notAttached[0],
+					attached[0],
+				},
+				Spec: graph.L7RouteSpec{
+					Rules: []graph.RouteRule{
+						{
+							BackendRefs: []graph.BackendRef{{Valid: false}},
+						},
+					},
+				},
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-2"}}: {
+				RouteType: graph.RouteTypeGRPC,
+			},
+		},
+		CompressionPolicies: map[types.NamespacedName]*graph.CompressionPolicy{
+			{Namespace: "test", Name: "cp-1"}: {Valid: true},
+			{Namespace: "test", Name: "cp-2"}: {
+				Conditions: []conditions.Condition{staticConds.NewCompressionPolicyConflicted("conflict")},
+			},
+		},
+		ErrorPagePolicies: map[types.NamespacedName]*graph.ErrorPagePolicy{
+			{Namespace: "test", Name: "epp-1"}: {
+				Conditions: []conditions.Condition{staticConds.NewErrorPagePolicyConflicted("conflict")},
+			},
+			{Namespace: "test", Name: "epp-2"}: {
+				Conditions: []conditions.Condition{staticConds.NewErrorPagePolicyConflicted("conflict")},
+			},
+		},
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+			{Namespace: "test", Name: "secret-1"}: {},
+			{Namespace: "test", Name: "secret-2"}: {},
+		},
+	}
+
+	expStats := graphStats{
+		gateways: map[string]int{
+			statusAccepted: 1,
+			statusInvalid:  0,
+			statusIgnored:  1,
+		},
+		listeners: map[string]int{
+			statusValid:   2,
+			statusInvalid: 1,
+		},
+		routes: map[routeStatusKey]int{
+			{routeType: "http", status: statusAccepted, reason: string(v1.RouteReasonAccepted)}:              2,
+			{routeType: "http", status: statusRejected, reason: string(v1.RouteReasonNotAllowedByListeners)}: 1,
+			{routeType: "http", status: statusRejected, reason: string(v1.RouteReasonUnsupportedValue)}:      1,
+			{routeType: "grpc", status: statusAccepted, reason: string(v1.RouteReasonAccepted)}:              1,
+			{routeType: "grpc", status: statusRejected, reason: string(v1.RouteReasonNoMatchingParent)}:      1,
+		},
+		policyConflicts: map[string]int{
+			policyKindCompression: 1,
+			policyKindErrorPage:   2,
+		},
+		invalidBackendRefs: 2,
+		referencedSecrets:  2,
+	}
+
+	g := NewWithT(t)
+	g.Expect(computeGraphStats(testGraph)).To(Equal(expStats))
+}
+
+func TestComputeGraphStats_Empty(t *testing.T) {
+	g := NewWithT(t)
+
+	expStats := graphStats{
+		gateways: map[string]int{
+			statusAccepted: 0,
+			statusInvalid:  0,
+			statusIgnored:  0,
+		},
+		listeners: map[string]int{
+			statusValid:   0,
+			statusInvalid: 0,
+		},
+		routes: map[routeStatusKey]int{},
+		policyConflicts: map[string]int{
+			policyKindCompression: 0,
+			policyKindErrorPage:   0,
+		},
+	}
+
+	g.Expect(computeGraphStats(&graph.Graph{})).To(Equal(expStats))
+}
@@ -15,6 +15,9 @@ type NginxRuntimeCollector struct {
 	reloadsError    prometheus.Counter
 	configStale     prometheus.Gauge
 	reloadsDuration prometheus.Histogram
+
+	liveEndpointUpdatesTotal prometheus.Counter
+	liveEndpointUpdatesError prometheus.Counter
 }
 
 // NewManagerMetricsCollector creates a new NginxRuntimeCollector
@@ -52,6 +55,22 @@ func NewManagerMetricsCollector(constLabels map[string]string) *NginxRuntimeColl
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		liveEndpointUpdatesTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "nginx_live_endpoint_updates_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of successful updates of NGINX upstream servers without a reload",
+				ConstLabels: constLabels,
+			},
+		),
+		liveEndpointUpdatesError: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "nginx_live_endpoint_update_errors_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of unsuccessful updates of NGINX upstream servers without a reload",
+				ConstLabels: constLabels,
+			},
+		),
 	}
 	return nc
 }
@@ -68,6 +87,16 @@ func (c *NginxRuntimeCollector) IncReloadErrors() {
 	c.updateConfigStaleStatus(true)
 }
 
+// IncLiveEndpointUpdateCount increments the counter of successful updates of upstream servers without a reload.
+func (c *NginxRuntimeCollector) IncLiveEndpointUpdateCount() {
+	c.liveEndpointUpdatesTotal.Inc()
+}
+
+// IncLiveEndpointUpdateErrors increments the counter of unsuccessful updates of upstream servers without a reload.
+func (c *NginxRuntimeCollector) IncLiveEndpointUpdateErrors() {
+	c.liveEndpointUpdatesError.Inc()
+}
+
 // updateConfigStaleStatus updates the last NGINX reload status metric.
 func (c *NginxRuntimeCollector) updateConfigStaleStatus(stale bool) {
 	var status float64
@@ -88,6 +117,8 @@ func (c *NginxRuntimeCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.reloadsError.Describe(ch)
 	c.configStale.Describe(ch)
 	c.reloadsDuration.Describe(ch)
+	c.liveEndpointUpdatesTotal.Describe(ch)
+	c.liveEndpointUpdatesError.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
@@ -96,6 +127,8 @@ func (c *NginxRuntimeCollector) Collect(ch chan<- prometheus.Metric) {
 	c.reloadsError.Collect(ch)
 	c.configStale.Collect(ch)
 	c.reloadsDuration.Collect(ch)
+	c.liveEndpointUpdatesTotal.Collect(ch)
+	c.liveEndpointUpdatesError.Collect(ch)
 }
 
 // ManagerNoopCollector used to initialize the ManagerCollector when metrics are disabled to avoid nil pointer errors.
@@ -114,3 +147,9 @@ func (c *ManagerNoopCollector) IncReloadErrors() {}
 
 // ObserveLastReloadTime implements a no-op ObserveLastReloadTime.
 func (c *ManagerNoopCollector) ObserveLastReloadTime(_ time.Duration) {}
+
+// IncLiveEndpointUpdateCount implements a no-op IncLiveEndpointUpdateCount.
+func (c *ManagerNoopCollector) IncLiveEndpointUpdateCount() {}
+
+// IncLiveEndpointUpdateErrors implements a no-op IncLiveEndpointUpdateErrors.
+func (c *ManagerNoopCollector) IncLiveEndpointUpdateErrors() {}
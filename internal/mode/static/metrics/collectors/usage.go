@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+)
+
+// UsageReportCollector collects metrics for the NGINX Plus usage reporting.
+// Implements the prometheus.Collector interface.
+type UsageReportCollector struct {
+	// Metrics
+	reportsSent    prometheus.Counter
+	reportFailures prometheus.Counter
+	reportsPending prometheus.Gauge
+}
+
+// NewUsageReportCollector creates a new UsageReportCollector.
+func NewUsageReportCollector(constLabels map[string]string) *UsageReportCollector {
+	return &UsageReportCollector{
+		reportsSent: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "nginx_plus_usage_reports_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of NGINX Plus usage reports that were sent",
+				ConstLabels: constLabels,
+			},
+		),
+		reportFailures: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "nginx_plus_usage_report_errors_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of failed attempts to send an NGINX Plus usage report, including the retries",
+				ConstLabels: constLabels,
+			},
+		),
+		reportsPending: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "nginx_plus_usage_reports_pending",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of NGINX Plus usage reports that haven't been sent yet",
+				ConstLabels: constLabels,
+			},
+		),
+	}
+}
+
+// IncReportSuccessCount increments the counter of usage reports that were sent.
+func (c *UsageReportCollector) IncReportSuccessCount() {
+	c.reportsSent.Inc()
+}
+
+// IncReportFailureCount increments the counter of failed attempts to send a usage report.
+func (c *UsageReportCollector) IncReportFailureCount() {
+	c.reportFailures.Inc()
+}
+
+// SetPendingReportCount sets the number of usage reports that haven't been sent yet.
+func (c *UsageReportCollector) SetPendingReportCount(count int) {
+	c.reportsPending.Set(float64(count))
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *UsageReportCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.reportsSent.Describe(ch)
+	c.reportFailures.Describe(ch)
+	c.reportsPending.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *UsageReportCollector) Collect(ch chan<- prometheus.Metric) {
+	c.reportsSent.Collect(ch)
+	c.reportFailures.Collect(ch)
+	c.reportsPending.Collect(ch)
+}
+
+// UsageReportNoopCollector used to initialize the UsageReportCollector when metrics are disabled to avoid nil pointer
+// errors.
+type UsageReportNoopCollector struct{}
+
+// NewUsageReportNoopCollector returns an instance of the UsageReportNoopCollector.
+func NewUsageReportNoopCollector() *UsageReportNoopCollector {
+	return &UsageReportNoopCollector{}
+}
+
+func (c *UsageReportNoopCollector) IncReportSuccessCount() {}
+
+func (c *UsageReportNoopCollector) IncReportFailureCount() {}
+
+func (c *UsageReportNoopCollector) SetPendingReportCount(_ int) {}
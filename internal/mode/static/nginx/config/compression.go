@@ -0,0 +1,47 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var compressionTemplate = gotemplate.Must(
+	gotemplate.Must(gotemplate.New("compression").Parse(compressionTemplateText)).Parse(compressionDirectivesTemplateText),
+)
+
+func executeCompression(conf dataplane.Configuration) []executeResult {
+	if conf.Compression == nil {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: execute(compressionTemplate, createCompression(conf.Compression)),
+	}
+
+	return []executeResult{result}
+}
+
+func createCompression(compression *dataplane.Compression) *http.Compression {
+	if compression == nil {
+		return nil
+	}
+
+	var brotli string
+	if compression.Brotli != nil {
+		brotli = "off"
+		if *compression.Brotli {
+			brotli = "on"
+		}
+	}
+
+	return &http.Compression{
+		Enabled:   compression.Enabled,
+		Brotli:    brotli,
+		Types:     compression.MIMETypes,
+		MinLength: compression.MinLength,
+		Level:     compression.Level,
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+// compressionDirectivesTemplateText defines the compression directives, so that the same directives are
+// generated for the default compression in the http context and for the CompressionPolicies in locations.
+const compressionDirectivesTemplateText = `
+{{- define "compressionDirectives" -}}
+gzip {{ if .Enabled }}on{{ else }}off{{ end }};
+{{- if .Enabled }}
+gzip_vary on;
+{{- end }}
+{{- if .Types }}
+gzip_types{{ range $t := .Types }} {{ $t }}{{ end }};
+{{- end }}
+{{- if .MinLength }}
+gzip_min_length {{ .MinLength }};
+{{- end }}
+{{- if .Level }}
+gzip_comp_level {{ .Level }};
+{{- end }}
+{{- if .Brotli }}
+brotli {{ .Brotli }};
+    {{- if eq .Brotli "on" }}
+        {{- if .Types }}
+brotli_types{{ range $t := .Types }} {{ $t }}{{ end }};
+        {{- end }}
+        {{- if .MinLength }}
+brotli_min_length {{ .MinLength }};
+        {{- end }}
+    {{- end }}
+{{- end }}
+{{- end -}}
+`
+
+const compressionTemplateText = `
+{{ template "compressionDirectives" . }}
+`
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteCompression(t *testing.T) {
+	conf := dataplane.Configuration{
+		Compression: &dataplane.Compression{
+			Enabled:   true,
+			Brotli:    helpers.GetPointer(true),
+			MIMETypes: []string{"application/json", "text/css"},
+			MinLength: helpers.GetPointer[int32](0),
+			Level:     helpers.GetPointer[int32](5),
+		},
+	}
+
+	g := NewWithT(t)
+	expSubStrings := map[string]int{
+		"gzip on;":                                1,
+		"gzip_vary on;":                           1,
+		"gzip_types application/json text/css;":   1,
+		"gzip_min_length 0;":                      1,
+		"gzip_comp_level 5;":                      1,
+		"brotli on;":                              1,
+		"brotli_types application/json text/css;": 1,
+		"brotli_min_length 0;":                    1,
+	}
+
+	res := executeCompression(conf)
+	g.Expect(res).To(HaveLen(1))
+	g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteCompressionDisabled(t *testing.T) {
+	conf := dataplane.Configuration{
+		Compression: &dataplane.Compression{},
+	}
+
+	g := NewWithT(t)
+
+	res := executeCompression(conf)
+	g.Expect(res).To(HaveLen(1))
+
+	data := string(res[0].data)
+	g.Expect(data).To(ContainSubstring("gzip off;"))
+	g.Expect(data).ToNot(ContainSubstring("gzip_vary"))
+	g.Expect(data).ToNot(ContainSubstring("gzip_types"))
+	g.Expect(data).ToNot(ContainSubstring("gzip_min_length"))
+	g.Expect(data).ToNot(ContainSubstring("gzip_comp_level"))
+	g.Expect(data).ToNot(ContainSubstring("brotli"))
+}
+
+func TestExecuteCompressionNil(t *testing.T) {
+	g := NewWithT(t)
+
+	res := executeCompression(dataplane.Configuration{})
+	g.Expect(res).To(BeEmpty())
+}
@@ -2,9 +2,13 @@ package config
 
 import (
 	"fmt"
+	"slices"
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
@@ -22,8 +26,47 @@ func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
 			Server: fmt.Sprintf("%s%s", ep.Address, port),
 		}
 
+		if ep.Backup {
+			server.Backup = helpers.GetPointer(true)
+		}
+
+		server.Drain = ep.Drain
+
 		servers = append(servers, server)
 	}
 
 	return servers
 }
+
+// ConvertDynamicUpstreams converts a list of Upstreams into the dynamic upstreams of NGINX open source.
+// Upstreams with endpoints that NGINX resolves by DNS are not dynamic, because only their upstream configuration
+// resolves them. Draining endpoints are backup servers, like in the upstream configuration.
+// It returns false if an upstream has no endpoints, because only its upstream configuration can respond with an
+// error, so that NGINX must be reloaded.
+func ConvertDynamicUpstreams(upstreams []dataplane.Upstream) (map[string]runtime.DynamicUpstream, bool) {
+	dynamicUpstreams := make(map[string]runtime.DynamicUpstream, len(upstreams))
+
+	for _, u := range upstreams {
+		if len(u.Endpoints) == 0 {
+			return nil, false
+		}
+
+		if slices.ContainsFunc(u.Endpoints, func(ep resolver.Endpoint) bool { return ep.Resolve }) {
+			continue
+		}
+
+		var dynamicUpstream runtime.DynamicUpstream
+		for _, ep := range u.Endpoints {
+			server := fmt.Sprintf("%s:%d", ep.Address, ep.Port)
+			if ep.Backup || ep.Drain {
+				dynamicUpstream.Backup = append(dynamicUpstream.Backup, server)
+			} else {
+				dynamicUpstream.Servers = append(dynamicUpstream.Servers, server)
+			}
+		}
+
+		dynamicUpstreams[u.Name] = dynamicUpstream
+	}
+
+	return dynamicUpstreams, true
+}
@@ -6,6 +6,9 @@ import (
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/gomega"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
@@ -19,6 +22,16 @@ func TestConvertEndpoints(t *testing.T) {
 			Address: "5.6.7.8",
 			Port:    0,
 		},
+		{
+			Address: "9.10.11.12",
+			Port:    80,
+			Backup:  true,
+		},
+		{
+			Address: "13.14.15.16",
+			Port:    80,
+			Drain:   true,
+		},
 	}
 
 	expUpstreams := []ngxclient.UpstreamServer{
@@ -28,8 +41,92 @@ func TestConvertEndpoints(t *testing.T) {
 		{
 			Server: "5.6.7.8",
 		},
+		{
+			Server: "9.10.11.12:80",
+			Backup: helpers.GetPointer(true),
+		},
+		{
+			Server: "13.14.15.16:80",
+			Drain:  true,
+		},
 	}
 
 	g := NewWithT(t)
 	g.Expect(ConvertEndpoints(endpoints)).To(Equal(expUpstreams))
 }
+
+func TestConvertDynamicUpstreams(t *testing.T) {
+	tests := []struct {
+		expUpstreams map[string]runtime.DynamicUpstream
+		name         string
+		upstreams    []dataplane.Upstream
+		expOK        bool
+	}{
+		{
+			name: "servers, backup and draining servers",
+			upstreams: []dataplane.Upstream{
+				{
+					Name: "up1",
+					Endpoints: []resolver.Endpoint{
+						{Address: "10.0.0.1", Port: 80},
+						{Address: "10.0.0.2", Port: 80, Backup: true},
+						{Address: "10.0.0.3", Port: 80, Drain: true},
+					},
+				},
+				{
+					Name: "up2",
+					Endpoints: []resolver.Endpoint{
+						{Address: "10.0.0.4", Port: 8080},
+					},
+				},
+			},
+			expUpstreams: map[string]runtime.DynamicUpstream{
+				"up1": {
+					Servers: []string{"10.0.0.1:80"},
+					Backup:  []string{"10.0.0.2:80", "10.0.0.3:80"},
+				},
+				"up2": {
+					Servers: []string{"10.0.0.4:8080"},
+				},
+			},
+			expOK: true,
+		},
+		{
+			name: "upstreams resolved by DNS are skipped",
+			upstreams: []dataplane.Upstream{
+				{
+					Name: "up1",
+					Endpoints: []resolver.Endpoint{
+						{Address: "example.com", Port: 80, Resolve: true},
+					},
+				},
+			},
+			expUpstreams: map[string]runtime.DynamicUpstream{},
+			expOK:        true,
+		},
+		{
+			name: "upstream without endpoints",
+			upstreams: []dataplane.Upstream{
+				{
+					Name: "up1",
+					Endpoints: []resolver.Endpoint{
+						{Address: "10.0.0.1", Port: 80},
+					},
+				},
+				{
+					Name: "up2",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			upstreams, ok := ConvertDynamicUpstreams(test.upstreams)
+			g.Expect(ok).To(Equal(test.expOK))
+			g.Expect(upstreams).To(Equal(test.expUpstreams))
+		})
+	}
+}
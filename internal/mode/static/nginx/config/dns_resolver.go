@@ -0,0 +1,32 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var dnsResolverTemplate = gotemplate.Must(gotemplate.New("dnsResolver").Parse(dnsResolverTemplateText))
+
+func executeDNSResolver(conf dataplane.Configuration) []executeResult {
+	if conf.DNSResolver == nil {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: execute(dnsResolverTemplate, createDNSResolver(conf.DNSResolver)),
+	}
+
+	return []executeResult{result}
+}
+
+func createDNSResolver(dnsResolver *dataplane.DNSResolver) http.DNSResolver {
+	return http.DNSResolver{
+		Addresses:   dnsResolver.Addresses,
+		Valid:       dnsResolver.Valid,
+		Timeout:     dnsResolver.Timeout,
+		DisableIPv6: dnsResolver.DisableIPv6,
+	}
+}
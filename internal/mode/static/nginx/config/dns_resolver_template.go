@@ -0,0 +1,9 @@
+package config
+
+const dnsResolverTemplateText = `
+resolver{{ range $a := .Addresses }} {{ $a }}{{ end }}{{ if .Valid }} valid={{ .Valid }}{{ end }}
+{{- if .DisableIPv6 }} ipv6=off{{ end }};
+{{- if .Timeout }}
+resolver_timeout {{ .Timeout }};
+{{- end }}
+`
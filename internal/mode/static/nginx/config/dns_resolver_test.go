@@ -0,0 +1,71 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteDNSResolver(t *testing.T) {
+	tests := []struct {
+		dnsResolver *dataplane.DNSResolver
+		name        string
+		expSubStrs  []string
+		notExpStrs  []string
+	}{
+		{
+			name: "all fields set",
+			dnsResolver: &dataplane.DNSResolver{
+				Addresses:   []string{"10.96.0.10", "[fd00::10]:53"},
+				Valid:       "30s",
+				Timeout:     "5s",
+				DisableIPv6: true,
+			},
+			expSubStrs: []string{
+				"resolver 10.96.0.10 [fd00::10]:53 valid=30s ipv6=off;",
+				"resolver_timeout 5s;",
+			},
+		},
+		{
+			name: "only addresses",
+			dnsResolver: &dataplane.DNSResolver{
+				Addresses: []string{"kube-dns.kube-system.svc.cluster.local"},
+			},
+			expSubStrs: []string{
+				"resolver kube-dns.kube-system.svc.cluster.local;",
+			},
+			notExpStrs: []string{
+				"valid=",
+				"ipv6=off",
+				"resolver_timeout",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := executeDNSResolver(dataplane.Configuration{DNSResolver: test.dnsResolver})
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+			data := string(res[0].data)
+			for _, str := range test.expSubStrs {
+				g.Expect(data).To(ContainSubstring(str))
+			}
+			for _, str := range test.notExpStrs {
+				g.Expect(data).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
+func TestExecuteDNSResolverNil(t *testing.T) {
+	g := NewWithT(t)
+
+	res := executeDNSResolver(dataplane.Configuration{})
+	g.Expect(res).To(BeEmpty())
+}
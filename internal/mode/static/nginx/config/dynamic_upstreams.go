@@ -0,0 +1,38 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var dynamicUpstreamsTemplate = gotemplate.Must(
+	gotemplate.New("dynamicUpstreams").Parse(dynamicUpstreamsTemplateText),
+)
+
+const (
+	// dynamicUpstreamServerVariable is the variable that holds the server NJS chooses for the upstream
+	// of a location.
+	dynamicUpstreamServerVariable = "ngf_upstream_server"
+	// dynamicUpstreamsZoneSize is the size of the shared memory zone that holds the servers of the dynamic upstreams.
+	dynamicUpstreamsZoneSize = "1m"
+)
+
+// dynamicUpstreamsEnabled returns true if NGINX chooses the servers of the upstreams from shared memory.
+// NGINX Plus updates the servers of its upstreams through the NGINX Plus API instead.
+func (g GeneratorImpl) dynamicUpstreamsEnabled(conf dataplane.Configuration) bool {
+	return conf.DynamicUpstreams && !g.plus
+}
+
+func (g GeneratorImpl) executeDynamicUpstreams(conf dataplane.Configuration) []executeResult {
+	if !g.dynamicUpstreamsEnabled(conf) {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: execute(dynamicUpstreamsTemplate, dynamicUpstreamsZoneSize),
+	}
+
+	return []executeResult{result}
+}
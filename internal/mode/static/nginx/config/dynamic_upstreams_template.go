@@ -0,0 +1,17 @@
+package config
+
+const dynamicUpstreamsTemplateText = `
+js_shared_dict_zone zone=ngf_upstreams:{{ . }};
+js_set $ngf_upstream_server upstreams.server;
+
+server {
+    listen unix:/var/run/nginx/nginx-upstreams.sock;
+    access_log off;
+    client_max_body_size {{ . }};
+    client_body_buffer_size {{ . }};
+
+    location /upstreams {
+        js_content upstreams.api;
+    }
+}
+`
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteDynamicUpstreams(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     dataplane.Configuration
+		plus     bool
+		expEmpty bool
+	}{
+		{
+			name: "dynamic upstreams enabled",
+			conf: dataplane.Configuration{DynamicUpstreams: true},
+		},
+		{
+			name:     "dynamic upstreams disabled",
+			conf:     dataplane.Configuration{},
+			expEmpty: true,
+		},
+		{
+			name:     "dynamic upstreams enabled with NGINX Plus",
+			conf:     dataplane.Configuration{DynamicUpstreams: true},
+			plus:     true,
+			expEmpty: true,
+		},
+	}
+
+	expSubStrs := []string{
+		"js_shared_dict_zone zone=ngf_upstreams:1m;",
+		"js_set $ngf_upstream_server upstreams.server;",
+		"listen unix:/var/run/nginx/nginx-upstreams.sock;",
+		"js_content upstreams.api;",
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := GeneratorImpl{plus: test.plus}.executeDynamicUpstreams(test.conf)
+			if test.expEmpty {
+				g.Expect(res).To(BeEmpty())
+				return
+			}
+
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+			data := string(res[0].data)
+			for _, str := range expSubStrs {
+				g.Expect(data).To(ContainSubstring(str))
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// errorPageLocationPrefix is the prefix of the internal locations that serve the content of the error pages.
+const errorPageLocationPrefix = "/_ngf-error-page/"
+
+func generateErrorPage(id dataplane.ErrorPageID, content []byte) file.File {
+	return file.File{
+		Content: content,
+		Path:    generateErrorPageFileName(id),
+		Type:    file.TypeRegular,
+	}
+}
+
+// generateErrorPageFileName returns the path of the file with the content of the error page.
+// The file doesn't have the .conf extension so that NGINX doesn't include it as configuration.
+func generateErrorPageFileName(id dataplane.ErrorPageID) string {
+	return filepath.Join(httpFolder, string(id))
+}
+
+func generateErrorPageURI(id dataplane.ErrorPageID) string {
+	return errorPageLocationPrefix + string(id)
+}
+
+// createErrorPages creates the error_page directives for the error pages. If several error pages include
+// the same status code, only the first one gets it.
+// The error pages of a route are merged with the error pages of the Gateway, because NGINX doesn't inherit
+// error_page directives from the server context if a location defines its own.
+func createErrorPages(routeErrorPages, gatewayErrorPages []dataplane.ErrorPage) []http.ErrorPage {
+	if len(routeErrorPages) == 0 && len(gatewayErrorPages) == 0 {
+		return nil
+	}
+
+	errorPages := make([]http.ErrorPage, 0, len(routeErrorPages)+len(gatewayErrorPages))
+	seenCodes := make(map[int32]struct{})
+
+	for _, page := range append(append([]dataplane.ErrorPage{}, routeErrorPages...), gatewayErrorPages...) {
+		codes := make([]int32, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			if _, seen := seenCodes[code]; seen {
+				continue
+			}
+			seenCodes[code] = struct{}{}
+			codes = append(codes, code)
+		}
+
+		if len(codes) == 0 {
+			continue
+		}
+
+		errorPages = append(errorPages, http.ErrorPage{
+			Codes:        codes,
+			ResponseCode: page.ResponseCode,
+			URI:          generateErrorPageURI(page.ID),
+		})
+	}
+
+	return errorPages
+}
+
+// createErrorPageLocations creates the internal locations that serve the error pages used by the server.
+// The error pages served by an upstream are proxied to the upstream.
+func createErrorPageLocations(
+	server dataplane.VirtualServer,
+	gatewayErrorPages []dataplane.ErrorPage,
+	dynamicUpstreams bool,
+) []http.ErrorPageLocation {
+	var locations []http.ErrorPageLocation
+	seenIDs := make(map[dataplane.ErrorPageID]struct{})
+
+	addLocations := func(pages []dataplane.ErrorPage) {
+		for _, page := range pages {
+			if _, seen := seenIDs[page.ID]; seen {
+				continue
+			}
+			seenIDs[page.ID] = struct{}{}
+
+			path := exactPath(generateErrorPageURI(page.ID))
+
+			if page.UpstreamName == "" {
+				locations = append(locations, http.ErrorPageLocation{
+					Path:        path,
+					ContentType: page.ContentType,
+					File:        generateErrorPageFileName(page.ID),
+				})
+				continue
+			}
+
+			location := http.ErrorPageLocation{Path: path}
+
+			target := page.UpstreamName
+			if dynamicUpstreams {
+				// NJS chooses the server of the upstream, so proxy to the server in its variable instead
+				location.DynamicUpstream = target
+				target = "$" + dynamicUpstreamServerVariable
+			}
+			location.ProxyPass = "http://" + target + page.Path
+
+			locations = append(locations, location)
+		}
+	}
+
+	addLocations(gatewayErrorPages)
+
+	for _, rule := range server.PathRules {
+		for _, matchRule := range rule.MatchRules {
+			addLocations(matchRule.ErrorPages)
+		}
+	}
+
+	return locations
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestCreateErrorPages(t *testing.T) {
+	gatewayErrorPages := []dataplane.ErrorPage{
+		{
+			ID:    "gw_0",
+			Codes: []int32{404, 500},
+		},
+		{
+			ID:    "gw_1",
+			Codes: []int32{502},
+		},
+	}
+
+	routeErrorPages := []dataplane.ErrorPage{
+		{
+			ID:           "route_0",
+			Codes:        []int32{500, 502},
+			ResponseCode: helpers.GetPointer[int32](200),
+		},
+		{
+			ID:    "route_1",
+			Codes: []int32{502, 503},
+		},
+	}
+
+	tests := []struct {
+		msg               string
+		routeErrorPages   []dataplane.ErrorPage
+		gatewayErrorPages []dataplane.ErrorPage
+		expected          []http.ErrorPage
+	}{
+		{
+			msg:      "no error pages",
+			expected: nil,
+		},
+		{
+			msg:               "gateway error pages",
+			gatewayErrorPages: gatewayErrorPages,
+			expected: []http.ErrorPage{
+				{
+					Codes: []int32{404, 500},
+					URI:   "/_ngf-error-page/gw_0",
+				},
+				{
+					Codes: []int32{502},
+					URI:   "/_ngf-error-page/gw_1",
+				},
+			},
+		},
+		{
+			msg:               "route error pages take precedence",
+			routeErrorPages:   routeErrorPages,
+			gatewayErrorPages: gatewayErrorPages,
+			expected: []http.ErrorPage{
+				{
+					Codes:        []int32{500, 502},
+					ResponseCode: helpers.GetPointer[int32](200),
+					URI:          "/_ngf-error-page/route_0",
+				},
+				{
+					Codes: []int32{503},
+					URI:   "/_ngf-error-page/route_1",
+				},
+				{
+					Codes: []int32{404},
+					URI:   "/_ngf-error-page/gw_0",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			result := createErrorPages(test.routeErrorPages, test.gatewayErrorPages)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateErrorPageLocations(t *testing.T) {
+	gatewayErrorPage := dataplane.ErrorPage{
+		ID:          "gw_0",
+		Codes:       []int32{404},
+		ContentType: "text/html",
+	}
+
+	routeErrorPage := dataplane.ErrorPage{
+		ID:          "route_0",
+		Codes:       []int32{500},
+		ContentType: "application/json",
+	}
+
+	backendErrorPage := dataplane.ErrorPage{
+		ID:           "route_1",
+		Codes:        []int32{503},
+		ContentType:  "text/html",
+		UpstreamName: "test_errors_80",
+		Path:         "/503.html",
+	}
+
+	server := dataplane.VirtualServer{
+		PathRules: []dataplane.PathRule{
+			{
+				MatchRules: []dataplane.MatchRule{
+					{ErrorPages: []dataplane.ErrorPage{routeErrorPage, backendErrorPage}},
+					{ErrorPages: []dataplane.ErrorPage{routeErrorPage}},
+					{},
+				},
+			},
+		},
+	}
+
+	expected := []http.ErrorPageLocation{
+		{
+			Path:        "= /_ngf-error-page/gw_0",
+			ContentType: "text/html",
+			File:        "/etc/nginx/conf.d/gw_0",
+		},
+		{
+			Path:        "= /_ngf-error-page/route_0",
+			ContentType: "application/json",
+			File:        "/etc/nginx/conf.d/route_0",
+		},
+		{
+			Path:      "= /_ngf-error-page/route_1",
+			ProxyPass: "http://test_errors_80/503.html",
+		},
+	}
+
+	g := NewWithT(t)
+
+	gatewayErrorPages := []dataplane.ErrorPage{gatewayErrorPage}
+
+	g.Expect(createErrorPageLocations(server, gatewayErrorPages, false)).To(Equal(expected))
+	g.Expect(createErrorPageLocations(dataplane.VirtualServer{}, nil, false)).To(BeNil())
+
+	expected[2] = http.ErrorPageLocation{
+		Path:            "= /_ngf-error-page/route_1",
+		ProxyPass:       "http://$ngf_upstream_server/503.html",
+		DynamicUpstream: "test_errors_80",
+	}
+
+	g.Expect(createErrorPageLocations(server, gatewayErrorPages, true)).To(Equal(expected))
+}
@@ -1,7 +1,12 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -89,11 +94,41 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 		files = append(files, generateCertBundle(id, bundle))
 	}
 
-	files = append(files, generateLoadModulesConf(conf))
+	for id, content := range conf.ErrorPageContents {
+		files = append(files, generateErrorPage(id, content))
+	}
+
+	files = append(files, generateLoadModulesConf(conf, g.plus))
 
 	return files
 }
 
+// HashFiles returns a hash of the NGINX configuration files. The order of the files doesn't affect the hash.
+// The config version file is not part of the hash, because its content changes with every generated configuration.
+func HashFiles(files []file.File) string {
+	sorted := slices.Clone(files)
+	slices.SortFunc(sorted, func(a, b file.File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	h := sha256.New()
+
+	for _, f := range sorted {
+		if f.Path == configVersionFile {
+			continue
+		}
+
+		// write the lengths of the path and content, so that different files can't produce the same input
+		_ = binary.Write(h, binary.BigEndian, uint64(len(f.Path)))
+		h.Write([]byte(f.Path))
+		_ = binary.Write(h, binary.BigEndian, uint64(f.Type))
+		_ = binary.Write(h, binary.BigEndian, uint64(len(f.Content)))
+		h.Write(f.Content)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func generatePEM(id dataplane.SSLKeyPairID, cert []byte, key []byte) file.File {
 	c := make([]byte, 0, len(cert)+len(key)+1)
 	c = append(c, cert...)
@@ -147,11 +182,15 @@ func (g GeneratorImpl) generateHTTPConfig(conf dataplane.Configuration) []file.F
 
 func (g GeneratorImpl) getExecuteFuncs() []executeFunc {
 	return []executeFunc{
-		executeServers,
+		g.executeServers,
 		g.executeUpstreams,
 		executeSplitClients,
 		executeMaps,
 		executeTelemetry,
+		executeCompression,
+		executeDNSResolver,
+		g.executeDynamicUpstreams,
+		executeSnippets,
 	}
 }
 
@@ -166,10 +205,20 @@ func generateConfigVersion(configVersion int) file.File {
 	}
 }
 
-func generateLoadModulesConf(conf dataplane.Configuration) file.File {
-	var c []byte
+// generateLoadModulesConf generates the load_module directives of the modules that the configuration needs.
+// Only the NGINX Plus image includes the Brotli module.
+func generateLoadModulesConf(conf dataplane.Configuration, plus bool) file.File {
+	var modules []string
 	if conf.Telemetry.Endpoint != "" {
-		c = []byte("load_module modules/ngx_otel_module.so;")
+		modules = append(modules, "load_module modules/ngx_otel_module.so;")
+	}
+	if plus && brotliConfigured(conf) {
+		modules = append(modules, "load_module modules/ngx_http_brotli_filter_module.so;")
+	}
+
+	var c []byte
+	if len(modules) > 0 {
+		c = []byte(strings.Join(modules, "\n"))
 	}
 
 	return file.File{
@@ -178,3 +227,22 @@ func generateLoadModulesConf(conf dataplane.Configuration) file.File {
 		Type:    file.TypeRegular,
 	}
 }
+
+// brotliConfigured returns whether any compression configuration sets Brotli, which requires the Brotli module.
+func brotliConfigured(conf dataplane.Configuration) bool {
+	if conf.Compression != nil && conf.Compression.Brotli != nil {
+		return true
+	}
+
+	for _, server := range slices.Concat(conf.HTTPServers, conf.SSLServers) {
+		for _, rule := range server.PathRules {
+			for _, matchRule := range rule.MatchRules {
+				if matchRule.Compression != nil && matchRule.Compression.Brotli != nil {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
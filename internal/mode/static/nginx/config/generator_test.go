@@ -2,12 +2,14 @@ package config_test
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -63,6 +65,9 @@ func TestGenerate(t *testing.T) {
 		CertBundles: map[dataplane.CertBundleID]dataplane.CertBundle{
 			"test-certbundle": []byte("test-cert"),
 		},
+		ErrorPageContents: map[dataplane.ErrorPageID][]byte{
+			"error_page_test_0": []byte("test-error-page"),
+		},
 		Telemetry: dataplane.Telemetry{
 			Endpoint:    "1.2.3.4:123",
 			ServiceName: "ngf:gw-ns:gw-name:my-name",
@@ -78,7 +83,7 @@ func TestGenerate(t *testing.T) {
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(6))
+	g.Expect(files).To(HaveLen(7))
 	arrange := func(i, j int) bool {
 		return files[i].Path < files[j].Path
 	}
@@ -89,9 +94,15 @@ func TestGenerate(t *testing.T) {
 	configVersion := string(files[0].Content)
 	g.Expect(configVersion).To(ContainSubstring(fmt.Sprintf("return 200 %d", conf.Version)))
 
-	g.Expect(files[1].Type).To(Equal(file.TypeRegular))
-	g.Expect(files[1].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
-	httpCfg := string(files[1].Content) // converting to string so that on failure gomega prints strings not byte arrays
+	g.Expect(files[1]).To(Equal(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/conf.d/error_page_test_0",
+		Content: []byte("test-error-page"),
+	}))
+
+	g.Expect(files[2].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[2].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
+	httpCfg := string(files[2].Content) // converting to string so that on failure gomega prints strings not byte arrays
 	// Note: this only verifies that Generate() returns a byte array with upstream, server, and split_client blocks.
 	// It does not test the correctness of those blocks. That functionality is covered by other tests in this package.
 	g.Expect(httpCfg).To(ContainSubstring("listen 80"))
@@ -105,22 +116,140 @@ func TestGenerate(t *testing.T) {
 	g.Expect(httpCfg).To(ContainSubstring("batch_count 4;"))
 	g.Expect(httpCfg).To(ContainSubstring("otel_service_name ngf:gw-ns:gw-name:my-name;"))
 
-	g.Expect(files[2].Path).To(Equal("/etc/nginx/conf.d/matches.json"))
+	g.Expect(files[3].Path).To(Equal("/etc/nginx/conf.d/matches.json"))
 
-	g.Expect(files[2].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[3].Type).To(Equal(file.TypeRegular))
 	expString := "{}"
-	g.Expect(string(files[2].Content)).To(Equal(expString))
+	g.Expect(string(files[3].Content)).To(Equal(expString))
 
-	g.Expect(files[3].Path).To(Equal("/etc/nginx/module-includes/load-modules.conf"))
-	g.Expect(files[3].Content).To(Equal([]byte("load_module modules/ngx_otel_module.so;")))
+	g.Expect(files[4].Path).To(Equal("/etc/nginx/module-includes/load-modules.conf"))
+	g.Expect(files[4].Content).To(Equal([]byte("load_module modules/ngx_otel_module.so;")))
 
-	g.Expect(files[4].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
-	certBundle := string(files[4].Content)
+	g.Expect(files[5].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
+	certBundle := string(files[5].Content)
 	g.Expect(certBundle).To(Equal("test-cert"))
 
-	g.Expect(files[5]).To(Equal(file.File{
+	g.Expect(files[6]).To(Equal(file.File{
 		Type:    file.TypeSecret,
 		Path:    "/etc/nginx/secrets/test-keypair.pem",
 		Content: []byte("test-cert\ntest-key"),
 	}))
 }
+
+func TestHashFiles(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
+		},
+		Version: 1,
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	g := NewWithT(t)
+
+	files := generator.Generate(conf)
+	hash := config.HashFiles(files)
+	g.Expect(hash).ToNot(BeEmpty())
+
+	reversed := make([]file.File, 0, len(files))
+	for i := len(files) - 1; i >= 0; i-- {
+		reversed = append(reversed, files[i])
+	}
+	g.Expect(config.HashFiles(reversed)).To(Equal(hash), "order of the files must not change the hash")
+
+	newVersion := conf
+	newVersion.Version = 2
+	g.Expect(config.HashFiles(generator.Generate(newVersion))).To(Equal(hash), "version must not change the hash")
+
+	newServer := conf
+	newServer.HTTPServers = append(newServer.HTTPServers, dataplane.VirtualServer{Hostname: "example.com", Port: 80})
+	g.Expect(config.HashFiles(generator.Generate(newServer))).ToNot(Equal(hash))
+
+	newType := slices.Clone(files)
+	newType[0].Type = file.TypeSecret
+	g.Expect(config.HashFiles(newType)).ToNot(Equal(hash))
+}
+
+func TestGenerateLoadModules(t *testing.T) {
+	brotliRouteServers := []dataplane.VirtualServer{
+		{
+			Hostname: "example.com",
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Compression: &dataplane.Compression{
+								Enabled: true,
+								Brotli:  helpers.GetPointer(false),
+							},
+						},
+					},
+				},
+			},
+			Port: 80,
+		},
+	}
+
+	tests := []struct {
+		conf       dataplane.Configuration
+		expContent string
+		msg        string
+		oss        bool
+	}{
+		{
+			conf:       dataplane.Configuration{},
+			expContent: "",
+			msg:        "no modules",
+		},
+		{
+			conf: dataplane.Configuration{
+				Compression: &dataplane.Compression{
+					Enabled: true,
+					Brotli:  helpers.GetPointer(true),
+				},
+			},
+			expContent: "",
+			msg:        "brotli with NGINX open source",
+			oss:        true,
+		},
+		{
+			conf: dataplane.Configuration{
+				Telemetry: dataplane.Telemetry{Endpoint: "1.2.3.4:123"},
+				Compression: &dataplane.Compression{
+					Enabled: true,
+					Brotli:  helpers.GetPointer(true),
+				},
+			},
+			expContent: "load_module modules/ngx_otel_module.so;\n" +
+				"load_module modules/ngx_http_brotli_filter_module.so;",
+			msg: "otel and brotli in the default compression",
+		},
+		{
+			conf: dataplane.Configuration{
+				HTTPServers: brotliRouteServers,
+			},
+			expContent: "load_module modules/ngx_http_brotli_filter_module.so;",
+			msg:        "brotli in the compression of a route",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			files := config.NewGeneratorImpl(!test.oss).Generate(test.conf)
+
+			idx := slices.IndexFunc(files, func(f file.File) bool {
+				return f.Path == "/etc/nginx/module-includes/load-modules.conf"
+			})
+			g.Expect(idx).ToNot(Equal(-1))
+			g.Expect(string(files[idx].Content)).To(Equal(test.expContent))
+		})
+	}
+}
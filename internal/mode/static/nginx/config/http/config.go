@@ -2,13 +2,16 @@ package http
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Locations     []Location
-	IsDefaultHTTP bool
-	IsDefaultSSL  bool
-	GRPC          bool
-	Port          int32
+	SSL                *SSL
+	ServerName         string
+	Locations          []Location
+	ErrorPages         []ErrorPage
+	ErrorPageLocations []ErrorPageLocation
+	Includes           []string
+	IsDefaultHTTP      bool
+	IsDefaultSSL       bool
+	GRPC               bool
+	Port               int32
 }
 
 // Location holds all configuration for an HTTP location.
@@ -16,13 +19,34 @@ type Location struct {
 	Path            string
 	ProxyPass       string
 	HTTPMatchKey    string
+	DynamicUpstream string
 	ProxySetHeaders []Header
 	ProxySSLVerify  *ProxySSLVerify
 	Return          *Return
+	Compression     *Compression
 	Rewrites        []string
+	ErrorPages      []ErrorPage
+	Includes        []string
 	GRPC            bool
 }
 
+// ErrorPage holds the configuration of an error_page directive.
+type ErrorPage struct {
+	ResponseCode *int32
+	URI          string
+	Codes        []int32
+}
+
+// ErrorPageLocation holds the configuration of an internal location that serves the content of an error page
+// from a File or proxies the request for the error page with ProxyPass.
+type ErrorPageLocation struct {
+	Path            string
+	ContentType     string
+	File            string
+	ProxyPass       string
+	DynamicUpstream string
+}
+
 // Header defines an HTTP header to be passed to the proxied server.
 type Header struct {
 	Name  string
@@ -55,14 +79,18 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name     string
-	ZoneSize string // format: 512k, 1m
-	Servers  []UpstreamServer
+	Name                string
+	ZoneSize            string // format: 512k, 1m
+	LoadBalancingMethod string
+	Servers             []UpstreamServer
 }
 
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
 	Address string
+	Resolve bool
+	Backup  bool
+	Drain   bool
 }
 
 // SplitClient holds all configuration for an HTTP split client.
@@ -95,3 +123,21 @@ type ProxySSLVerify struct {
 	TrustedCertificate string
 	Name               string
 }
+
+// DNSResolver holds the configuration of the resolver directive.
+type DNSResolver struct {
+	Valid       string
+	Timeout     string
+	Addresses   []string
+	DisableIPv6 bool
+}
+
+// Compression holds the gzip and Brotli compression configuration.
+type Compression struct {
+	MinLength *int32
+	Level     *int32
+	// Brotli is the value of the brotli directive, "on" or "off". If empty, Brotli is not configured.
+	Brotli  string
+	Types   []string
+	Enabled bool
+}
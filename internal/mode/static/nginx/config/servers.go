@@ -12,7 +12,9 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var serversTemplate = gotemplate.Must(gotemplate.New("servers").Parse(serversTemplateText))
+var serversTemplate = gotemplate.Must(
+	gotemplate.Must(gotemplate.New("servers").Parse(serversTemplateText)).Parse(compressionDirectivesTemplateText),
+)
 
 const (
 	// HeaderMatchSeparator is the separator for constructing header-based match for NJS.
@@ -40,8 +42,13 @@ var baseHeaders = []http.Header{
 	},
 }
 
-func executeServers(conf dataplane.Configuration) []executeResult {
-	servers, httpMatchPairs := createServers(conf.HTTPServers, conf.SSLServers)
+func (g GeneratorImpl) executeServers(conf dataplane.Configuration) []executeResult {
+	servers, httpMatchPairs := createServers(
+		conf.HTTPServers,
+		conf.SSLServers,
+		conf.ErrorPages,
+		g.dynamicUpstreamsEnabled(conf),
+	)
 
 	serverResult := executeResult{
 		dest: httpConfigFile,
@@ -63,18 +70,23 @@ func executeServers(conf dataplane.Configuration) []executeResult {
 	return []executeResult{serverResult, httpMatchResult}
 }
 
-func createServers(httpServers, sslServers []dataplane.VirtualServer) ([]http.Server, httpMatchPairs) {
+func createServers(
+	httpServers,
+	sslServers []dataplane.VirtualServer,
+	errorPages []dataplane.ErrorPage,
+	dynamicUpstreams bool,
+) ([]http.Server, httpMatchPairs) {
 	servers := make([]http.Server, 0, len(httpServers)+len(sslServers))
 	finalMatchPairs := make(httpMatchPairs)
 
 	for serverID, s := range httpServers {
-		httpServer, matchPairs := createServer(s, serverID)
+		httpServer, matchPairs := createServer(s, serverID, errorPages, dynamicUpstreams)
 		servers = append(servers, httpServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
 
 	for serverID, s := range sslServers {
-		sslServer, matchPair := createSSLServer(s, serverID)
+		sslServer, matchPair := createSSLServer(s, serverID, errorPages, dynamicUpstreams)
 		servers = append(servers, sslServer)
 		maps.Copy(finalMatchPairs, matchPair)
 	}
@@ -82,7 +94,12 @@ func createServers(httpServers, sslServers []dataplane.VirtualServer) ([]http.Se
 	return servers, finalMatchPairs
 }
 
-func createSSLServer(virtualServer dataplane.VirtualServer, serverID int) (http.Server, httpMatchPairs) {
+func createSSLServer(
+	virtualServer dataplane.VirtualServer,
+	serverID int,
+	errorPages []dataplane.ErrorPage,
+	dynamicUpstreams bool,
+) (http.Server, httpMatchPairs) {
 	if virtualServer.IsDefault {
 		return http.Server{
 			IsDefaultSSL: true,
@@ -90,7 +107,7 @@ func createSSLServer(virtualServer dataplane.VirtualServer, serverID int) (http.
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID)
+	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, errorPages, dynamicUpstreams)
 
 	return http.Server{
 		ServerName: virtualServer.Hostname,
@@ -98,27 +115,40 @@ func createSSLServer(virtualServer dataplane.VirtualServer, serverID int) (http.
 			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
 			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
 		},
-		Locations: locs,
-		Port:      virtualServer.Port,
-		GRPC:      grpc,
+		Locations:          locs,
+		ErrorPages:         createErrorPages(nil, errorPages),
+		ErrorPageLocations: createErrorPageLocations(virtualServer, errorPages, dynamicUpstreams),
+		Includes:           createServerSnippetIncludes(virtualServer),
+		Port:               virtualServer.Port,
+		GRPC:               grpc,
 	}, matchPairs
 }
 
-func createServer(virtualServer dataplane.VirtualServer, serverID int) (http.Server, httpMatchPairs) {
+func createServer(
+	virtualServer dataplane.VirtualServer,
+	serverID int,
+	errorPages []dataplane.ErrorPage,
+	dynamicUpstreams bool,
+) (http.Server, httpMatchPairs) {
 	if virtualServer.IsDefault {
 		return http.Server{
-			IsDefaultHTTP: true,
-			Port:          virtualServer.Port,
+			IsDefaultHTTP:      true,
+			ErrorPages:         createErrorPages(nil, errorPages),
+			ErrorPageLocations: createErrorPageLocations(virtualServer, errorPages, dynamicUpstreams),
+			Port:               virtualServer.Port,
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID)
+	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, errorPages, dynamicUpstreams)
 
 	return http.Server{
-		ServerName: virtualServer.Hostname,
-		Locations:  locs,
-		Port:       virtualServer.Port,
-		GRPC:       grpc,
+		ServerName:         virtualServer.Hostname,
+		Locations:          locs,
+		ErrorPages:         createErrorPages(nil, errorPages),
+		ErrorPageLocations: createErrorPageLocations(virtualServer, errorPages, dynamicUpstreams),
+		Includes:           createServerSnippetIncludes(virtualServer),
+		Port:               virtualServer.Port,
+		GRPC:               grpc,
 	}, matchPairs
 }
 
@@ -131,7 +161,12 @@ type rewriteConfig struct {
 
 type httpMatchPairs map[string][]routeMatch
 
-func createLocations(server *dataplane.VirtualServer, serverID int) ([]http.Location, httpMatchPairs, bool) {
+func createLocations(
+	server *dataplane.VirtualServer,
+	serverID int,
+	errorPages []dataplane.ErrorPage,
+	dynamicUpstreams bool,
+) ([]http.Location, httpMatchPairs, bool) {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(server.PathRules)
 	locs := make([]http.Location, 0, maxLocs)
 	matchPairs := make(httpMatchPairs)
@@ -159,7 +194,23 @@ func createLocations(server *dataplane.VirtualServer, serverID int) ([]http.Loca
 				matches = append(matches, match)
 			}
 
-			buildLocations = updateLocationsForFilters(r.Filters, buildLocations, r, server.Port, rule.Path, rule.GRPC)
+			buildLocations = updateLocationsForFilters(
+				r.Filters,
+				buildLocations,
+				r,
+				server.Port,
+				rule.Path,
+				rule.GRPC,
+				dynamicUpstreams,
+			)
+
+			// the error pages of the route replace the error pages of the Gateway inherited from the server
+			if len(r.ErrorPages) > 0 {
+				for i := range buildLocations {
+					buildLocations[i].ErrorPages = createErrorPages(r.ErrorPages, errorPages)
+				}
+			}
+
 			locs = append(locs, buildLocations...)
 		}
 
@@ -276,6 +327,7 @@ func updateLocationsForFilters(
 	listenerPort int32,
 	path string,
 	grpc bool,
+	dynamicUpstreams bool,
 ) []http.Location {
 	if filters.InvalidFilter != nil {
 		for i := range buildLocations {
@@ -284,6 +336,11 @@ func updateLocationsForFilters(
 		return buildLocations
 	}
 
+	includes := createLocationSnippetIncludes(filters.SnippetsFilters)
+	for i := range buildLocations {
+		buildLocations[i].Includes = includes
+	}
+
 	if filters.RequestRedirect != nil {
 		ret := createReturnValForRedirectFilter(filters.RequestRedirect, listenerPort)
 		for i := range buildLocations {
@@ -302,14 +359,23 @@ func updateLocationsForFilters(
 		}
 		buildLocations[i].ProxySetHeaders = proxySetHeaders
 		buildLocations[i].ProxySSLVerify = createProxyTLSFromBackends(matchRule.BackendGroup.Backends)
+
+		target := createUpstreamTarget(matchRule.BackendGroup)
+		if dynamicUpstreams {
+			// NJS chooses the server of the upstream, so proxy to the server in its variable instead
+			buildLocations[i].DynamicUpstream = target
+			target = "$" + dynamicUpstreamServerVariable
+		}
+
 		proxyPass := createProxyPass(
-			matchRule.BackendGroup,
+			target,
 			matchRule.Filters.RequestURLRewrite,
 			generateProtocolString(buildLocations[i].ProxySSLVerify, grpc),
 			grpc,
 		)
 		buildLocations[i].ProxyPass = proxyPass
 		buildLocations[i].GRPC = grpc
+		buildLocations[i].Compression = createCompression(matchRule.Compression)
 	}
 
 	return buildLocations
@@ -526,8 +592,19 @@ func isPathOnlyMatch(match dataplane.Match) bool {
 	return match.Method == nil && len(match.Headers) == 0 && len(match.QueryParams) == 0
 }
 
+// createUpstreamTarget returns the name of the upstream of the backend group, or the variable of the split clients
+// that chooses the upstream if the traffic is split between multiple backends.
+func createUpstreamTarget(backendGroup dataplane.BackendGroup) string {
+	backendName := backendGroupName(backendGroup)
+	if backendGroupNeedsSplit(backendGroup) {
+		return "$" + convertStringToSafeVariableName(backendName)
+	}
+
+	return backendName
+}
+
 func createProxyPass(
-	backendGroup dataplane.BackendGroup,
+	target string,
 	filter *dataplane.HTTPURLRewriteFilter,
 	protocol string,
 	grpc bool,
@@ -539,12 +616,7 @@ func createProxyPass(
 		}
 	}
 
-	backendName := backendGroupName(backendGroup)
-	if backendGroupNeedsSplit(backendGroup) {
-		return protocol + "://$" + convertStringToSafeVariableName(backendName) + requestURI
-	}
-
-	return protocol + "://" + backendName + requestURI
+	return protocol + "://" + target + requestURI
 }
 
 func createMatchLocation(path string) http.Location {
@@ -14,7 +14,33 @@ server {
     listen {{ $s.Port }} default_server;
 
     default_type text/html;
+        {{- if $s.ErrorPages }}
+            {{- range $e := $s.ErrorPages }}
+    error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}{{ if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} {{ $e.URI }};
+            {{- end }}
+
+    location / {
+        return 404;
+    }
+            {{- range $el := $s.ErrorPageLocations }}
+
+    location {{ $el.Path }} {
+        internal;
+                {{- if $el.ProxyPass }}
+                    {{- if $el.DynamicUpstream }}
+        set $ngf_upstream {{ $el.DynamicUpstream }};
+                    {{- end }}
+        proxy_pass {{ $el.ProxyPass }};
+                {{- else }}
+        types {}
+        default_type {{ $el.ContentType }};
+        alias {{ $el.File }};
+                {{- end }}
+    }
+            {{- end }}
+        {{- else }}
     return 404;
+        {{- end }}
 }
     {{- else }}
 server {
@@ -32,12 +58,35 @@ server {
 
     server_name {{ $s.ServerName }};
 
+        {{- range $i := $s.Includes }}
+    include {{ $i }};
+        {{- end }}
+
+        {{- range $e := $s.ErrorPages }}
+    error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}{{ if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} {{ $e.URI }};
+        {{- end }}
+
         {{ range $l := $s.Locations }}
     location {{ $l.Path }} {
+        {{- if $l.DynamicUpstream }}
+        set $ngf_upstream {{ $l.DynamicUpstream }};
+        {{- end }}
+
         {{- range $r := $l.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
 
+        {{- range $e := $l.ErrorPages }}
+        error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}{{ if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} {{ $e.URI }};
+        {{- end }}
+        {{- if $l.ErrorPages }}
+        proxy_intercept_errors on;
+        {{- end }}
+
+        {{- range $i := $l.Includes }}
+        include {{ $i }};
+        {{- end }}
+
         {{- if $l.Return }}
         return {{ $l.Return.Code }} "{{ $l.Return.Body }}";
         {{- end }}
@@ -53,6 +102,10 @@ server {
         include /etc/nginx/grpc-error-pages.conf;
         {{- end }}
 
+        {{- if $l.Compression }}
+        {{ template "compressionDirectives" $l.Compression }}
+        {{- end }}
+
         {{- if $l.ProxyPass -}}
             {{ range $h := $l.ProxySetHeaders }}
         {{ $proxyOrGRPC }}_set_header {{ $h.Name }} "{{ $h.Value }}";
@@ -68,6 +121,22 @@ server {
     }
         {{ end }}
 
+        {{- range $el := $s.ErrorPageLocations }}
+    location {{ $el.Path }} {
+        internal;
+            {{- if $el.ProxyPass }}
+                {{- if $el.DynamicUpstream }}
+        set $ngf_upstream {{ $el.DynamicUpstream }};
+                {{- end }}
+        proxy_pass {{ $el.ProxyPass }};
+            {{- else }}
+        types {}
+        default_type {{ $el.ContentType }};
+        alias {{ $el.File }};
+            {{- end }}
+    }
+        {{ end }}
+
         {{- if $s.GRPC }}
         include /etc/nginx/grpc-error-locations.conf;
         {{- end }}
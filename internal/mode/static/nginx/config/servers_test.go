@@ -63,7 +63,7 @@ func TestExecuteServers(t *testing.T) {
 		"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 2,
 	}
 	g := NewWithT(t)
-	serverResults := executeServers(conf)
+	serverResults := GeneratorImpl{}.executeServers(conf)
 	g.Expect(serverResults).To(HaveLen(2))
 	serverConf := string(serverResults[0].data)
 	httpMatchConf := string(serverResults[1].data)
@@ -73,6 +73,250 @@ func TestExecuteServers(t *testing.T) {
 	}
 }
 
+func TestExecuteServersWithCompression(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+								Compression: &dataplane.Compression{
+									Enabled:   true,
+									Brotli:    helpers.GetPointer(true),
+									MIMETypes: []string{"application/json"},
+									MinLength: helpers.GetPointer[int32](256),
+									Level:     helpers.GetPointer[int32](6),
+								},
+							},
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+								Compression: &dataplane.Compression{
+									Brotli: helpers.GetPointer(false),
+								},
+							},
+						},
+					},
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"gzip on;":                       2, // both /coffee/ and = /coffee locations
+		"gzip_vary on;":                  2,
+		"gzip_types application/json;":   2,
+		"gzip_min_length 256;":           2,
+		"gzip_comp_level 6;":             2,
+		"gzip off;":                      1,
+		"brotli on;":                     2,
+		"brotli_types application/json;": 2,
+		"brotli_min_length 256;":         2,
+		"brotli off;":                    1,
+		"location = /tea {":              1,
+		"location / {":                   1,
+	}
+
+	g := NewWithT(t)
+	serverResults := GeneratorImpl{}.executeServers(conf)
+	g.Expect(serverResults).To(HaveLen(2))
+	serverConf := string(serverResults[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServersWithDynamicUpstreams(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_coffee_80", Valid: true, Weight: 1},
+									},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "hr"},
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_tea_80", Valid: true, Weight: 1},
+										{UpstreamName: "test_chai_80", Valid: true, Weight: 1},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		DynamicUpstreams: true,
+	}
+
+	tests := []struct {
+		expSubStrings map[string]int
+		name          string
+		plus          bool
+	}{
+		{
+			name: "NGINX open source",
+			expSubStrings: map[string]int{
+				"set $ngf_upstream test_coffee_80;":                   2,
+				"set $ngf_upstream $test__hr_rule0;":                  1,
+				"proxy_pass http://$ngf_upstream_server$request_uri;": 3,
+			},
+		},
+		{
+			name: "NGINX Plus",
+			plus: true,
+			expSubStrings: map[string]int{
+				"set $ngf_upstream":                                  0,
+				"proxy_pass http://test_coffee_80$request_uri;":      2,
+				"proxy_pass http://$test__hr_rule0$request_uri;":     1,
+				"proxy_pass http://$ngf_upstream_server$request_uri": 0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			serverResults := GeneratorImpl{plus: test.plus}.executeServers(conf)
+			g.Expect(serverResults).To(HaveLen(2))
+			serverConf := string(serverResults[0].data)
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteServersWithErrorPages(t *testing.T) {
+	gatewayErrorPages := []dataplane.ErrorPage{
+		{
+			ID:          "error_page_test_gw_0",
+			Codes:       []int32{404, 502},
+			ContentType: "text/html",
+		},
+		{
+			ID:           "error_page_test_gw_1",
+			Codes:        []int32{503},
+			UpstreamName: "test_errors_80",
+			Path:         "/503.html",
+		},
+	}
+
+	routeErrorPages := []dataplane.ErrorPage{
+		{
+			ID:           "error_page_test_route_0",
+			Codes:        []int32{502},
+			ResponseCode: helpers.GetPointer[int32](200),
+			ContentType:  "application/json",
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
+			},
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+								ErrorPages:   routeErrorPages,
+							},
+						},
+					},
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+							},
+						},
+					},
+				},
+			},
+		},
+		ErrorPages: gatewayErrorPages,
+	}
+
+	expSubStrings := map[string]int{
+		"error_page 404 502 /_ngf-error-page/error_page_test_gw_0;":     2, // default server and cafe server
+		"error_page 502 =200 /_ngf-error-page/error_page_test_route_0;": 1,
+		"error_page 404 /_ngf-error-page/error_page_test_gw_0;":         1,
+		"error_page 503 /_ngf-error-page/error_page_test_gw_1;":         3,
+		"proxy_intercept_errors on;":                                    1, // only the location with route error pages
+		"location = /_ngf-error-page/error_page_test_gw_0 {":            2,
+		"location = /_ngf-error-page/error_page_test_gw_1 {":            2,
+		"location = /_ngf-error-page/error_page_test_route_0 {":         1,
+		"alias /etc/nginx/conf.d/error_page_test_gw_0;":                 2,
+		"alias /etc/nginx/conf.d/error_page_test_route_0;":              1,
+		"proxy_pass http://test_errors_80/503.html;":                    2,
+		"default_type application/json;":                                1,
+		"internal;":                                                     5,
+		"return 404;":                                                   1,
+	}
+
+	g := NewWithT(t)
+	serverResults := GeneratorImpl{}.executeServers(conf)
+	g.Expect(serverResults).To(HaveLen(2))
+	serverConf := string(serverResults[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
 func TestExecuteForDefaultServers(t *testing.T) {
 	testcases := []struct {
 		msg       string
@@ -144,7 +388,7 @@ func TestExecuteForDefaultServers(t *testing.T) {
 		t.Run(tc.msg, func(t *testing.T) {
 			g := NewWithT(t)
 
-			serverResults := executeServers(tc.conf)
+			serverResults := GeneratorImpl{}.executeServers(tc.conf)
 			g.Expect(serverResults).To(HaveLen(2))
 			serverConf := string(serverResults[0].data)
 			httpMatchConf := string(serverResults[1].data)
@@ -878,7 +1122,7 @@ func TestCreateServers(t *testing.T) {
 
 	g := NewWithT(t)
 
-	result, httpMatchPair := createServers(httpServers, sslServers)
+	result, httpMatchPair := createServers(httpServers, sslServers, nil, false)
 
 	g.Expect(httpMatchPair).To(Equal(allExpMatchPair))
 	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
@@ -1087,7 +1331,7 @@ func TestCreateServersConflicts(t *testing.T) {
 
 			g := NewWithT(t)
 
-			result, _ := createServers(httpServers, []dataplane.VirtualServer{})
+			result, _ := createServers(httpServers, []dataplane.VirtualServer{}, nil, false)
 			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
 		})
 	}
@@ -1256,7 +1500,7 @@ func TestCreateLocationsRootPath(t *testing.T) {
 			locs, httpMatchPair, grpc := createLocations(&dataplane.VirtualServer{
 				PathRules: test.pathRules,
 				Port:      80,
-			}, 1)
+			}, 1, nil, false)
 			g.Expect(locs).To(Equal(test.expLocations))
 			g.Expect(httpMatchPair).To(BeEmpty())
 			g.Expect(grpc).To(Equal(test.grpc))
@@ -1838,7 +2082,7 @@ func TestCreateProxyPass(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil, tc.GRPC), tc.GRPC)
+		result := createProxyPass(createUpstreamTarget(tc.grp), tc.rewrite, generateProtocolString(nil, tc.GRPC), tc.GRPC)
 		g.Expect(result).To(Equal(tc.expected))
 	}
 }
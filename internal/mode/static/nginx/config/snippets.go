@@ -0,0 +1,140 @@
+package config
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// Every snippet is written to its own file, so that NGINX reports the file of the offending snippet
+// if the snippet is not valid.
+
+// snippetFileRegexp matches the path of a snippet file. The file name is generated by the dataplane package as
+// <kind>_<context>_<namespace>_<name>.
+var snippetFileRegexp = regexp.MustCompile(
+	`(?:` + regexp.QuoteMeta(modulesIncludesFolder) + `|` + regexp.QuoteMeta(httpFolder) + `)/` +
+		`(SnippetsFilter|HTTPRoute)_(?:main|http|http\.server|http\.server\.location)_` +
+		`([a-z0-9-]+)_([a-z0-9.-]+)\.(?:conf|snippet)`,
+)
+
+// FindSnippetOwner finds the first snippet file in the errors reported by NGINX, and returns the kind and
+// the namespaced name of the resource that owns the snippet: a SnippetsFilter or an HTTPRoute with snippet
+// annotations. It returns false if the errors don't name a snippet file.
+func FindSnippetOwner(errs string) (v1.Kind, types.NamespacedName, bool) {
+	match := snippetFileRegexp.FindStringSubmatch(errs)
+	if match == nil {
+		return "", types.NamespacedName{}, false
+	}
+
+	return v1.Kind(match[1]), types.NamespacedName{Namespace: match[2], Name: match[3]}, true
+}
+
+// executeSnippets generates the files of the snippets of the SnippetsFilters.
+// The main snippets are included by nginx.conf through modulesIncludesFolder, the http snippets are
+// included through httpFolder, and the server and location snippets are included by the servers and locations
+// that use them.
+func executeSnippets(conf dataplane.Configuration) []executeResult {
+	results := make([]executeResult, 0, len(conf.MainSnippets)+len(conf.HTTPSnippets))
+
+	for _, snippet := range conf.MainSnippets {
+		results = append(results, executeResult{
+			dest: generateMainSnippetFileName(snippet),
+			data: []byte(snippet.Contents),
+		})
+	}
+
+	for _, snippet := range conf.HTTPSnippets {
+		results = append(results, executeResult{
+			dest: generateHTTPSnippetFileName(snippet),
+			data: []byte(snippet.Contents),
+		})
+	}
+
+	seenSnippets := make(map[string]struct{})
+
+	addSnippet := func(snippet *dataplane.Snippet) {
+		if snippet == nil {
+			return
+		}
+
+		if _, seen := seenSnippets[snippet.Name]; seen {
+			return
+		}
+		seenSnippets[snippet.Name] = struct{}{}
+
+		results = append(results, executeResult{
+			dest: generateIncludedSnippetFileName(*snippet),
+			data: []byte(snippet.Contents),
+		})
+	}
+
+	for _, server := range append(append([]dataplane.VirtualServer{}, conf.HTTPServers...), conf.SSLServers...) {
+		for _, rule := range server.PathRules {
+			for _, matchRule := range rule.MatchRules {
+				for _, sf := range matchRule.Filters.SnippetsFilters {
+					addSnippet(sf.ServerSnippet)
+					addSnippet(sf.LocationSnippet)
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+func generateMainSnippetFileName(snippet dataplane.Snippet) string {
+	return filepath.Join(modulesIncludesFolder, snippet.Name+".conf")
+}
+
+func generateHTTPSnippetFileName(snippet dataplane.Snippet) string {
+	return filepath.Join(httpFolder, snippet.Name+".conf")
+}
+
+// generateIncludedSnippetFileName returns the path of the file of a server or location snippet.
+// The file doesn't have the .conf extension so that NGINX doesn't include it in the http context.
+func generateIncludedSnippetFileName(snippet dataplane.Snippet) string {
+	return filepath.Join(httpFolder, snippet.Name+".snippet")
+}
+
+// createServerSnippetIncludes creates the includes of the server snippets of the SnippetsFilters used by
+// the server. Every snippet is included once.
+func createServerSnippetIncludes(server dataplane.VirtualServer) []string {
+	var includes []string
+	seenSnippets := make(map[string]struct{})
+
+	for _, rule := range server.PathRules {
+		for _, matchRule := range rule.MatchRules {
+			for _, sf := range matchRule.Filters.SnippetsFilters {
+				if sf.ServerSnippet == nil {
+					continue
+				}
+
+				if _, seen := seenSnippets[sf.ServerSnippet.Name]; seen {
+					continue
+				}
+				seenSnippets[sf.ServerSnippet.Name] = struct{}{}
+
+				includes = append(includes, generateIncludedSnippetFileName(*sf.ServerSnippet))
+			}
+		}
+	}
+
+	return includes
+}
+
+// createLocationSnippetIncludes creates the includes of the location snippets of the SnippetsFilters.
+func createLocationSnippetIncludes(filters []dataplane.SnippetsFilter) []string {
+	var includes []string
+
+	for _, sf := range filters {
+		if sf.LocationSnippet != nil {
+			includes = append(includes, generateIncludedSnippetFileName(*sf.LocationSnippet))
+		}
+	}
+
+	return includes
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var (
+	serverSnippet = &dataplane.Snippet{
+		Name:     "SnippetsFilter_http.server_test_sf",
+		Contents: "client_max_body_size 10m;",
+	}
+	locationSnippet = &dataplane.Snippet{
+		Name:     "SnippetsFilter_http.server.location_test_sf",
+		Contents: "add_header X-Test test;",
+	}
+)
+
+func createSnippetsServer(hostname string) dataplane.VirtualServer {
+	return dataplane.VirtualServer{
+		Hostname: hostname,
+		Port:     8080,
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/coffee",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							SnippetsFilters: []dataplane.SnippetsFilter{
+								{
+									ServerSnippet:   serverSnippet,
+									LocationSnippet: locationSnippet,
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/tea",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							SnippetsFilters: []dataplane.SnippetsFilter{
+								{
+									ServerSnippet: serverSnippet,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExecuteSnippets(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{createSnippetsServer("cafe.example.com")},
+		SSLServers:  []dataplane.VirtualServer{createSnippetsServer("cafe.example.com")},
+		MainSnippets: []dataplane.Snippet{
+			{
+				Name:     "SnippetsFilter_main_test_sf",
+				Contents: "worker_priority 0;",
+			},
+		},
+		HTTPSnippets: []dataplane.Snippet{
+			{
+				Name:     "SnippetsFilter_http_test_sf",
+				Contents: "log_format one '$remote_addr';",
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	results := executeSnippets(conf)
+	g.Expect(results).To(ConsistOf(
+		executeResult{
+			dest: "/etc/nginx/module-includes/SnippetsFilter_main_test_sf.conf",
+			data: []byte("worker_priority 0;"),
+		},
+		executeResult{
+			dest: "/etc/nginx/conf.d/SnippetsFilter_http_test_sf.conf",
+			data: []byte("log_format one '$remote_addr';"),
+		},
+		executeResult{
+			dest: "/etc/nginx/conf.d/SnippetsFilter_http.server_test_sf.snippet",
+			data: []byte("client_max_body_size 10m;"),
+		},
+		executeResult{
+			dest: "/etc/nginx/conf.d/SnippetsFilter_http.server.location_test_sf.snippet",
+			data: []byte("add_header X-Test test;"),
+		},
+	))
+
+	g.Expect(executeSnippets(dataplane.Configuration{})).To(BeEmpty())
+}
+
+func TestExecuteServersWithSnippets(t *testing.T) {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{createSnippetsServer("cafe.example.com")},
+	}
+
+	expSubStrings := map[string]int{
+		"include /etc/nginx/conf.d/SnippetsFilter_http.server_test_sf.snippet;":          1,
+		"include /etc/nginx/conf.d/SnippetsFilter_http.server.location_test_sf.snippet;": 1,
+	}
+
+	g := NewWithT(t)
+	serverResults := GeneratorImpl{}.executeServers(conf)
+	g.Expect(serverResults).To(HaveLen(2))
+	serverConf := string(serverResults[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestFindSnippetOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      string
+		expKind   v1.Kind
+		expNsName types.NamespacedName
+		expFound  bool
+	}{
+		{
+			name: "location snippet of a SnippetsFilter",
+			errs: `[emerg] unknown directive "invalid" in ` +
+				`/etc/nginx/conf.d/SnippetsFilter_http.server.location_test_sf.v1.snippet:1`,
+			expKind:   "SnippetsFilter",
+			expNsName: types.NamespacedName{Namespace: "test", Name: "sf.v1"},
+			expFound:  true,
+		},
+		{
+			name: "main snippet of an HTTPRoute",
+			errs: `[emerg] "worker_priority" directive is duplicate in ` +
+				`/etc/nginx/module-includes/HTTPRoute_main_default_coffee.conf:1`,
+			expKind:   "HTTPRoute",
+			expNsName: types.NamespacedName{Namespace: "default", Name: "coffee"},
+			expFound:  true,
+		},
+		{
+			name:      "http snippet of an HTTPRoute",
+			errs:      `[emerg] unexpected "}" in /etc/nginx/conf.d/HTTPRoute_http_default_tea.conf:2`,
+			expKind:   "HTTPRoute",
+			expNsName: types.NamespacedName{Namespace: "default", Name: "tea"},
+			expFound:  true,
+		},
+		{
+			name: "not a snippet",
+			errs: `[emerg] host not found in upstream "backend" in /etc/nginx/conf.d/http.conf:10`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kind, nsname, found := FindSnippetOwner(test.errs)
+			g.Expect(found).To(Equal(test.expFound))
+			g.Expect(kind).To(Equal(test.expKind))
+			g.Expect(nsname).To(Equal(test.expNsName))
+		})
+	}
+}
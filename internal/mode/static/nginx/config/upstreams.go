@@ -23,6 +23,11 @@ const (
 	plusZoneSize = "1m"
 	// invalidBackendZoneSize is the upstream zone size for the invalid backend upstream.
 	invalidBackendZoneSize = "32k"
+	// defaultLoadBalancingMethod is the load balancing method of the upstreams.
+	defaultLoadBalancingMethod = "random two least_conn"
+	// backupLoadBalancingMethod is the load balancing method of the upstreams with backup servers,
+	// because the random method doesn't support backup servers.
+	backupLoadBalancingMethod = "least_conn"
 )
 
 func (g GeneratorImpl) executeUpstreams(conf dataplane.Configuration) []executeResult {
@@ -56,8 +61,9 @@ func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
 
 	if len(up.Endpoints) == 0 {
 		return http.Upstream{
-			Name:     up.Name,
-			ZoneSize: zoneSize,
+			Name:                up.Name,
+			ZoneSize:            zoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -66,24 +72,45 @@ func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
 		}
 	}
 
+	lbMethod := defaultLoadBalancingMethod
+
 	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
-		upstreamServers[idx] = http.UpstreamServer{
+		server := http.UpstreamServer{
 			Address: fmt.Sprintf("%s:%d", ep.Address, ep.Port),
+			Resolve: ep.Resolve,
+			Backup:  ep.Backup,
+		}
+
+		// the drain parameter is only supported by NGINX Plus, so NGINX open source uses backup servers instead.
+		if ep.Drain {
+			if g.plus {
+				server.Drain = true
+			} else {
+				server.Backup = true
+			}
+		}
+
+		upstreamServers[idx] = server
+
+		if server.Backup {
+			lbMethod = backupLoadBalancingMethod
 		}
 	}
 
 	return http.Upstream{
-		Name:     up.Name,
-		ZoneSize: zoneSize,
-		Servers:  upstreamServers,
+		Name:                up.Name,
+		ZoneSize:            zoneSize,
+		LoadBalancingMethod: lbMethod,
+		Servers:             upstreamServers,
 	}
 }
 
 func createInvalidBackendRefUpstream() http.Upstream {
 	return http.Upstream{
-		Name:     invalidBackendRef,
-		ZoneSize: invalidBackendZoneSize,
+		Name:                invalidBackendRef,
+		ZoneSize:            invalidBackendZoneSize,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
 		Servers: []http.UpstreamServer{
 			{
 				Address: nginx500Server,
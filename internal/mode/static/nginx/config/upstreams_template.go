@@ -7,10 +7,10 @@ package config
 const upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
+    {{ $u.LoadBalancingMethod }};
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ range $server := $u.Servers }}
-    server {{ $server.Address }};
+    server {{ $server.Address }}{{ if $server.Resolve }} resolve{{ end }}{{ if $server.Backup }} backup{{ end }}{{ if $server.Drain }} drain{{ end }};
     {{- end }}
 }
 {{ end -}}
@@ -35,15 +35,44 @@ func TestExecuteUpstreams(t *testing.T) {
 			Name:      "up3",
 			Endpoints: []resolver.Endpoint{},
 		},
+		{
+			Name: "up4",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "api.example.com",
+					Port:    443,
+					Resolve: true,
+				},
+			},
+		},
+		{
+			Name: "up5",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "12.0.0.0",
+					Port:    80,
+				},
+				{
+					Address: "12.0.0.1",
+					Port:    80,
+					Backup:  true,
+				},
+			},
+		},
 	}
 
 	expectedSubStrings := []string{
 		"upstream up1",
 		"upstream up2",
 		"upstream up3",
+		"upstream up4",
 		"upstream invalid-backend-ref",
 		"server 10.0.0.0:80;",
 		"server 11.0.0.0:80;",
+		"server api.example.com:443 resolve;",
+		"server 12.0.0.1:80 backup;",
+		"upstream up1 {\n    random two least_conn;",
+		"upstream up5 {\n    least_conn;",
 		"server unix:/var/lib/nginx/nginx-502-server.sock;",
 	}
 
@@ -95,8 +124,9 @@ func TestCreateUpstreams(t *testing.T) {
 
 	expUpstreams := []http.Upstream{
 		{
-			Name:     "up1",
-			ZoneSize: ossZoneSize,
+			Name:                "up1",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -110,8 +140,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up2",
-			ZoneSize: ossZoneSize,
+			Name:                "up2",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -119,8 +150,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up3",
-			ZoneSize: ossZoneSize,
+			Name:                "up3",
+			ZoneSize:            ossZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -128,8 +160,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     invalidBackendRef,
-			ZoneSize: invalidBackendZoneSize,
+			Name:                invalidBackendRef,
+			ZoneSize:            invalidBackendZoneSize,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx500Server,
@@ -156,8 +189,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: nil,
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "nil-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "nil-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -172,8 +206,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: []resolver.Endpoint{},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "no-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "no-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -201,8 +236,9 @@ func TestCreateUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "multiple-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:                "multiple-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: defaultLoadBalancingMethod,
 				Servers: []http.UpstreamServer{
 					{
 						Address: "10.0.0.1:80",
@@ -217,6 +253,68 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			msg: "multiple endpoints",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "backup-endpoints",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+					{
+						Address: "10.0.0.2",
+						Port:    80,
+						Backup:  true,
+					},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:                "backup-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: backupLoadBalancingMethod,
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+					{
+						Address: "10.0.0.2:80",
+						Backup:  true,
+					},
+				},
+			},
+			msg: "backup endpoints",
+		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "drain-endpoints",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+					{
+						Address: "10.0.0.2",
+						Port:    80,
+						Drain:   true,
+					},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:                "drain-endpoints",
+				ZoneSize:            ossZoneSize,
+				LoadBalancingMethod: backupLoadBalancingMethod,
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+					{
+						Address: "10.0.0.2:80",
+						Backup:  true,
+					},
+				},
+			},
+			msg: "drain endpoints are backup servers",
+		},
 	}
 
 	for _, test := range tests {
@@ -238,15 +336,25 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				Address: "10.0.0.1",
 				Port:    80,
 			},
+			{
+				Address: "10.0.0.2",
+				Port:    80,
+				Drain:   true,
+			},
 		},
 	}
 	expectedUpstream := http.Upstream{
-		Name:     "multiple-endpoints",
-		ZoneSize: plusZoneSize,
+		Name:                "multiple-endpoints",
+		ZoneSize:            plusZoneSize,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
 		Servers: []http.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
 			},
+			{
+				Address: "10.0.0.2:80",
+				Drain:   true,
+			},
 		},
 	}
 
@@ -59,6 +59,28 @@ func (GenericValidator) ValidateNginxDuration(duration string) error {
 	return nil
 }
 
+const (
+	sizeStringFmt    = `\d{1,4}(k|m|g)?`
+	sizeStringErrMsg = "must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed"
+)
+
+var sizeStringFmtRegexp = regexp.MustCompile("^" + sizeStringFmt + "$")
+
+// ValidateNginxSize validates a size string that nginx can understand.
+func (GenericValidator) ValidateNginxSize(size string) error {
+	if !sizeStringFmtRegexp.MatchString(size) {
+		examples := []string{
+			"1024",
+			"8k",
+			"1m",
+		}
+
+		return errors.New(k8svalidation.RegexError(sizeStringErrMsg, sizeStringFmt, examples...))
+	}
+
+	return nil
+}
+
 const (
 	//nolint:lll
 	endpointStringFmt    = `(?:http?:\/\/)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*(?::\d{1,5})?`
@@ -81,3 +103,112 @@ func (GenericValidator) ValidateEndpoint(endpoint string) error {
 
 	return nil
 }
+
+const (
+	mimeTypeStringFmt    = `\*|[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*/[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*`
+	mimeTypeStringErrMsg = "must be '*' or a media type of the form 'type/subtype'"
+)
+
+var mimeTypeStringFmtRegexp = regexp.MustCompile("^(" + mimeTypeStringFmt + ")$")
+
+// ValidateMIMEType validates a MIME type that nginx can understand.
+func (GenericValidator) ValidateMIMEType(mimeType string) error {
+	if !mimeTypeStringFmtRegexp.MatchString(mimeType) {
+		examples := []string{
+			"*",
+			"application/json",
+			"image/svg+xml",
+		}
+
+		return errors.New(k8svalidation.RegexError(mimeTypeStringErrMsg, mimeTypeStringFmt, examples...))
+	}
+
+	return nil
+}
+
+const (
+	//nolint:lll
+	resolverAddressStringFmt    = `(?:\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*)(?::\d{1,5})?`
+	resolverAddressStringErrMsg = "must be an IPv4 address, an IPv6 address in square brackets, or a hostname, " +
+		"with an optional port"
+)
+
+var resolverAddressStringFmtRegexp = regexp.MustCompile("^" + resolverAddressStringFmt + "$")
+
+// ValidateResolverAddress validates the address of a DNS server for the nginx resolver directive.
+func (GenericValidator) ValidateResolverAddress(address string) error {
+	if !resolverAddressStringFmtRegexp.MatchString(address) {
+		examples := []string{
+			"10.96.0.10",
+			"[fd00::10]:53",
+			"kube-dns.kube-system.svc.cluster.local:53",
+		}
+
+		return errors.New(k8svalidation.RegexError(resolverAddressStringErrMsg, resolverAddressStringFmt, examples...))
+	}
+
+	return nil
+}
+
+// ValidateSnippet performs a basic syntax check of an NGINX configuration snippet: quotes must be closed,
+// braces must be balanced, and every directive must be terminated with ';' or a block.
+// It doesn't check whether the directives exist or are allowed in the context of the snippet.
+func (GenericValidator) ValidateSnippet(snippet string) error {
+	var (
+		quote        rune
+		escaped      bool
+		comment      bool
+		depth        int
+		lastTokenEnd rune
+	)
+
+	for _, c := range snippet {
+		switch {
+		case comment:
+			if c == '\n' {
+				comment = false
+			}
+		case escaped:
+			escaped = false
+			lastTokenEnd = c
+		case c == '\\':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			lastTokenEnd = c
+		case c == '"' || c == '\'':
+			quote = c
+			lastTokenEnd = c
+		case c == '#':
+			comment = true
+		case c == '{':
+			depth++
+			lastTokenEnd = c
+		case c == '}':
+			depth--
+			if depth < 0 {
+				return errors.New("unexpected '}'")
+			}
+			lastTokenEnd = c
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		default:
+			lastTokenEnd = c
+		}
+	}
+
+	if quote != 0 || escaped {
+		return errors.New("unterminated quoted string")
+	}
+
+	if depth > 0 {
+		return errors.New("unexpected end of snippet, expecting '}'")
+	}
+
+	if lastTokenEnd != ';' && lastTokenEnd != '}' {
+		return errors.New("unexpected end of snippet, expecting ';' or '}'")
+	}
+
+	return nil
+}
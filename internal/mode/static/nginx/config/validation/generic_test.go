@@ -64,6 +64,28 @@ func TestValidateNginxDuration(t *testing.T) {
 	)
 }
 
+func TestValidateNginxSize(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxSize,
+		`1024`,
+		`8k`,
+		`1m`,
+		`2g`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxSize,
+		`test`,
+		`12345`,
+		`5kb`,
+		`-1`,
+	)
+}
+
 func TestValidateEndpoint(t *testing.T) {
 	validator := GenericValidator{}
 
@@ -84,3 +106,79 @@ func TestValidateEndpoint(t *testing.T) {
 		`my$endpoint`,
 	)
 }
+
+func TestValidateResolverAddress(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateResolverAddress,
+		`10.96.0.10`,
+		`10.96.0.10:53`,
+		`[fd00::10]`,
+		`[fd00::10]:53`,
+		`kube-dns.kube-system.svc.cluster.local`,
+		`Resolver-1:5353`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateResolverAddress,
+		`fd00::10`,
+		`udp://10.96.0.10`,
+		`my_resolver`,
+		`10.96.0.10 valid=10s`,
+		`resolver;`,
+	)
+}
+
+func TestValidateMIMEType(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateMIMEType,
+		`*`,
+		`application/json`,
+		`image/svg+xml`,
+		`application/vnd.api+json`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateMIMEType,
+		``,
+		`application`,
+		`application/*`,
+		`text/html;`,
+		`text/$html`,
+		`text/html application/json`,
+	)
+}
+
+func TestValidateSnippet(t *testing.T) {
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateSnippet,
+		`worker_priority 0;`,
+		`add_header X-Snippet "value; with } chars";`,
+		"limit_req_zone $binary_remote_addr zone=one:10m rate=1r/s; # rate limiting",
+		`location /healthz { return 200 'ok'; }`,
+		"if ($http_x_test) {\n\treturn 404;\n}\n",
+		`add_header X-Escaped \";`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateSnippet,
+		``,
+		`# only a comment`,
+		`worker_priority 0`,
+		`add_header X-Snippet "value;`,
+		`location /healthz { return 200;`,
+		`return 200; }`,
+		`add_header X-Escaped \`,
+	)
+}
@@ -0,0 +1,159 @@
+const UPSTREAM_KEY = 'ngf_upstream';
+const DICT_NAME = 'ngf_upstreams';
+const HTTP_CODES = {
+	ok: 200,
+	noContent: 204,
+	badRequest: 400,
+	methodNotAllowed: 405,
+	internalServerError: 500,
+};
+
+// server returns the address of a server of the upstream in the ngf_upstream variable.
+// If the servers of the upstream are not stored in the shared dictionary, it returns the name of the upstream,
+// so that NGINX uses the servers of the upstream in its configuration.
+function server(r) {
+	const name = r.variables[UPSTREAM_KEY];
+	if (!name) {
+		r.error(`cannot choose a server; the ${UPSTREAM_KEY} variable is not defined`);
+		return '';
+	}
+
+	const dict = ngx.shared[DICT_NAME];
+	const value = dict ? dict.get(name) : undefined;
+	if (!value) {
+		return name;
+	}
+
+	let upstream;
+	try {
+		upstream = JSON.parse(value);
+	} catch (e) {
+		r.error(`cannot choose a server for upstream ${name}: ${e}`);
+		return name;
+	}
+
+	return chooseServer(upstream) || name;
+}
+
+// chooseServer returns a random server of the upstream. Backup servers are only chosen if the upstream has no other
+// servers. It returns undefined if the upstream has no servers.
+function chooseServer(upstream) {
+	let servers = upstream.servers;
+	if (!Array.isArray(servers) || servers.length === 0) {
+		servers = upstream.backup;
+	}
+
+	if (!Array.isArray(servers) || servers.length === 0) {
+		return undefined;
+	}
+
+	return servers[Math.floor(Math.random() * servers.length)];
+}
+
+// api is a location handler for managing the servers of the upstreams in the shared dictionary.
+// GET returns the upstreams, PUT replaces the upstreams with the upstreams in the request body, and DELETE removes
+// all upstreams.
+function api(r) {
+	const dict = ngx.shared[DICT_NAME];
+	if (!dict) {
+		r.error(`the ${DICT_NAME} shared dictionary is not configured`);
+		r.return(HTTP_CODES.internalServerError);
+		return;
+	}
+
+	switch (r.method) {
+		case 'GET':
+			r.headersOut['Content-Type'] = 'application/json';
+			r.return(HTTP_CODES.ok, JSON.stringify(getUpstreams(dict)));
+			return;
+		case 'PUT':
+			putUpstreams(r, dict);
+			return;
+		case 'DELETE':
+			dict.clear();
+			r.return(HTTP_CODES.noContent);
+			return;
+		default:
+			r.return(HTTP_CODES.methodNotAllowed);
+	}
+}
+
+function putUpstreams(r, dict) {
+	let upstreams;
+	try {
+		upstreams = parseUpstreams(r.requestText);
+	} catch (e) {
+		r.return(HTTP_CODES.badRequest, e.message);
+		return;
+	}
+
+	replaceUpstreams(dict, upstreams);
+	r.return(HTTP_CODES.noContent);
+}
+
+function getUpstreams(dict) {
+	const upstreams = {};
+	for (const name of dict.keys()) {
+		upstreams[name] = JSON.parse(dict.get(name));
+	}
+
+	return upstreams;
+}
+
+function parseUpstreams(body) {
+	let upstreams;
+	try {
+		upstreams = JSON.parse(body);
+	} catch (e) {
+		throw Error(`cannot parse the upstreams: ${e}`);
+	}
+
+	if (upstreams === null || typeof upstreams !== 'object' || Array.isArray(upstreams)) {
+		throw Error(`expected an object of upstreams, got ${body}`);
+	}
+
+	for (const name in upstreams) {
+		const upstream = upstreams[name];
+		if (upstream === null || typeof upstream !== 'object') {
+			throw Error(`expected an upstream object for upstream ${name}`);
+		}
+		if (!isListOfStrings(upstream.servers) || !isListOfStrings(upstream.backup)) {
+			throw Error(`expected lists of servers for upstream ${name}`);
+		}
+	}
+
+	return upstreams;
+}
+
+function isListOfStrings(value) {
+	if (value === undefined) {
+		return true;
+	}
+
+	return Array.isArray(value) && value.every((v) => typeof v === 'string');
+}
+
+// replaceUpstreams stores the new upstreams before it removes the upstreams that no longer exist, so that requests
+// never fall back to the servers in the NGINX configuration while the upstreams are replaced.
+function replaceUpstreams(dict, upstreams) {
+	for (const name in upstreams) {
+		dict.set(name, JSON.stringify(upstreams[name]));
+	}
+
+	for (const name of dict.keys()) {
+		if (!(name in upstreams)) {
+			dict.delete(name);
+		}
+	}
+}
+
+export default {
+	server,
+	chooseServer,
+	api,
+	parseUpstreams,
+	replaceUpstreams,
+	UPSTREAM_KEY,
+	DICT_NAME,
+	HTTP_CODES,
+};
@@ -0,0 +1,257 @@
+import { default as ups } from '../src/upstreams.js';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+
+// Creates a shared dictionary for testing.
+// See documentation for all methods available: http://nginx.org/en/docs/njs/reference.html#ngx_shared
+function createDict(entries = {}) {
+	const store = new Map(Object.entries(entries));
+	return {
+		get(key) {
+			return store.get(key);
+		},
+		set(key, value) {
+			store.set(key, value);
+		},
+		delete(key) {
+			store.delete(key);
+		},
+		clear() {
+			store.clear();
+		},
+		keys() {
+			return Array.from(store.keys());
+		},
+	};
+}
+
+// Creates a NGINX HTTP Request Object for testing.
+// See documentation for all properties available: http://nginx.org/en/docs/njs/reference.html
+function createRequest({ method = '', upstream = '', body = '' } = {}) {
+	let r = {
+		// Test mocks
+		return(statusCode, body) {
+			r.testReturned = statusCode;
+			r.testBody = body;
+		},
+		error(msg) {
+			console.log('\tngx_error:', msg);
+		},
+		variables: {},
+		headersOut: {},
+		method: method,
+		requestText: body,
+	};
+
+	if (upstream) {
+		r.variables[ups.UPSTREAM_KEY] = upstream;
+	}
+
+	return r;
+}
+
+describe('server', () => {
+	beforeEach(() => {
+		globalThis.ngx = {
+			shared: {
+				[ups.DICT_NAME]: createDict({
+					up1: JSON.stringify({ servers: ['10.0.0.1:80'] }),
+					up2: JSON.stringify({ servers: [], backup: ['10.0.0.2:80'] }),
+					up3: JSON.stringify({ servers: [] }),
+					up4: 'invalid',
+				}),
+			},
+		};
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	const tests = [
+		{
+			name: 'returns a server of the upstream',
+			upstream: 'up1',
+			expected: '10.0.0.1:80',
+		},
+		{
+			name: 'returns a backup server if the upstream has no other servers',
+			upstream: 'up2',
+			expected: '10.0.0.2:80',
+		},
+		{
+			name: 'returns the upstream name if the upstream has no servers',
+			upstream: 'up3',
+			expected: 'up3',
+		},
+		{
+			name: 'returns the upstream name if the upstream is invalid',
+			upstream: 'up4',
+			expected: 'up4',
+		},
+		{
+			name: 'returns the upstream name if the upstream does not exist',
+			upstream: 'up5',
+			expected: 'up5',
+		},
+		{
+			name: 'returns an empty string if the upstream variable is not defined',
+			upstream: '',
+			expected: '',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(ups.server(createRequest({ upstream: test.upstream }))).to.equal(test.expected);
+		});
+	});
+
+	it('returns the upstream name if the shared dictionary is not configured', () => {
+		globalThis.ngx = { shared: {} };
+		expect(ups.server(createRequest({ upstream: 'up1' }))).to.equal('up1');
+	});
+});
+
+describe('chooseServer', () => {
+	const tests = [
+		{
+			name: 'chooses one of the servers',
+			upstream: { servers: ['10.0.0.1:80', '10.0.0.2:80'], backup: ['10.0.0.3:80'] },
+			expected: ['10.0.0.1:80', '10.0.0.2:80'],
+		},
+		{
+			name: 'chooses one of the backup servers',
+			upstream: { servers: [], backup: ['10.0.0.3:80', '10.0.0.4:80'] },
+			expected: ['10.0.0.3:80', '10.0.0.4:80'],
+		},
+		{
+			name: 'returns undefined if there are no servers',
+			upstream: {},
+			expected: [undefined],
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			for (let i = 0; i < 10; i++) {
+				expect(test.expected).to.include(ups.chooseServer(test.upstream));
+			}
+		});
+	});
+});
+
+describe('parseUpstreams', () => {
+	const tests = [
+		{
+			name: 'parses upstreams',
+			body: '{"up1":{"servers":["10.0.0.1:80"],"backup":["10.0.0.2:80"]},"up2":{}}',
+			expected: { up1: { servers: ['10.0.0.1:80'], backup: ['10.0.0.2:80'] }, up2: {} },
+		},
+		{
+			name: 'throws if the body is not JSON',
+			body: 'invalid',
+			expectThrow: true,
+			errSubstring: 'cannot parse the upstreams',
+		},
+		{
+			name: 'throws if the body is not an object',
+			body: '["10.0.0.1:80"]',
+			expectThrow: true,
+			errSubstring: 'expected an object of upstreams',
+		},
+		{
+			name: 'throws if an upstream is not an object',
+			body: '{"up1":"10.0.0.1:80"}',
+			expectThrow: true,
+			errSubstring: 'expected an upstream object for upstream up1',
+		},
+		{
+			name: 'throws if the servers are not a list of strings',
+			body: '{"up1":{"servers":[80]}}',
+			expectThrow: true,
+			errSubstring: 'expected lists of servers for upstream up1',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			if (test.expectThrow) {
+				expect(() => ups.parseUpstreams(test.body)).to.throw(test.errSubstring);
+			} else {
+				expect(ups.parseUpstreams(test.body)).to.deep.equal(test.expected);
+			}
+		});
+	});
+});
+
+describe('replaceUpstreams', () => {
+	it('replaces the upstreams', () => {
+		const dict = createDict({
+			up1: JSON.stringify({ servers: ['10.0.0.1:80'] }),
+			up2: JSON.stringify({ servers: ['10.0.0.2:80'] }),
+		});
+
+		ups.replaceUpstreams(dict, {
+			up1: { servers: ['10.0.0.3:80'] },
+			up3: { servers: ['10.0.0.4:80'] },
+		});
+
+		expect(dict.keys()).to.have.members(['up1', 'up3']);
+		expect(JSON.parse(dict.get('up1'))).to.deep.equal({ servers: ['10.0.0.3:80'] });
+		expect(JSON.parse(dict.get('up3'))).to.deep.equal({ servers: ['10.0.0.4:80'] });
+	});
+});
+
+describe('api', () => {
+	let dict;
+
+	beforeEach(() => {
+		dict = createDict({ up1: JSON.stringify({ servers: ['10.0.0.1:80'] }) });
+		globalThis.ngx = { shared: { [ups.DICT_NAME]: dict } };
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('returns the upstreams', () => {
+		const r = createRequest({ method: 'GET' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.ok);
+		expect(JSON.parse(r.testBody)).to.deep.equal({ up1: { servers: ['10.0.0.1:80'] } });
+	});
+
+	it('replaces the upstreams', () => {
+		const r = createRequest({ method: 'PUT', body: '{"up2":{"servers":["10.0.0.2:80"]}}' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.noContent);
+		expect(dict.keys()).to.have.members(['up2']);
+	});
+
+	it('rejects invalid upstreams', () => {
+		const r = createRequest({ method: 'PUT', body: 'invalid' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.badRequest);
+		expect(dict.keys()).to.have.members(['up1']);
+	});
+
+	it('removes the upstreams', () => {
+		const r = createRequest({ method: 'DELETE' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.noContent);
+		expect(dict.keys()).to.be.empty;
+	});
+
+	it('rejects other methods', () => {
+		const r = createRequest({ method: 'POST' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.methodNotAllowed);
+	});
+
+	it('returns an error if the shared dictionary is not configured', () => {
+		globalThis.ngx = { shared: {} };
+		const r = createRequest({ method: 'GET' });
+		ups.api(r);
+		expect(r.testReturned).to.equal(ups.HTTP_CODES.internalServerError);
+	});
+});
@@ -0,0 +1,85 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	dynamicUpstreamsSock    = "/var/run/nginx/nginx-upstreams.sock"
+	dynamicUpstreamsURI     = "http://nginx-upstreams/upstreams"
+	dynamicUpstreamsTimeout = 10 * time.Second
+)
+
+// DynamicUpstream holds the servers of an upstream that NGINX open source chooses from shared memory.
+type DynamicUpstream struct {
+	// Servers are the addresses of the servers.
+	Servers []string `json:"servers"`
+	// Backup are the addresses of the backup servers. They are only used if the upstream has no other servers.
+	Backup []string `json:"backup,omitempty"`
+}
+
+// dynamicUpstreamsClient is a client for the NJS API that manages the dynamic upstreams in the shared memory of NGINX.
+type dynamicUpstreamsClient struct {
+	client  *http.Client
+	uri     string
+	timeout time.Duration
+}
+
+// newDynamicUpstreamsClient returns a new client pointed at the dynamic upstreams socket.
+func newDynamicUpstreamsClient() *dynamicUpstreamsClient {
+	client := GetSocketClient(dynamicUpstreamsSock)
+
+	return &dynamicUpstreamsClient{
+		client:  &client,
+		uri:     dynamicUpstreamsURI,
+		timeout: dynamicUpstreamsTimeout,
+	}
+}
+
+// update replaces the dynamic upstreams in NGINX with the given upstreams.
+func (c *dynamicUpstreamsClient) update(ctx context.Context, upstreams map[string]DynamicUpstream) error {
+	body, err := json.Marshal(upstreams)
+	if err != nil {
+		return fmt.Errorf("error marshaling the upstreams: %w", err)
+	}
+
+	return c.do(ctx, http.MethodPut, body)
+}
+
+// reset removes all dynamic upstreams from NGINX.
+func (c *dynamicUpstreamsClient) reset(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, nil)
+}
+
+func (c *dynamicUpstreamsClient) do(ctx context.Context, method string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, c.uri, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected response: %v %s", resp.StatusCode, msg)
+	}
+
+	return nil
+}
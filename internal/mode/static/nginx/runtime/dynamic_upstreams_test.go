@@ -0,0 +1,61 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDynamicUpstreamsClient(t *testing.T) {
+	var (
+		method string
+		body   []byte
+		status int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	c := &dynamicUpstreamsClient{
+		client:  server.Client(),
+		uri:     server.URL + "/upstreams",
+		timeout: time.Second,
+	}
+
+	upstreams := map[string]DynamicUpstream{
+		"up1": {
+			Servers: []string{"10.0.0.1:80"},
+			Backup:  []string{"10.0.0.2:80"},
+		},
+	}
+
+	g := NewWithT(t)
+
+	status = http.StatusNoContent
+	g.Expect(c.update(context.Background(), upstreams)).To(Succeed())
+	g.Expect(method).To(Equal(http.MethodPut))
+
+	var sent map[string]DynamicUpstream
+	g.Expect(json.Unmarshal(body, &sent)).To(Succeed())
+	g.Expect(sent).To(Equal(upstreams))
+
+	g.Expect(c.reset(context.Background())).To(Succeed())
+	g.Expect(method).To(Equal(http.MethodDelete))
+
+	status = http.StatusBadRequest
+	g.Expect(c.update(context.Background(), upstreams)).To(MatchError(ContainSubstring("400")))
+	g.Expect(c.reset(context.Background())).To(MatchError(ContainSubstring("400")))
+
+	server.Close()
+	g.Expect(c.update(context.Background(), upstreams)).ToNot(Succeed())
+}
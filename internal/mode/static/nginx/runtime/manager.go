@@ -43,6 +43,13 @@ type Manager interface {
 	// GetUpstreams uses the NGINX Plus API to get the upstreams.
 	// Only usable if running NGINX Plus.
 	GetUpstreams() (ngxclient.Upstreams, error)
+	// UpdateDynamicUpstreams replaces the servers of the dynamic upstreams in the shared memory of NGINX.
+	// Only usable if running NGINX open source with dynamic upstreams.
+	UpdateDynamicUpstreams(ctx context.Context, upstreams map[string]DynamicUpstream) error
+	// ResetDynamicUpstreams removes the servers of the dynamic upstreams from the shared memory of NGINX,
+	// so that NGINX uses the servers of the upstreams in its configuration.
+	// Only usable if running NGINX open source with dynamic upstreams.
+	ResetDynamicUpstreams(ctx context.Context) error
 }
 
 // MetricsCollector is an interface for the metrics of the NGINX runtime manager.
@@ -50,14 +57,17 @@ type MetricsCollector interface {
 	IncReloadCount()
 	IncReloadErrors()
 	ObserveLastReloadTime(ms time.Duration)
+	IncLiveEndpointUpdateCount()
+	IncLiveEndpointUpdateErrors()
 }
 
 // ManagerImpl implements Manager.
 type ManagerImpl struct {
-	verifyClient     *verifyClient
-	metricsCollector MetricsCollector
-	ngxPlusClient    *ngxclient.NginxClient
-	logger           logr.Logger
+	verifyClient           *verifyClient
+	dynamicUpstreamsClient *dynamicUpstreamsClient
+	metricsCollector       MetricsCollector
+	ngxPlusClient          *ngxclient.NginxClient
+	logger                 logr.Logger
 }
 
 // NewManagerImpl creates a new ManagerImpl.
@@ -67,10 +77,11 @@ func NewManagerImpl(
 	logger logr.Logger,
 ) *ManagerImpl {
 	return &ManagerImpl{
-		verifyClient:     newVerifyClient(nginxReloadTimeout),
-		metricsCollector: collector,
-		ngxPlusClient:    ngxPlusClient,
-		logger:           logger,
+		verifyClient:           newVerifyClient(nginxReloadTimeout),
+		dynamicUpstreamsClient: newDynamicUpstreamsClient(),
+		metricsCollector:       collector,
+		ngxPlusClient:          ngxPlusClient,
+		logger:                 logger,
 	}
 }
 
@@ -129,7 +140,13 @@ func (m *ManagerImpl) UpdateHTTPServers(upstream string, servers []ngxclient.Ups
 	m.logger.V(1).Info("Deleted upstream servers", "count", len(deleted))
 	m.logger.V(1).Info("Updated upstream servers", "count", len(updated))
 
-	return err
+	if err != nil {
+		m.metricsCollector.IncLiveEndpointUpdateErrors()
+		return err
+	}
+	m.metricsCollector.IncLiveEndpointUpdateCount()
+
+	return nil
 }
 
 // GetUpstreams uses the NGINX Plus API to get the upstreams.
@@ -151,6 +168,39 @@ func (m *ManagerImpl) GetUpstreams() (ngxclient.Upstreams, error) {
 	return *upstreams, nil
 }
 
+// UpdateDynamicUpstreams replaces the servers of the dynamic upstreams in the shared memory of NGINX.
+// Only usable if running NGINX open source with dynamic upstreams.
+func (m *ManagerImpl) UpdateDynamicUpstreams(ctx context.Context, upstreams map[string]DynamicUpstream) error {
+	if m.IsPlus() {
+		panic("cannot update dynamic upstreams: NGINX Plus enabled")
+	}
+
+	if err := m.dynamicUpstreamsClient.update(ctx, upstreams); err != nil {
+		m.metricsCollector.IncLiveEndpointUpdateErrors()
+		return fmt.Errorf("failed to update dynamic upstreams: %w", err)
+	}
+	m.metricsCollector.IncLiveEndpointUpdateCount()
+
+	m.logger.V(1).Info("Updated dynamic upstreams", "count", len(upstreams))
+
+	return nil
+}
+
+// ResetDynamicUpstreams removes the servers of the dynamic upstreams from the shared memory of NGINX,
+// so that NGINX uses the servers of the upstreams in its configuration.
+// Only usable if running NGINX open source with dynamic upstreams.
+func (m *ManagerImpl) ResetDynamicUpstreams(ctx context.Context) error {
+	if m.IsPlus() {
+		panic("cannot reset dynamic upstreams: NGINX Plus enabled")
+	}
+
+	if err := m.dynamicUpstreamsClient.reset(ctx); err != nil {
+		return fmt.Errorf("failed to reset dynamic upstreams: %w", err)
+	}
+
+	return nil
+}
+
 // EnsureNginxRunning ensures NGINX is running by locating the main process.
 func EnsureNginxRunning(ctx context.Context) error {
 	if _, err := findMainProcess(ctx, os.Stat, os.ReadFile, pidFileTimeout); err != nil {
@@ -44,6 +44,29 @@ type FakeManager struct {
 	reloadReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResetDynamicUpstreamsStub        func(context.Context) error
+	resetDynamicUpstreamsMutex       sync.RWMutex
+	resetDynamicUpstreamsArgsForCall []struct {
+		arg1 context.Context
+	}
+	resetDynamicUpstreamsReturns struct {
+		result1 error
+	}
+	resetDynamicUpstreamsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateDynamicUpstreamsStub        func(context.Context, map[string]runtime.DynamicUpstream) error
+	updateDynamicUpstreamsMutex       sync.RWMutex
+	updateDynamicUpstreamsArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]runtime.DynamicUpstream
+	}
+	updateDynamicUpstreamsReturns struct {
+		result1 error
+	}
+	updateDynamicUpstreamsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateHTTPServersStub        func(string, []client.UpstreamServer) error
 	updateHTTPServersMutex       sync.RWMutex
 	updateHTTPServersArgsForCall []struct {
@@ -231,6 +254,129 @@ func (fake *FakeManager) ReloadReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) ResetDynamicUpstreams(arg1 context.Context) error {
+	fake.resetDynamicUpstreamsMutex.Lock()
+	ret, specificReturn := fake.resetDynamicUpstreamsReturnsOnCall[len(fake.resetDynamicUpstreamsArgsForCall)]
+	fake.resetDynamicUpstreamsArgsForCall = append(fake.resetDynamicUpstreamsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ResetDynamicUpstreamsStub
+	fakeReturns := fake.resetDynamicUpstreamsReturns
+	fake.recordInvocation("ResetDynamicUpstreams", []interface{}{arg1})
+	fake.resetDynamicUpstreamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) ResetDynamicUpstreamsCallCount() int {
+	fake.resetDynamicUpstreamsMutex.RLock()
+	defer fake.resetDynamicUpstreamsMutex.RUnlock()
+	return len(fake.resetDynamicUpstreamsArgsForCall)
+}
+
+func (fake *FakeManager) ResetDynamicUpstreamsCalls(stub func(context.Context) error) {
+	fake.resetDynamicUpstreamsMutex.Lock()
+	defer fake.resetDynamicUpstreamsMutex.Unlock()
+	fake.ResetDynamicUpstreamsStub = stub
+}
+
+func (fake *FakeManager) ResetDynamicUpstreamsArgsForCall(i int) context.Context {
+	fake.resetDynamicUpstreamsMutex.RLock()
+	defer fake.resetDynamicUpstreamsMutex.RUnlock()
+	argsForCall := fake.resetDynamicUpstreamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) ResetDynamicUpstreamsReturns(result1 error) {
+	fake.resetDynamicUpstreamsMutex.Lock()
+	defer fake.resetDynamicUpstreamsMutex.Unlock()
+	fake.ResetDynamicUpstreamsStub = nil
+	fake.resetDynamicUpstreamsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ResetDynamicUpstreamsReturnsOnCall(i int, result1 error) {
+	fake.resetDynamicUpstreamsMutex.Lock()
+	defer fake.resetDynamicUpstreamsMutex.Unlock()
+	fake.ResetDynamicUpstreamsStub = nil
+	if fake.resetDynamicUpstreamsReturnsOnCall == nil {
+		fake.resetDynamicUpstreamsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetDynamicUpstreamsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreams(arg1 context.Context, arg2 map[string]runtime.DynamicUpstream) error {
+	fake.updateDynamicUpstreamsMutex.Lock()
+	ret, specificReturn := fake.updateDynamicUpstreamsReturnsOnCall[len(fake.updateDynamicUpstreamsArgsForCall)]
+	fake.updateDynamicUpstreamsArgsForCall = append(fake.updateDynamicUpstreamsArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]runtime.DynamicUpstream
+	}{arg1, arg2})
+	stub := fake.UpdateDynamicUpstreamsStub
+	fakeReturns := fake.updateDynamicUpstreamsReturns
+	fake.recordInvocation("UpdateDynamicUpstreams", []interface{}{arg1, arg2})
+	fake.updateDynamicUpstreamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreamsCallCount() int {
+	fake.updateDynamicUpstreamsMutex.RLock()
+	defer fake.updateDynamicUpstreamsMutex.RUnlock()
+	return len(fake.updateDynamicUpstreamsArgsForCall)
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreamsCalls(stub func(context.Context, map[string]runtime.DynamicUpstream) error) {
+	fake.updateDynamicUpstreamsMutex.Lock()
+	defer fake.updateDynamicUpstreamsMutex.Unlock()
+	fake.UpdateDynamicUpstreamsStub = stub
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreamsArgsForCall(i int) (context.Context, map[string]runtime.DynamicUpstream) {
+	fake.updateDynamicUpstreamsMutex.RLock()
+	defer fake.updateDynamicUpstreamsMutex.RUnlock()
+	argsForCall := fake.updateDynamicUpstreamsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreamsReturns(result1 error) {
+	fake.updateDynamicUpstreamsMutex.Lock()
+	defer fake.updateDynamicUpstreamsMutex.Unlock()
+	fake.UpdateDynamicUpstreamsStub = nil
+	fake.updateDynamicUpstreamsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UpdateDynamicUpstreamsReturnsOnCall(i int, result1 error) {
+	fake.updateDynamicUpstreamsMutex.Lock()
+	defer fake.updateDynamicUpstreamsMutex.Unlock()
+	fake.UpdateDynamicUpstreamsStub = nil
+	if fake.updateDynamicUpstreamsReturnsOnCall == nil {
+		fake.updateDynamicUpstreamsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDynamicUpstreamsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) UpdateHTTPServers(arg1 string, arg2 []client.UpstreamServer) error {
 	var arg2Copy []client.UpstreamServer
 	if arg2 != nil {
@@ -307,6 +453,10 @@ func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	defer fake.isPlusMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.resetDynamicUpstreamsMutex.RLock()
+	defer fake.resetDynamicUpstreamsMutex.RUnlock()
+	fake.updateDynamicUpstreamsMutex.RLock()
+	defer fake.updateDynamicUpstreamsMutex.RUnlock()
 	fake.updateHTTPServersMutex.RLock()
 	defer fake.updateHTTPServersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,305 @@
+package sandbox
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+const (
+	// mainConfigFile is the main NGINX configuration file of the NGINX image.
+	mainConfigFile = "/etc/nginx/nginx.conf"
+	// jsModuleFile is the njs module, which the main NGINX configuration file always loads.
+	jsModuleFile = "/usr/lib/nginx/modules/ngx_http_js_module.so"
+	// loadModulesFile is the file with the load_module directives of the modules that the configuration needs.
+	loadModulesFile = "/etc/nginx/module-includes/load-modules.conf"
+
+	// validationTimeout is the time to wait for the result of nginx -t.
+	validationTimeout = 10 * time.Second
+	// pollInterval is the interval of checking for the result of nginx -t.
+	pollInterval = 100 * time.Millisecond
+	// maxCachedResults is the maximum number of cached snippet validation results.
+	maxCachedResults = 1000
+	// unavailableRetryPeriod is the time after a failure of the sandbox during which snippets are not tested,
+	// so that validating every snippet doesn't wait for the validationTimeout.
+	unavailableRetryPeriod = 30 * time.Second
+)
+
+// Validator tests NGINX configuration with nginx -t, in a sandbox: the snippets-validator container of the
+// NGINX Gateway Fabric Pod. The container runs the NGINX image and shares a directory with the control plane.
+// To test a configuration, the Validator writes it to <id>.conf in that directory. The container runs
+// nginx -t against every .conf file, writes the exit code and the output of nginx -t to <id>.result, and
+// removes the .conf file. The Validator then reads and removes the result.
+//
+// Validator is safe for concurrent use.
+type Validator struct {
+	// unavailableUntil is the time until which the sandbox is considered unavailable after unavailableErr.
+	unavailableUntil time.Time
+	unavailableErr   error
+	results          map[snippetKey]error
+	dir              string
+	timeout          time.Duration
+	pollInterval     time.Duration
+	retryPeriod      time.Duration
+	lastID           uint64
+	lock             sync.Mutex
+}
+
+// snippetKey is the SHA-256 hash of the NGINX context and the content of a snippet.
+type snippetKey [sha256.Size]byte
+
+func newSnippetKey(nginxContext, snippet string) snippetKey {
+	return sha256.Sum256([]byte(nginxContext + "\n" + snippet))
+}
+
+// NewValidator creates a new Validator that uses the given directory to exchange files with the
+// snippets-validator container.
+func NewValidator(dir string) *Validator {
+	return &Validator{
+		results:      make(map[snippetKey]error),
+		dir:          dir,
+		timeout:      validationTimeout,
+		pollInterval: pollInterval,
+		retryPeriod:  unavailableRetryPeriod,
+	}
+}
+
+// WaitUntilReady waits until the sandbox tests a minimal NGINX configuration. It returns an error if the sandbox
+// doesn't respond within the timeout, for example, because the snippets-validator container is missing.
+func (v *Validator) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output, err := v.test(ctx, v.nextID(), "events {}\n")
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		return fmt.Errorf("nginx -t failed for a minimal configuration: %s", output)
+	}
+
+	return nil
+}
+
+// ValidateSnippet validates a snippet by testing a minimal NGINX configuration that includes the snippet in its
+// NGINX context. The results are cached by the hash of the context and the snippet, so that a snippet is only
+// tested once. If the sandbox fails to test a snippet, the failure is returned for all snippets that are not cached
+// until the retry period passes, so that a missing or stuck sandbox doesn't block the caller for every snippet.
+func (v *Validator) ValidateSnippet(context, snippet string) error {
+	key := newSnippetKey(context, snippet)
+
+	v.lock.Lock()
+	err, cached := v.results[key]
+	unavailable := v.unavailableErr != nil && time.Now().Before(v.unavailableUntil)
+	unavailableErr := v.unavailableErr
+	v.lock.Unlock()
+
+	if cached {
+		return err
+	}
+
+	if unavailable {
+		return unavailableErr
+	}
+
+	err, done := v.testSnippet(context, snippet)
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if !done {
+		// the failure of the sandbox isn't a result of the snippet, so the snippet is tested again after the
+		// retry period
+		v.unavailableErr = err
+		v.unavailableUntil = time.Now().Add(v.retryPeriod)
+
+		return err
+	}
+
+	v.unavailableErr = nil
+
+	if len(v.results) >= maxCachedResults {
+		clear(v.results)
+	}
+	v.results[key] = err
+
+	return err
+}
+
+// TestConfig tests the NGINX configuration of NGINX Gateway Fabric. It returns the output of nginx -t as an
+// error, if the configuration is invalid.
+func (v *Validator) TestConfig(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	id := v.nextID()
+
+	output, err := v.test(ctx, id, fmt.Sprintf("include %s;\n", mainConfigFile))
+	if err != nil {
+		return fmt.Errorf("cannot test the NGINX configuration: %w", err)
+	}
+
+	if output != "" {
+		return errors.New(output)
+	}
+
+	return nil
+}
+
+// testSnippet tests a snippet. It returns false if the snippet could not be tested.
+func (v *Validator) testSnippet(nginxContext, snippet string) (error, bool) { //nolint:revive // error isn't a result
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+
+	id := v.nextID()
+	snippetFile := filepath.Join(v.dir, id+".snippet")
+
+	conf, err := buildSnippetConfig(nginxContext, snippetFile)
+	if err != nil {
+		return err, true
+	}
+
+	if err := os.WriteFile(snippetFile, []byte(snippet), 0o644); err != nil {
+		return fmt.Errorf("cannot validate the snippet: %w", err), false
+	}
+	defer os.Remove(snippetFile)
+
+	output, err := v.test(ctx, id, conf)
+	if err != nil {
+		return fmt.Errorf("cannot validate the snippet: %w", err), false
+	}
+
+	if output != "" {
+		// the sandbox file names mean nothing to the user
+		return errors.New(strings.ReplaceAll(output, snippetFile+":", "snippet line ")), true
+	}
+
+	return nil, true
+}
+
+// test runs nginx -t against the configuration in the sandbox. It returns the errors that nginx -t reported,
+// or an empty string if the configuration is valid.
+func (v *Validator) test(ctx context.Context, id, conf string) (string, error) {
+	confFile := filepath.Join(v.dir, id+".conf")
+	resultFile := filepath.Join(v.dir, id+".result")
+
+	// the sandbox must not see a partially written file
+	tmpFile := confFile + ".tmp"
+	if err := os.WriteFile(tmpFile, []byte(conf), 0o644); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmpFile, confFile); err != nil {
+		os.Remove(tmpFile)
+		return "", err
+	}
+
+	var result []byte
+
+	err := wait.PollUntilContextCancel(
+		ctx,
+		v.pollInterval,
+		true, /* poll immediately */
+		func(_ context.Context) (bool, error) {
+			var err error
+			result, err = os.ReadFile(resultFile)
+			if errors.Is(err, os.ErrNotExist) {
+				return false, nil
+			}
+			return err == nil, err
+		},
+	)
+	if err != nil {
+		// the sandbox didn't process the file, so we remove it ourselves
+		os.Remove(confFile)
+		return "", fmt.Errorf("no result of nginx -t from the snippets-validator container: %w", err)
+	}
+
+	os.Remove(resultFile)
+
+	return parseResult(string(result))
+}
+
+func (v *Validator) nextID() string {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.lastID++
+
+	return fmt.Sprintf("%d-%d", os.Getpid(), v.lastID)
+}
+
+// parseResult parses a result file, which has the exit code of nginx -t on the first line, followed by the output
+// of nginx -t. It returns the errors of nginx -t, if the exit code is not 0.
+func parseResult(result string) (string, error) {
+	code, output, _ := strings.Cut(result, "\n")
+
+	exitCode, err := strconv.Atoi(strings.TrimSpace(code))
+	if err != nil {
+		return "", fmt.Errorf("invalid exit code of nginx -t %q: %w", code, err)
+	}
+
+	if exitCode == 0 {
+		return "", nil
+	}
+
+	var errs []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "nginx: ")
+		// skip the summary of nginx -t
+		if line == "" || strings.HasPrefix(line, "configuration file ") {
+			continue
+		}
+		errs = append(errs, line)
+	}
+
+	if len(errs) == 0 {
+		return fmt.Sprintf("nginx -t exited with code %d", exitCode), nil
+	}
+
+	return strings.Join(errs, "; "), nil
+}
+
+// buildSnippetConfig builds a minimal NGINX configuration that includes the snippet file in its NGINX context.
+func buildSnippetConfig(nginxContext, snippetFile string) (string, error) {
+	var main, http, server, location string
+
+	include := fmt.Sprintf("include %s;", snippetFile)
+
+	switch ngfAPI.NginxContext(nginxContext) {
+	case ngfAPI.NginxContextMain:
+		main = include
+	case ngfAPI.NginxContextHTTP:
+		http = include
+	case ngfAPI.NginxContextHTTPServer:
+		server = include
+	case ngfAPI.NginxContextHTTPServerLocation:
+		location = include
+	default:
+		return "", fmt.Errorf("unsupported NGINX context %q", nginxContext)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "load_module %s;\n", jsModuleFile)
+	// the snippet may use the directives of the modules that NGINX Gateway Fabric loads
+	if _, err := os.Stat(loadModulesFile); err == nil {
+		fmt.Fprintf(&b, "include %s;\n", loadModulesFile)
+	}
+	fmt.Fprintf(&b, "%s\nevents {}\n", main)
+	fmt.Fprintf(&b, "http {\n%s\nserver {\n%s\nlocation / {\n%s\n}\n}\n}\n", http, server, location)
+
+	return b.String(), nil
+}
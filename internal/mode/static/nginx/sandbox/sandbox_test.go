@@ -0,0 +1,204 @@
+package sandbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// respond acts as the snippets-validator container: it answers every .conf file in dir with the result.
+func respond(ctx context.Context, dir string, result func(conf string) string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Millisecond):
+		}
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.conf"))
+		for _, f := range files {
+			conf, err := os.ReadFile(f)
+			if err != nil {
+				continue
+			}
+			_ = os.WriteFile(strings.TrimSuffix(f, ".conf")+".result", []byte(result(string(conf))), 0o644)
+			_ = os.Remove(f)
+		}
+	}
+}
+
+func newTestValidator(dir string) *Validator {
+	v := NewValidator(dir)
+	v.timeout = 200 * time.Millisecond
+	v.pollInterval = time.Millisecond
+
+	return v
+}
+
+func TestValidateSnippet(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var tested atomic.Int32
+	go respond(ctx, dir, func(conf string) string {
+		tested.Add(1)
+		if !strings.Contains(conf, "server {\ninclude") {
+			return "0\n"
+		}
+		snippetFile, _ := filepath.Glob(filepath.Join(dir, "*.snippet"))
+		return "1\nnginx: [emerg] unknown directive \"invalid\" in " + snippetFile[0] +
+			":1\nnginx: configuration file " + snippetFile[0] + " test failed\n"
+	})
+
+	v := newTestValidator(dir)
+
+	g.Expect(v.ValidateSnippet("main", "worker_priority 0;")).To(Succeed())
+
+	err := v.ValidateSnippet("http.server", "invalid;")
+	g.Expect(err).To(MatchError(`[emerg] unknown directive "invalid" in snippet line 1`))
+
+	// cached
+	err = v.ValidateSnippet("http.server", "invalid;")
+	g.Expect(err).To(MatchError(`[emerg] unknown directive "invalid" in snippet line 1`))
+	g.Expect(tested.Load()).To(Equal(int32(2)))
+
+	g.Expect(v.ValidateSnippet("stream", "invalid;")).To(MatchError(`unsupported NGINX context "stream"`))
+
+	files, err := os.ReadDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(BeEmpty())
+}
+
+func TestValidateSnippet_NoSandbox(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	v := newTestValidator(dir)
+
+	v.retryPeriod = time.Hour
+
+	err := v.ValidateSnippet("http", "gzip on;")
+	g.Expect(err).To(MatchError(ContainSubstring("no result of nginx -t from the snippets-validator container")))
+
+	// the failure isn't cached as the result of the snippet
+	g.Expect(v.results).To(BeEmpty())
+
+	// other snippets don't wait for the timeout during the retry period
+	start := time.Now()
+	err = v.ValidateSnippet("http", "gzip off;")
+	g.Expect(err).To(MatchError(ContainSubstring("no result of nginx -t from the snippets-validator container")))
+	g.Expect(time.Since(start)).To(BeNumerically("<", v.timeout))
+
+	files, err := os.ReadDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(files).To(BeEmpty())
+
+	// after the retry period, the snippet is tested again
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go respond(ctx, dir, func(string) string { return "0\n" })
+
+	v.retryPeriod = 0
+	v.unavailableUntil = time.Now()
+
+	g.Expect(v.ValidateSnippet("http", "gzip on;")).To(Succeed())
+	g.Expect(v.ValidateSnippet("http", "gzip off;")).To(Succeed())
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	v := newTestValidator(dir)
+
+	err := v.WaitUntilReady(context.Background(), 50*time.Millisecond)
+	g.Expect(err).To(MatchError(ContainSubstring("no result of nginx -t from the snippets-validator container")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go respond(ctx, dir, func(conf string) string {
+		if conf != "events {}\n" {
+			return "1\nunexpected configuration\n"
+		}
+		return "0\n"
+	})
+
+	g.Expect(v.WaitUntilReady(ctx, time.Second)).To(Succeed())
+}
+
+func TestTestConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go respond(ctx, dir, func(conf string) string {
+		if conf != "include /etc/nginx/nginx.conf;\n" {
+			return "1\nunexpected configuration\n"
+		}
+		return "0\n"
+	})
+
+	v := newTestValidator(dir)
+
+	g.Expect(v.TestConfig(ctx)).To(Succeed())
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    string
+		expOutput string
+		expErr    bool
+	}{
+		{
+			name:   "valid",
+			result: "0\nnginx: the configuration file /etc/nginx/nginx.conf syntax is ok\n",
+		},
+		{
+			name: "invalid",
+			result: "1\nnginx: [emerg] unknown directive \"invalid\" in /etc/nginx/conf.d/a.conf:1\n" +
+				"nginx: configuration file /etc/nginx/nginx.conf test failed\n",
+			expOutput: `[emerg] unknown directive "invalid" in /etc/nginx/conf.d/a.conf:1`,
+		},
+		{
+			name:      "invalid without output",
+			result:    "1\n",
+			expOutput: "nginx -t exited with code 1",
+		},
+		{
+			name:   "malformed",
+			result: "",
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			output, err := parseResult(test.result)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(output).To(Equal(test.expOutput))
+		})
+	}
+}
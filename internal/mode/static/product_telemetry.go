@@ -0,0 +1,136 @@
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	ngxvalidation "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
+)
+
+// CollectProductTelemetry collects the product telemetry data that NGF with the provided config would send,
+// from the current state of the cluster. Like NGF on start, it lists the resources from the Kubernetes API,
+// and builds the graph and the configuration from them. It doesn't send the data anywhere.
+// The NGINX reload counts are always zero, because only the running NGF knows them.
+func CollectProductTelemetry(
+	ctx context.Context,
+	restConfig *rest.Config,
+	cfg config.Config,
+) (telemetry.Data, error) {
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return telemetry.Data{}, fmt.Errorf("cannot create Kubernetes client: %w", err)
+	}
+
+	var watchedNamespaces []string
+	if isWatchNamespacesSet(cfg.WatchNamespaces) {
+		watchedNamespaces, err = getWatchedNamespaceNames(k8sClient, cfg)
+		if err != nil {
+			return telemetry.Data{}, fmt.Errorf("cannot determine watched Namespaces: %w", err)
+		}
+	}
+
+	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+		GatewayCtlrName:  cfg.GatewayCtlrName,
+		GatewayClassName: cfg.GatewayClassName,
+		Logger:           cfg.Logger.WithName("changeProcessor"),
+		Validators: validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    ngxvalidation.GenericValidator{},
+		},
+		// the events of the resources are recorded by NGF, not here
+		EventRecorder:     &record.FakeRecorder{},
+		Scheme:            scheme,
+		ProtectedPorts:    getProtectedPorts(cfg),
+		WatchedNamespaces: graph.WatchedNamespaces{Names: watchedNamespaces},
+		Plus:              cfg.Plus,
+	})
+
+	objects, objectLists := prepareFirstEventBatchPreparerArgs(
+		cfg.GatewayClassName,
+		cfg.GatewayNsName,
+		cfg.ExperimentalFeatures,
+		cfg.SnippetsFilters,
+		cfg.ErrorPagePolicies,
+	)
+
+	batch, err := events.NewFirstEventBatchPreparerImpl(k8sClient, objects, objectLists).Prepare(ctx)
+	if err != nil {
+		return telemetry.Data{}, fmt.Errorf("cannot list resources: %w", err)
+	}
+
+	for _, event := range batch {
+		if e, ok := event.(*events.UpsertEvent); ok {
+			processor.CaptureUpsertChange(e.Resource)
+		}
+	}
+
+	_, g := processor.Process()
+
+	zone, err := getNodeZone(ctx, k8sClient, cfg.GatewayPodConfig.NodeName)
+	if err != nil {
+		cfg.Logger.Error(err, "Cannot determine the zone of NGINX; all endpoints are counted")
+	}
+
+	dataplaneCfg := dataplane.BuildConfiguration(
+		ctx,
+		g,
+		resolver.NewServiceResolverImpl(k8sClient),
+		1, /* configVersion */
+		zone,
+	)
+
+	collector := telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
+		K8sClientReader:        k8sClient,
+		GraphGetter:            latestGraph{graph: g},
+		ConfigurationGetter:    latestConfiguration{configuration: &dataplaneCfg},
+		NginxReloadCountGetter: noNginxReloads{},
+		Version:                cfg.Version,
+		PodNSName: types.NamespacedName{
+			Namespace: cfg.GatewayPodConfig.Namespace,
+			Name:      cfg.GatewayPodConfig.Name,
+		},
+		ImageSource: cfg.ImageSource,
+		Flags:       cfg.Flags,
+		Plus:        cfg.Plus,
+	})
+
+	return collector.Collect(ctx)
+}
+
+// latestGraph is a GraphGetter of a Graph that doesn't change.
+type latestGraph struct {
+	graph *graph.Graph
+}
+
+func (l latestGraph) GetLatestGraph() *graph.Graph {
+	return l.graph
+}
+
+// latestConfiguration is a ConfigurationGetter of a Configuration that doesn't change.
+type latestConfiguration struct {
+	configuration *dataplane.Configuration
+}
+
+func (l latestConfiguration) GetLatestConfiguration() *dataplane.Configuration {
+	return l.configuration
+}
+
+// noNginxReloads is a NginxReloadCountGetter when NGINX is not reloaded.
+type noNginxReloads struct{}
+
+func (noNginxReloads) GetNginxReloadCount() int64 {
+	return 0
+}
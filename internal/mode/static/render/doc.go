@@ -0,0 +1,6 @@
+/*
+Package render builds the NGINX configuration and the statuses of resources from Kubernetes manifests,
+without a cluster or NGINX. It runs the same graph, dataplane configuration and NGINX configuration builders
+as the static mode.
+*/
+package render
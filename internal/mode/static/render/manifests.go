@@ -0,0 +1,315 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(gatewayv1beta1.Install(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1alpha2.Install(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+	utilruntime.Must(discoveryV1.AddToScheme(scheme))
+	utilruntime.Must(ngfAPI.AddToScheme(scheme))
+	utilruntime.Must(apiext.AddToScheme(scheme))
+}
+
+// defaultNamespace is the Namespace of namespaced resources that don't specify it, like kubectl does.
+const defaultNamespace = "default"
+
+// objectKey uniquely identifies a resource among the manifests.
+type objectKey struct {
+	kind   string
+	nsName types.NamespacedName
+}
+
+func newObjectKey(obj client.Object) objectKey {
+	return objectKey{
+		kind:   fmt.Sprintf("%T", obj),
+		nsName: client.ObjectKeyFromObject(obj),
+	}
+}
+
+// manifests holds the resources loaded from the manifests.
+type manifests struct {
+	// objects holds all resources in the state, so that their statuses can be set.
+	objects        map[objectKey]client.Object
+	state          graph.ClusterState
+	endpointSlices []discoveryV1.EndpointSlice
+	// skipped holds the resources that NGINX Gateway Fabric doesn't process.
+	skipped []string
+}
+
+func newManifests() *manifests {
+	return &manifests{
+		objects: make(map[objectKey]client.Object),
+		state: graph.ClusterState{
+			GatewayClasses:      make(map[types.NamespacedName]*gatewayv1.GatewayClass),
+			Gateways:            make(map[types.NamespacedName]*gatewayv1.Gateway),
+			HTTPRoutes:          make(map[types.NamespacedName]*gatewayv1.HTTPRoute),
+			Services:            make(map[types.NamespacedName]*apiv1.Service),
+			Namespaces:          make(map[types.NamespacedName]*apiv1.Namespace),
+			ReferenceGrants:     make(map[types.NamespacedName]*gatewayv1beta1.ReferenceGrant),
+			Secrets:             make(map[types.NamespacedName]*apiv1.Secret),
+			CRDMetadata:         make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+			BackendTLSPolicies:  make(map[types.NamespacedName]*gatewayv1alpha2.BackendTLSPolicy),
+			ConfigMaps:          make(map[types.NamespacedName]*apiv1.ConfigMap),
+			NginxProxies:        make(map[types.NamespacedName]*ngfAPI.NginxProxy),
+			GRPCRoutes:          make(map[types.NamespacedName]*gatewayv1alpha2.GRPCRoute),
+			CompressionPolicies: make(map[types.NamespacedName]*ngfAPI.CompressionPolicy),
+			ErrorPagePolicies:   make(map[types.NamespacedName]*ngfAPI.ErrorPagePolicy),
+			SnippetsFilters:     make(map[types.NamespacedName]*ngfAPI.SnippetsFilter),
+			HostnameBackends:    make(map[types.NamespacedName]*ngfAPI.HostnameBackend),
+		},
+	}
+}
+
+// loadManifests loads the resources from the files. A path can be a file or a directory, in which case
+// all .yaml, .yml and .json files in it are loaded, recursively.
+func loadManifests(paths []string, cfg Config) (*manifests, error) {
+	m := newManifests()
+
+	for _, path := range paths {
+		files, err := findManifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range files {
+			if err := m.loadFile(f, cfg); err != nil {
+				return nil, fmt.Errorf("error loading manifests from %s: %w", f, err)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func findManifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".yaml", ".yml", ".json":
+			if !d.IsDir() {
+				files = append(files, p)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func (m *manifests) loadFile(path string, cfg Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return m.load(f, cfg)
+}
+
+// load loads the resources from a stream of YAML or JSON documents.
+func (m *manifests) load(r io.Reader, cfg Config) error {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(strings.TrimSpace(string(doc))) == 0 {
+			continue
+		}
+
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+			return err
+		}
+		// empty documents, for example, with only comments
+		if typeMeta.Kind == "" && typeMeta.APIVersion == "" {
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				m.skip(typeMeta.GroupVersionKind(), doc)
+				continue
+			}
+			return err
+		}
+
+		if items, isList := listItems(obj); isList {
+			for _, item := range items {
+				if err := m.load(bytes.NewReader(item.Raw), cfg); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		obj.GetObjectKind().SetGroupVersionKind(*gvk)
+
+		if !m.add(obj, cfg) {
+			m.skip(*gvk, doc)
+		}
+	}
+}
+
+// listItems returns the items of a List, which kubectl produces, for example, with "kubectl get -o yaml".
+func listItems(obj runtime.Object) ([]runtime.RawExtension, bool) {
+	switch list := obj.(type) {
+	case *apiv1.List:
+		return list.Items, true
+	case *metav1.List:
+		return list.Items, true
+	default:
+		return nil, false
+	}
+}
+
+func (m *manifests) skip(gvk schema.GroupVersionKind, doc []byte) {
+	var meta struct {
+		metav1.ObjectMeta `json:"metadata"`
+	}
+	_ = yaml.Unmarshal(doc, &meta)
+
+	name := meta.Name
+	if meta.Namespace != "" {
+		name = meta.Namespace + "/" + name
+	}
+
+	m.skipped = append(m.skipped, fmt.Sprintf("%s %s", gvk.GroupKind(), name))
+}
+
+// add adds the resource to the state. It returns false if NGINX Gateway Fabric doesn't process the resource.
+func (m *manifests) add(obj runtime.Object, cfg Config) bool {
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return false
+	}
+
+	if clientObj.GetNamespace() == "" && !isClusterScoped(obj) {
+		clientObj.SetNamespace(defaultNamespace)
+	}
+
+	nsName := client.ObjectKeyFromObject(clientObj)
+
+	switch o := obj.(type) {
+	case *gatewayv1.GatewayClass:
+		m.state.GatewayClasses[nsName] = o
+	case *gatewayv1.Gateway:
+		m.state.Gateways[nsName] = o
+	case *gatewayv1.HTTPRoute:
+		m.state.HTTPRoutes[nsName] = o
+	case *gatewayv1beta1.ReferenceGrant:
+		m.state.ReferenceGrants[nsName] = o
+	case *gatewayv1alpha2.GRPCRoute:
+		if !cfg.ExperimentalFeatures {
+			return false
+		}
+		m.state.GRPCRoutes[nsName] = o
+	case *gatewayv1alpha2.BackendTLSPolicy:
+		if !cfg.ExperimentalFeatures {
+			return false
+		}
+		m.state.BackendTLSPolicies[nsName] = o
+	case *apiv1.Service:
+		m.state.Services[nsName] = o
+	case *apiv1.Namespace:
+		m.state.Namespaces[nsName] = o
+	case *apiv1.Secret:
+		m.state.Secrets[nsName] = o
+	case *apiv1.ConfigMap:
+		m.state.ConfigMaps[nsName] = o
+	case *discoveryV1.EndpointSlice:
+		m.endpointSlices = append(m.endpointSlices, *o)
+	case *ngfAPI.NginxProxy:
+		m.state.NginxProxies[nsName] = o
+	case *ngfAPI.CompressionPolicy:
+		m.state.CompressionPolicies[nsName] = o
+	case *ngfAPI.ErrorPagePolicy:
+		if !cfg.ErrorPagePolicies {
+			return false
+		}
+		m.state.ErrorPagePolicies[nsName] = o
+	case *ngfAPI.SnippetsFilter:
+		if !cfg.SnippetsFilters {
+			return false
+		}
+		m.state.SnippetsFilters[nsName] = o
+	case *ngfAPI.HostnameBackend:
+		m.state.HostnameBackends[nsName] = o
+	case *apiext.CustomResourceDefinition:
+		m.state.CRDMetadata[nsName] = &metav1.PartialObjectMetadata{
+			TypeMeta:   o.TypeMeta,
+			ObjectMeta: o.ObjectMeta,
+		}
+	default:
+		return false
+	}
+
+	m.objects[newObjectKey(clientObj)] = clientObj
+
+	return true
+}
+
+func isClusterScoped(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *apiv1.Namespace, *gatewayv1.GatewayClass, *apiext.CustomResourceDefinition:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,163 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const testManifests = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: gateway.nginx.org/nginx-gateway-controller
+---
+# only a comment
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: test
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: coffee
+    namespace: test
+  spec:
+    ports:
+    - name: http
+      port: 80
+      targetPort: 8080
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coffee
+  namespace: test
+---
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: GRPCRoute
+metadata:
+  name: grpc
+  namespace: test
+---
+apiVersion: gateway.nginx.org/v1alpha1
+kind: SnippetsFilter
+metadata:
+  name: snippets
+  namespace: test
+spec:
+  snippets:
+  - context: http
+    value: "# snippet"
+---
+apiVersion: gateway.nginx.org/v1alpha1
+kind: ErrorPagePolicy
+metadata:
+  name: errors
+  namespace: test
+spec:
+  targetRef:
+    group: gateway.networking.k8s.io
+    kind: Gateway
+    name: gateway
+  errorPages:
+  - codes:
+    - 404
+    body: not found
+`
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	g := NewWithT(t)
+
+	g.Expect(os.MkdirAll(filepath.Join(dir, "nested"), 0o755)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "nested", "resources.yml"), []byte(testManifests), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("# not a manifest"), 0o600)).To(Succeed())
+
+	tests := []struct {
+		name                 string
+		expSkipped           []string
+		cfg                  Config
+		expGRPCRoutes        int
+		expSnippetsFilters   int
+		expErrorPagePolicies int
+	}{
+		{
+			name: "experimental features, SnippetsFilters and ErrorPagePolicies disabled",
+			expSkipped: []string{
+				"Deployment.apps test/coffee",
+				"GRPCRoute.gateway.networking.k8s.io test/grpc",
+				"SnippetsFilter.gateway.nginx.org test/snippets",
+				"ErrorPagePolicy.gateway.nginx.org test/errors",
+			},
+		},
+		{
+			name: "experimental features, SnippetsFilters and ErrorPagePolicies enabled",
+			cfg: Config{
+				ExperimentalFeatures: true,
+				SnippetsFilters:      true,
+				ErrorPagePolicies:    true,
+			},
+			expSkipped:           []string{"Deployment.apps test/coffee"},
+			expGRPCRoutes:        1,
+			expSnippetsFilters:   1,
+			expErrorPagePolicies: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m, err := loadManifests([]string{dir}, test.cfg)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(m.state.GatewayClasses).To(HaveKey(types.NamespacedName{Name: "nginx"}))
+			// namespaced resources without a namespace are in the default namespace
+			g.Expect(m.state.Gateways).To(HaveKey(types.NamespacedName{Namespace: "default", Name: "gateway"}))
+			g.Expect(m.state.Namespaces).To(HaveKey(types.NamespacedName{Name: "test"}))
+			g.Expect(m.state.Services).To(HaveKey(types.NamespacedName{Namespace: "test", Name: "coffee"}))
+			g.Expect(m.state.GRPCRoutes).To(HaveLen(test.expGRPCRoutes))
+			g.Expect(m.state.SnippetsFilters).To(HaveLen(test.expSnippetsFilters))
+			g.Expect(m.state.ErrorPagePolicies).To(HaveLen(test.expErrorPagePolicies))
+			g.Expect(m.skipped).To(ConsistOf(test.expSkipped))
+		})
+	}
+}
+
+func TestLoadManifestsErrors(t *testing.T) {
+	dir := t.TempDir()
+	g := NewWithT(t)
+
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	g.Expect(os.WriteFile(invalidPath, []byte("apiVersion: v1\nkind: Service\nspec: [invalid"), 0o600)).To(Succeed())
+
+	_, err := loadManifests([]string{invalidPath}, Config{})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(HavePrefix("error loading manifests from " + invalidPath))
+
+	_, err = loadManifests([]string{filepath.Join(dir, "missing.yaml")}, Config{})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(strings.Contains(err.Error(), "no such file or directory")).To(BeTrue())
+}
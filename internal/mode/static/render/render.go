@@ -0,0 +1,202 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	ngxvalidation "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/status"
+)
+
+const (
+	// StatusesFileName is the name of the file in the output directory that contains the resources
+	// with their statuses.
+	StatusesFileName = "statuses.yaml"
+
+	// configVersion is the version of the rendered configuration. Unlike the static mode, which increments
+	// the version on every update, the render command always renders the first configuration.
+	configVersion = 1
+)
+
+// Config is the configuration of the render command.
+type Config struct {
+	// Logger is the logger for the render command.
+	Logger logr.Logger
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass resource that the Gateway will use.
+	GatewayClassName string
+	// OutputDir is the directory where the NGINX configuration files and the statuses are written.
+	OutputDir string
+	// ManifestPaths are the files or directories with the manifests of the resources.
+	ManifestPaths []string
+	// Plus indicates whether the configuration is rendered for NGINX Plus.
+	Plus bool
+	// ExperimentalFeatures indicates if experimental features are enabled.
+	ExperimentalFeatures bool
+	// SnippetsFilters indicates if SnippetsFilters are enabled.
+	SnippetsFilters bool
+	// ErrorPagePolicies indicates if ErrorPagePolicies are enabled.
+	ErrorPagePolicies bool
+}
+
+// Render builds the NGINX configuration and the statuses of the resources from the manifests, and writes them
+// to the output directory. The NGINX configuration files are written under the output directory with the same
+// paths as in the NGINX container.
+func Render(ctx context.Context, cfg Config) error {
+	m, err := loadManifests(cfg.ManifestPaths, cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range m.skipped {
+		cfg.Logger.Info("Ignoring resource not processed by NGINX Gateway Fabric", "resource", skipped)
+	}
+
+	validators := validation.Validators{
+		HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+		GenericValidator:    ngxvalidation.GenericValidator{},
+	}
+
+	g := graph.BuildGraph(
+		m.state,
+		cfg.GatewayCtlrName,
+		cfg.GatewayClassName,
+		validators,
+		nil, /* protectedPorts */
+		graph.WatchedNamespaces{},
+		cfg.Plus,
+		cfg.SnippetsFilters,
+	)
+
+	conf := dataplane.BuildConfiguration(
+		ctx,
+		g,
+		resolver.NewEndpointSliceResolver(m.endpointSlices),
+		configVersion,
+		"", /* zone */
+	)
+
+	files := ngxcfg.NewGeneratorImpl(cfg.Plus).Generate(conf)
+
+	if err := writeFiles(cfg.OutputDir, files); err != nil {
+		return fmt.Errorf("error writing NGINX configuration files: %w", err)
+	}
+
+	statuses, err := buildStatuses(m, g, cfg.GatewayCtlrName, metav1.Now())
+	if err != nil {
+		return fmt.Errorf("error building statuses: %w", err)
+	}
+
+	statusesPath := filepath.Join(cfg.OutputDir, StatusesFileName)
+	if err := os.WriteFile(statusesPath, statuses, 0o644); err != nil { //nolint:gosec // not a secret
+		return fmt.Errorf("error writing statuses: %w", err)
+	}
+
+	cfg.Logger.Info(
+		"Rendered NGINX configuration",
+		"files", len(files),
+		"statuses", statusesPath,
+		"configHash", ngxcfg.HashFiles(files),
+	)
+
+	return nil
+}
+
+func writeFiles(outputDir string, files []file.File) error {
+	for _, f := range files {
+		path := filepath.Join(outputDir, f.Path)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+
+		perm := os.FileMode(0o644)
+		if f.Type == file.TypeSecret {
+			perm = 0o600
+		}
+
+		if err := os.WriteFile(path, f.Content, perm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildStatuses sets the statuses that the static mode would set on the resources and returns the resources
+// as a stream of YAML documents, sorted by kind, namespace and name.
+func buildStatuses(
+	m *manifests,
+	g *graph.Graph,
+	gatewayCtlrName string,
+	transitionTime metav1.Time,
+) ([]byte, error) {
+	// The configuration is not applied to NGINX, so the reload always succeeds.
+	var reloadRes status.NginxReloadResult
+
+	var reqs []frameworkStatus.UpdateRequest
+	reqs = append(reqs, status.PrepareGatewayClassRequests(g.GatewayClass, g.IgnoredGatewayClasses, transitionTime)...)
+	reqs = append(reqs, status.PrepareGatewayRequests(g.Gateway, g.IgnoredGateways, transitionTime, nil, nil, reloadRes)...)
+	reqs = append(reqs, status.PrepareRouteRequests(g.Routes, nil, transitionTime, reloadRes, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareBackendTLSPolicyRequests(g.BackendTLSPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareCompressionPolicyRequests(g.CompressionPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareErrorPagePolicyRequests(g.ErrorPagePolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareSnippetsFilterRequests(g.SnippetsFilters, transitionTime, gatewayCtlrName)...)
+
+	keys := make([]objectKey, 0, len(reqs))
+	setters := make(map[objectKey]frameworkStatus.Setter, len(reqs))
+
+	for _, req := range reqs {
+		key := objectKey{kind: fmt.Sprintf("%T", req.ResourceType), nsName: req.NsName}
+		if _, exists := m.objects[key]; !exists {
+			continue
+		}
+
+		keys = append(keys, key)
+		setters[key] = req.Setter
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		kindI := m.objects[keys[i]].GetObjectKind().GroupVersionKind().Kind
+		kindJ := m.objects[keys[j]].GetObjectKind().GroupVersionKind().Kind
+		if kindI != kindJ {
+			return kindI < kindJ
+		}
+		if keys[i].nsName.Namespace != keys[j].nsName.Namespace {
+			return keys[i].nsName.Namespace < keys[j].nsName.Namespace
+		}
+		return keys[i].nsName.Name < keys[j].nsName.Name
+	})
+
+	var out []byte
+	for _, key := range keys {
+		obj := m.objects[key]
+		setters[key](obj)
+		obj.SetManagedFields(nil)
+
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, "---\n"...)
+		out = append(out, data...)
+	}
+
+	return out, nil
+}
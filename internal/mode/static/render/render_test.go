@@ -0,0 +1,185 @@
+package render
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const renderManifests = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: test
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: invalid
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /invalid{
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-abc
+  namespace: test
+  labels:
+    kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: http
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.1
+  conditions:
+    ready: true
+`
+
+func TestRender(t *testing.T) {
+	g := NewWithT(t)
+
+	manifestsPath := filepath.Join(t.TempDir(), "manifests.yaml")
+	g.Expect(os.WriteFile(manifestsPath, []byte(renderManifests), 0o600)).To(Succeed())
+
+	outputDir := t.TempDir()
+
+	err := Render(context.Background(), Config{
+		Logger:           logr.Discard(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		ManifestPaths:    []string{manifestsPath},
+		OutputDir:        outputDir,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpConf, err := os.ReadFile(filepath.Join(outputDir, "etc", "nginx", "conf.d", "http.conf"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(httpConf)).To(ContainSubstring("server_name cafe.example.com;"))
+	g.Expect(string(httpConf)).To(ContainSubstring("location /coffee/"))
+	g.Expect(string(httpConf)).To(ContainSubstring("server 10.0.0.1:8080;"))
+	g.Expect(string(httpConf)).ToNot(ContainSubstring("/invalid"))
+
+	statuses, err := os.ReadFile(filepath.Join(outputDir, StatusesFileName))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	docs := splitDocuments(string(statuses))
+	// in order of kind, namespace and name
+	g.Expect(docs).To(HaveLen(4))
+
+	var gw gatewayv1.Gateway
+	g.Expect(yaml.Unmarshal([]byte(docs[0]), &gw)).To(Succeed())
+	g.Expect(gw.Name).To(Equal("gateway"))
+	g.Expect(gw.Status.Listeners).To(HaveLen(1))
+	g.Expect(gw.Status.Listeners[0].AttachedRoutes).To(Equal(int32(2)))
+
+	var gc gatewayv1.GatewayClass
+	g.Expect(yaml.Unmarshal([]byte(docs[1]), &gc)).To(Succeed())
+	g.Expect(gc.Name).To(Equal("nginx"))
+	g.Expect(gc.Status.Conditions).To(ContainElement(SatisfyAll(
+		HaveField("Type", string(gatewayv1.GatewayClassConditionStatusAccepted)),
+		HaveField("Status", metav1.ConditionTrue),
+	)))
+
+	var coffee, invalid gatewayv1.HTTPRoute
+	g.Expect(yaml.Unmarshal([]byte(docs[2]), &coffee)).To(Succeed())
+	g.Expect(yaml.Unmarshal([]byte(docs[3]), &invalid)).To(Succeed())
+
+	g.Expect(coffee.Name).To(Equal("coffee"))
+	g.Expect(coffee.Status.Parents).To(HaveLen(1))
+	g.Expect(coffee.Status.Parents[0].Conditions).To(ContainElement(SatisfyAll(
+		HaveField("Type", string(gatewayv1.RouteConditionAccepted)),
+		HaveField("Status", metav1.ConditionTrue),
+	)))
+
+	g.Expect(invalid.Name).To(Equal("invalid"))
+	g.Expect(invalid.Status.Parents).To(HaveLen(1))
+	g.Expect(invalid.Status.Parents[0].Conditions).To(ContainElement(SatisfyAll(
+		HaveField("Type", string(gatewayv1.RouteConditionAccepted)),
+		HaveField("Status", metav1.ConditionFalse),
+		HaveField("Reason", string(gatewayv1.RouteReasonUnsupportedValue)),
+	)))
+}
+
+func TestRenderError(t *testing.T) {
+	g := NewWithT(t)
+
+	err := Render(context.Background(), Config{
+		Logger:        logr.Discard(),
+		ManifestPaths: []string{filepath.Join(t.TempDir(), "missing.yaml")},
+		OutputDir:     t.TempDir(),
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func splitDocuments(s string) []string {
+	var docs []string
+	for _, doc := range strings.Split(s, "---\n") {
+		if strings.TrimSpace(doc) != "" {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
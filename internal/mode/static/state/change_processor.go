@@ -76,6 +76,12 @@ type ChangeProcessorConfig struct {
 	GatewayCtlrName string
 	// GatewayClassName is the name of the GatewayClass resource.
 	GatewayClassName string
+	// WatchedNamespaces are the Namespaces in which NGF watches the resources.
+	WatchedNamespaces graph.WatchedNamespaces
+	// Plus indicates if NGINX Plus is being used.
+	Plus bool
+	// SnippetsFilters indicates if SnippetsFilters and the snippet annotations of HTTPRoutes are enabled.
+	SnippetsFilters bool
 }
 
 // ChangeProcessorImpl is an implementation of ChangeProcessor.
@@ -96,18 +102,22 @@ type ChangeProcessorImpl struct {
 // NewChangeProcessorImpl creates a new ChangeProcessorImpl for the Gateway resource with the configured namespace name.
 func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 	clusterStore := graph.ClusterState{
-		GatewayClasses:     make(map[types.NamespacedName]*v1.GatewayClass),
-		Gateways:           make(map[types.NamespacedName]*v1.Gateway),
-		HTTPRoutes:         make(map[types.NamespacedName]*v1.HTTPRoute),
-		Services:           make(map[types.NamespacedName]*apiv1.Service),
-		Namespaces:         make(map[types.NamespacedName]*apiv1.Namespace),
-		ReferenceGrants:    make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
-		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
-		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
-		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha2.BackendTLSPolicy),
-		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
-		NginxProxies:       make(map[types.NamespacedName]*ngfAPI.NginxProxy),
-		GRPCRoutes:         make(map[types.NamespacedName]*v1alpha2.GRPCRoute),
+		GatewayClasses:      make(map[types.NamespacedName]*v1.GatewayClass),
+		Gateways:            make(map[types.NamespacedName]*v1.Gateway),
+		HTTPRoutes:          make(map[types.NamespacedName]*v1.HTTPRoute),
+		Services:            make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:          make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:     make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
+		Secrets:             make(map[types.NamespacedName]*apiv1.Secret),
+		CRDMetadata:         make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		BackendTLSPolicies:  make(map[types.NamespacedName]*v1alpha2.BackendTLSPolicy),
+		ConfigMaps:          make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:        make(map[types.NamespacedName]*ngfAPI.NginxProxy),
+		GRPCRoutes:          make(map[types.NamespacedName]*v1alpha2.GRPCRoute),
+		CompressionPolicies: make(map[types.NamespacedName]*ngfAPI.CompressionPolicy),
+		ErrorPagePolicies:   make(map[types.NamespacedName]*ngfAPI.ErrorPagePolicy),
+		SnippetsFilters:     make(map[types.NamespacedName]*ngfAPI.SnippetsFilter),
+		HostnameBackends:    make(map[types.NamespacedName]*ngfAPI.HostnameBackend),
 	}
 
 	extractGVK := func(obj client.Object) schema.GroupVersionKind {
@@ -195,6 +205,26 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.NginxProxies),
 				predicate: funcPredicate{stateChanged: isReferenced},
 			},
+			{
+				gvk:       extractGVK(&ngfAPI.CompressionPolicy{}),
+				store:     newObjectStoreMapAdapter(clusterStore.CompressionPolicies),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&ngfAPI.ErrorPagePolicy{}),
+				store:     newObjectStoreMapAdapter(clusterStore.ErrorPagePolicies),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&ngfAPI.SnippetsFilter{}),
+				store:     newObjectStoreMapAdapter(clusterStore.SnippetsFilters),
+				predicate: nil,
+			},
+			{
+				gvk:       extractGVK(&ngfAPI.HostnameBackend{}),
+				store:     newObjectStoreMapAdapter(clusterStore.HostnameBackends),
+				predicate: nil,
+			},
 		},
 	)
 
@@ -248,6 +278,9 @@ func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 		c.cfg.GatewayClassName,
 		c.cfg.Validators,
 		c.cfg.ProtectedPorts,
+		c.cfg.WatchedNamespaces,
+		c.cfg.Plus,
+		c.cfg.SnippetsFilters,
 	)
 
 	return changeType, c.latestGraph
@@ -44,6 +44,18 @@ const (
 	// Used with Accepted (false).
 	RouteReasonGatewayNotProgrammed v1.RouteConditionReason = "GatewayNotProgrammed"
 
+	// RouteReasonInvalidFilter is used with the "ResolvedRefs" (false) condition when one of the Route rules
+	// references a filter that doesn't exist or is invalid.
+	RouteReasonInvalidFilter v1.RouteConditionReason = "InvalidFilter"
+
+	// RouteReasonDNSResolutionUnavailable is used with the "ResolvedRefs" (false) condition when one of the
+	// Route rules references a backend that must be resolved by DNS, but NGINX can't resolve it.
+	RouteReasonDNSResolutionUnavailable v1.RouteConditionReason = "DNSResolutionUnavailable"
+
+	// RouteReasonNamespaceNotWatched is used with the "Accepted" (false) condition when NGINX Gateway Fabric
+	// doesn't watch the Namespace of the Route.
+	RouteReasonNamespaceNotWatched v1.RouteConditionReason = "NamespaceNotWatched"
+
 	// GatewayReasonGatewayConflict indicates there are multiple Gateway resources to choose from,
 	// and we ignored the resource in question and picked another Gateway as the winner.
 	// This reason is used with GatewayConditionAccepted (false).
@@ -219,6 +231,39 @@ func NewRouteBackendRefUnsupportedValue(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteBackendRefDNSResolutionUnavailable returns a Condition that indicates that the Route has a backendRef
+// to a backend that must be resolved by DNS, but DNS resolution is not possible.
+func NewRouteBackendRefDNSResolutionUnavailable(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonDNSResolutionUnavailable),
+		Message: msg,
+	}
+}
+
+// NewRouteResolvedRefsInvalidFilter returns a Condition that indicates that the Route has a filter that
+// cannot be resolved or is invalid.
+func NewRouteResolvedRefsInvalidFilter(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonInvalidFilter),
+		Message: msg,
+	}
+}
+
+// NewRouteResolvedRefsInvalidFilterKind returns a Condition that indicates that the Route has an ExtensionRef
+// filter that references a filter of an unsupported kind.
+func NewRouteResolvedRefsInvalidFilterKind(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.RouteReasonInvalidKind),
+		Message: msg,
+	}
+}
+
 // NewRouteInvalidGateway returns a Condition that indicates that the Route is not Accepted because the Gateway it
 // references is invalid.
 func NewRouteInvalidGateway() conditions.Condition {
@@ -241,6 +286,20 @@ func NewRouteNoMatchingParent() conditions.Condition {
 	}
 }
 
+// NewRouteNamespaceNotWatched returns a Condition that indicates that the Route is not Accepted because
+// NGINX Gateway Fabric doesn't watch its Namespace.
+func NewRouteNamespaceNotWatched(namespace string) conditions.Condition {
+	return conditions.Condition{
+		Type:   string(v1.RouteConditionAccepted),
+		Status: metav1.ConditionFalse,
+		Reason: string(RouteReasonNamespaceNotWatched),
+		Message: fmt.Sprintf(
+			"Namespace %s is not watched by NGINX Gateway Fabric; the Route is not considered",
+			namespace,
+		),
+	}
+}
+
 // NewRouteGatewayNotProgrammed returns a Condition that indicates that the Gateway it references is not programmed,
 // which does not guarantee that the Route has been configured.
 func NewRouteGatewayNotProgrammed(msg string) conditions.Condition {
@@ -537,6 +596,42 @@ func NewGatewayUnsupportedValue(msg string) []conditions.Condition {
 	}
 }
 
+// NewGatewayUnsupportedAddress returns Conditions that indicate that an address in spec.addresses of the Gateway
+// has an unsupported type or an invalid value.
+func NewGatewayUnsupportedAddress(msg string) []conditions.Condition {
+	return []conditions.Condition{
+		{
+			Type:    string(v1.GatewayConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.GatewayReasonUnsupportedAddress),
+			Message: msg,
+		},
+		NewGatewayNotProgrammedInvalid(msg),
+	}
+}
+
+// NewGatewayAddressNotAssigned returns a Condition that indicates that the addresses requested in spec.addresses
+// of the Gateway are not assigned yet.
+func NewGatewayAddressNotAssigned(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.GatewayReasonAddressNotAssigned),
+		Message: msg,
+	}
+}
+
+// NewGatewayAddressNotUsable returns a Condition that indicates that the addresses requested in spec.addresses
+// of the Gateway can't be used.
+func NewGatewayAddressNotUsable(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.GatewayReasonAddressNotUsable),
+		Message: msg,
+	}
+}
+
 // NewGatewayProgrammed returns a Condition that indicates the Gateway is programmed.
 func NewGatewayProgrammed() conditions.Condition {
 	return conditions.Condition{
@@ -610,3 +705,109 @@ func NewBackendTLSPolicyInvalid(msg string) conditions.Condition {
 		Message: msg,
 	}
 }
+
+// NewCompressionPolicyAccepted returns a Condition that indicates that the CompressionPolicy is valid and accepted
+// by the Gateway.
+func NewCompressionPolicyAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha2.PolicyReasonAccepted),
+		Message: "CompressionPolicy is accepted by the Gateway",
+	}
+}
+
+// NewCompressionPolicyInvalid returns a Condition that indicates that the CompressionPolicy is invalid.
+func NewCompressionPolicyInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewCompressionPolicyTargetNotFound returns a Condition that indicates that the target of the CompressionPolicy
+// does not exist or is not attached to the Gateway.
+func NewCompressionPolicyTargetNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonTargetNotFound),
+		Message: msg,
+	}
+}
+
+// NewCompressionPolicyConflicted returns a Condition that indicates that the CompressionPolicy targets a resource
+// that is already targeted by another CompressionPolicy.
+func NewCompressionPolicyConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonConflicted),
+		Message: msg,
+	}
+}
+
+// NewErrorPagePolicyAccepted returns a Condition that indicates that the ErrorPagePolicy is valid and accepted
+// by the Gateway.
+func NewErrorPagePolicyAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha2.PolicyReasonAccepted),
+		Message: "ErrorPagePolicy is accepted by the Gateway",
+	}
+}
+
+// NewErrorPagePolicyInvalid returns a Condition that indicates that the ErrorPagePolicy is invalid.
+func NewErrorPagePolicyInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewErrorPagePolicyTargetNotFound returns a Condition that indicates that the target of the ErrorPagePolicy
+// does not exist or is not attached to the Gateway.
+func NewErrorPagePolicyTargetNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonTargetNotFound),
+		Message: msg,
+	}
+}
+
+// NewErrorPagePolicyConflicted returns a Condition that indicates that the ErrorPagePolicy targets a resource
+// that is already targeted by another ErrorPagePolicy.
+func NewErrorPagePolicyConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonConflicted),
+		Message: msg,
+	}
+}
+
+// NewSnippetsFilterAccepted returns a Condition that indicates that the SnippetsFilter is valid and accepted.
+func NewSnippetsFilterAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.SnippetsFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.SnippetsFilterConditionReasonAccepted),
+		Message: "SnippetsFilter is accepted",
+	}
+}
+
+// NewSnippetsFilterInvalid returns a Condition that indicates that the SnippetsFilter is invalid.
+func NewSnippetsFilterInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.SnippetsFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.SnippetsFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
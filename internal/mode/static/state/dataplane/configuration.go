@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"sort"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -13,6 +14,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
@@ -24,11 +26,13 @@ const (
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
+// The zone is the zone of the Node that NGINX runs on. It is empty if unknown.
 func BuildConfiguration(
 	ctx context.Context,
 	g *graph.Graph,
 	resolver resolver.ServiceResolver,
 	configVersion int,
+	zone string,
 ) Configuration {
 	if g.GatewayClass == nil || !g.GatewayClass.Valid {
 		return Configuration{Version: configVersion}
@@ -38,12 +42,23 @@ func BuildConfiguration(
 		return Configuration{Version: configVersion}
 	}
 
-	upstreams := buildUpstreams(ctx, g.Gateway.Listeners, resolver)
-	httpServers, sslServers := buildServers(g.Gateway.Listeners)
+	upstreams := buildUpstreams(
+		ctx,
+		g.Gateway,
+		resolver,
+		getPreferredZone(g, zone),
+		getTerminatingEndpointsPolicy(g),
+	)
+	httpServers, sslServers := buildServers(g.Gateway.Listeners, getNginxProxyCompression(g))
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
 	keyPairs := buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners)
 	certBundles := buildCertBundles(g.ReferencedCaCertConfigMaps, backendGroups)
 	telemetry := buildTelemetry(g)
+	compression := buildCompression(g)
+	dnsResolver := buildDNSResolver(g)
+	errorPages := buildErrorPages(g.Gateway.ErrorPagePolicy)
+	errorPageContents := buildErrorPageContents(g)
+	mainSnippets, httpSnippets := buildSnippets(g.Gateway.Listeners)
 
 	config := Configuration{
 		HTTPServers:   httpServers,
@@ -54,11 +69,26 @@ func BuildConfiguration(
 		Version:       configVersion,
 		CertBundles:   certBundles,
 		Telemetry:     telemetry,
+		Compression:   compression,
+		DNSResolver:   dnsResolver,
+
+		ErrorPages:        errorPages,
+		ErrorPageContents: errorPageContents,
+		MainSnippets:      mainSnippets,
+		HTTPSnippets:      httpSnippets,
+		DynamicUpstreams:  dynamicUpstreamsEnabled(g),
 	}
 
 	return config
 }
 
+// dynamicUpstreamsEnabled returns true if the NginxProxy enables updating endpoints without reloading NGINX.
+func dynamicUpstreamsEnabled(g *graph.Graph) bool {
+	return g.NginxProxy != nil &&
+		g.NginxProxy.Spec.DynamicUpstreams != nil &&
+		*g.NginxProxy.Spec.DynamicUpstreams
+}
+
 // buildSSLKeyPairs builds the SSLKeyPairs from the Secrets. It will only include Secrets that are referenced by
 // valid listeners, so that we don't include unused Secrets in the configuration of the data plane.
 func buildSSLKeyPairs(
@@ -199,7 +229,10 @@ func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 	return verify
 }
 
-func buildServers(listeners []*graph.Listener) (http, ssl []VirtualServer) {
+func buildServers(
+	listeners []*graph.Listener,
+	defaultCompression *ngfAPI.Compression,
+) (http, ssl []VirtualServer) {
 	rulesForProtocol := map[v1.ProtocolType]portPathRules{
 		v1.HTTPProtocolType:  make(portPathRules),
 		v1.HTTPSProtocolType: make(portPathRules),
@@ -209,7 +242,7 @@ func buildServers(listeners []*graph.Listener) (http, ssl []VirtualServer) {
 		if l.Valid {
 			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
 			if rules == nil {
-				rules = newHostPathRules()
+				rules = newHostPathRules(defaultCompression)
 				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
 			}
 
@@ -247,18 +280,20 @@ type pathAndType struct {
 }
 
 type hostPathRules struct {
-	rulesPerHost     map[string]map[pathAndType]PathRule
-	listenersForHost map[string]*graph.Listener
-	httpsListeners   []*graph.Listener
-	listenersExist   bool
-	port             int32
+	rulesPerHost       map[string]map[pathAndType]PathRule
+	listenersForHost   map[string]*graph.Listener
+	defaultCompression *ngfAPI.Compression
+	httpsListeners     []*graph.Listener
+	listenersExist     bool
+	port               int32
 }
 
-func newHostPathRules() *hostPathRules {
+func newHostPathRules(defaultCompression *ngfAPI.Compression) *hostPathRules {
 	return &hostPathRules{
-		rulesPerHost:     make(map[string]map[pathAndType]PathRule),
-		listenersForHost: make(map[string]*graph.Listener),
-		httpsListeners:   make([]*graph.Listener, 0),
+		rulesPerHost:       make(map[string]map[pathAndType]PathRule),
+		listenersForHost:   make(map[string]*graph.Listener),
+		defaultCompression: defaultCompression,
+		httpsListeners:     make([]*graph.Listener, 0),
 	}
 }
 
@@ -312,6 +347,15 @@ func (hpr *hostPathRules) upsertRoute(route *graph.L7Route, listener *graph.List
 		}
 	}
 
+	var compression *Compression
+	if route.CompressionPolicy != nil {
+		compression = convertCompression(
+			mergeCompression(&route.CompressionPolicy.Source.Spec.Compression, hpr.defaultCompression),
+		)
+	}
+
+	errorPages := buildErrorPages(route.ErrorPagePolicy)
+
 	for i, rule := range route.Spec.Rules {
 		if !rule.ValidMatches {
 			continue
@@ -320,6 +364,8 @@ func (hpr *hostPathRules) upsertRoute(route *graph.L7Route, listener *graph.List
 		var filters HTTPFilters
 		if rule.ValidFilters {
 			filters = createHTTPFilters(rule.Filters)
+			addExtensionRefFilters(rule.ExtensionRefFilters, &filters)
+			addRouteSnippets(route, &filters)
 		} else {
 			filters = HTTPFilters{
 				InvalidFilter: &InvalidHTTPFilter{},
@@ -350,6 +396,8 @@ func (hpr *hostPathRules) upsertRoute(route *graph.L7Route, listener *graph.List
 					BackendGroup: newBackendGroup(rule.BackendRefs, routeNsName, i),
 					Filters:      filters,
 					Match:        convertMatch(m),
+					Compression:  compression,
+					ErrorPages:   errorPages,
 				})
 
 				hpr.rulesPerHost[h][key] = hostRule
@@ -444,15 +492,75 @@ func (hpr *hostPathRules) maxServerCount() int {
 
 func buildUpstreams(
 	ctx context.Context,
-	listeners []*graph.Listener,
-	resolver resolver.ServiceResolver,
+	gateway *graph.Gateway,
+	svcResolver resolver.ServiceResolver,
+	preferredZone string,
+	terminatingPolicy ngfAPI.TerminatingEndpointsPolicy,
 ) []Upstream {
 	// There can be duplicate upstreams if multiple routes reference the same upstream.
 	// We use a map to deduplicate them.
 	uniqueUpstreams := make(map[string]Upstream)
 
-	for _, l := range listeners {
+	addUpstream := func(br graph.BackendRef) {
+		if !br.Valid {
+			return
+		}
+
+		upstreamName := br.ServicePortReference()
+		if _, exist := uniqueUpstreams[upstreamName]; exist {
+			return
+		}
+
+		if br.Hostname != "" {
+			// NGINX resolves the hostname at runtime, so there are no endpoints to look up.
+			uniqueUpstreams[upstreamName] = Upstream{
+				Name: upstreamName,
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: br.Hostname,
+						Port:    br.ServicePort.Port,
+						Resolve: true,
+					},
+				},
+			}
 
+			return
+		}
+
+		var errMsg string
+
+		eps, err := svcResolver.Resolve(ctx, br.SvcNsName, br.ServicePort)
+		if err != nil {
+			errMsg = err.Error()
+		}
+
+		eps = applyTerminatingEndpointsPolicy(eps, terminatingPolicy)
+
+		if preferredZone != "" {
+			eps = preferZone(eps, preferredZone)
+		}
+
+		uniqueUpstreams[upstreamName] = Upstream{
+			Name:      upstreamName,
+			Endpoints: eps,
+			ErrorMsg:  errMsg,
+		}
+	}
+
+	// The error pages served by a Service are proxied to an upstream of the Service.
+	addErrorPageUpstreams := func(policy *graph.ErrorPagePolicy) {
+		if policy == nil {
+			return
+		}
+
+		for _, page := range policy.ErrorPages {
+			if page.BackendRef != nil {
+				addUpstream(*page.BackendRef)
+			}
+		}
+	}
+
+	for _, l := range gateway.Listeners {
 		if !l.Valid {
 			continue
 		}
@@ -468,32 +576,16 @@ func buildUpstreams(
 					continue
 				}
 				for _, br := range rule.BackendRefs {
-					if br.Valid {
-						upstreamName := br.ServicePortReference()
-						_, exist := uniqueUpstreams[upstreamName]
-
-						if exist {
-							continue
-						}
-
-						var errMsg string
-
-						eps, err := resolver.Resolve(ctx, br.SvcNsName, br.ServicePort)
-						if err != nil {
-							errMsg = err.Error()
-						}
-
-						uniqueUpstreams[upstreamName] = Upstream{
-							Name:      upstreamName,
-							Endpoints: eps,
-							ErrorMsg:  errMsg,
-						}
-					}
+					addUpstream(br)
 				}
 			}
+
+			addErrorPageUpstreams(route.ErrorPagePolicy)
 		}
 	}
 
+	addErrorPageUpstreams(gateway.ErrorPagePolicy)
+
 	if len(uniqueUpstreams) == 0 {
 		return nil
 	}
@@ -506,6 +598,106 @@ func buildUpstreams(
 	return upstreams
 }
 
+// getPreferredZone returns the zone whose endpoints should be preferred by NGINX, or an empty string if
+// topology-aware routing is not enabled.
+func getPreferredZone(g *graph.Graph, zone string) string {
+	if g.NginxProxy == nil || g.NginxProxy.Spec.TopologyAwareRouting == nil {
+		return ""
+	}
+
+	if g.NginxProxy.Spec.TopologyAwareRouting.Mode != ngfAPI.TopologyModePreferSameZone {
+		return ""
+	}
+
+	return zone
+}
+
+// getTerminatingEndpointsPolicy returns the policy for terminating endpoints. Terminating endpoints are removed
+// unless the NginxProxy configures a different policy.
+func getTerminatingEndpointsPolicy(g *graph.Graph) ngfAPI.TerminatingEndpointsPolicy {
+	if g.NginxProxy == nil || g.NginxProxy.Spec.TerminatingEndpoints == nil {
+		return ngfAPI.TerminatingEndpointsPolicyRemove
+	}
+
+	return g.NginxProxy.Spec.TerminatingEndpoints.Policy
+}
+
+// applyTerminatingEndpointsPolicy removes the terminating endpoints, or marks them as backup or draining endpoints,
+// depending on the policy.
+// If all endpoints are terminating, the Backup and Drain policies keep them as primary endpoints, because NGINX
+// rejects an upstream whose servers are all backup servers, and draining all servers would stop the traffic.
+func applyTerminatingEndpointsPolicy(
+	eps []resolver.Endpoint,
+	policy ngfAPI.TerminatingEndpointsPolicy,
+) []resolver.Endpoint {
+	isTerminating := func(ep resolver.Endpoint) bool { return ep.Terminating }
+
+	if !slices.ContainsFunc(eps, isTerminating) {
+		return eps
+	}
+
+	if policy != ngfAPI.TerminatingEndpointsPolicyRemove &&
+		!slices.ContainsFunc(eps, func(ep resolver.Endpoint) bool { return !ep.Terminating }) {
+		return eps
+	}
+
+	result := make([]resolver.Endpoint, 0, len(eps))
+	for _, ep := range eps {
+		if ep.Terminating {
+			switch policy {
+			case ngfAPI.TerminatingEndpointsPolicyBackup:
+				ep.Backup = true
+			case ngfAPI.TerminatingEndpointsPolicyDrain:
+				ep.Drain = true
+			default:
+				continue
+			}
+		}
+
+		result = append(result, ep)
+	}
+
+	return result
+}
+
+// preferZone marks the endpoints that are not in the zone as backup endpoints.
+// Like kube-proxy, it uses the zone hints of the endpoints only if all endpoints have hints.
+// If no endpoint is in the zone, the endpoints are returned unchanged, so that all of them are used.
+// Terminating endpoints are never considered in the zone and are returned unchanged.
+func preferZone(eps []resolver.Endpoint, zone string) []resolver.Endpoint {
+	useHints := len(eps) > 0
+	for _, ep := range eps {
+		if !ep.Terminating && len(ep.ZoneHints) == 0 {
+			useHints = false
+			break
+		}
+	}
+
+	inZone := func(ep resolver.Endpoint) bool {
+		if ep.Terminating {
+			return false
+		}
+		if useHints {
+			return slices.Contains(ep.ZoneHints, zone)
+		}
+		return ep.Zone == zone
+	}
+
+	if !slices.ContainsFunc(eps, inZone) {
+		return eps
+	}
+
+	result := make([]resolver.Endpoint, 0, len(eps))
+	for _, ep := range eps {
+		if !ep.Terminating {
+			ep.Backup = !inZone(ep)
+		}
+		result = append(result, ep)
+	}
+
+	return result
+}
+
 func getListenerHostname(h *v1.Hostname) string {
 	if h == nil || *h == "" {
 		return wildcardHostname
@@ -574,6 +766,229 @@ func generateCertBundleID(configMap types.NamespacedName) CertBundleID {
 	return CertBundleID(fmt.Sprintf("cert_bundle_%s_%s", configMap.Namespace, configMap.Name))
 }
 
+// generateErrorPageID generates an ID for the error page based on the ErrorPagePolicy namespaced name and
+// the index of the error page in the ErrorPagePolicy.
+// It is guaranteed to be unique per unique namespaced name and index.
+// The ID is safe to use as a file name.
+func generateErrorPageID(policy types.NamespacedName, idx int) ErrorPageID {
+	return ErrorPageID(fmt.Sprintf("error_page_%s_%s_%d", policy.Namespace, policy.Name, idx))
+}
+
+// buildErrorPages generates the error pages of the ErrorPagePolicy.
+func buildErrorPages(policy *graph.ErrorPagePolicy) []ErrorPage {
+	if policy == nil {
+		return nil
+	}
+
+	policyNsName := client.ObjectKeyFromObject(policy.Source)
+
+	errorPages := make([]ErrorPage, 0, len(policy.ErrorPages))
+	for i, page := range policy.ErrorPages {
+		errorPage := ErrorPage{
+			ID:           generateErrorPageID(policyNsName, i),
+			Codes:        page.Codes,
+			ResponseCode: page.ResponseCode,
+			ContentType:  page.ContentType,
+		}
+
+		if page.BackendRef != nil {
+			errorPage.UpstreamName = page.BackendRef.ServicePortReference()
+			errorPage.Path = page.Path
+		}
+
+		errorPages = append(errorPages, errorPage)
+	}
+
+	return errorPages
+}
+
+// buildErrorPageContents generates the contents of the error pages of the ErrorPagePolicies that are
+// applied to the Gateway or its routes.
+func buildErrorPageContents(g *graph.Graph) map[ErrorPageID][]byte {
+	contents := make(map[ErrorPageID][]byte)
+
+	addContents := func(policy *graph.ErrorPagePolicy) {
+		if policy == nil {
+			return
+		}
+
+		policyNsName := client.ObjectKeyFromObject(policy.Source)
+		for i, page := range policy.ErrorPages {
+			if page.BackendRef != nil {
+				continue
+			}
+			contents[generateErrorPageID(policyNsName, i)] = page.Content
+		}
+	}
+
+	addContents(g.Gateway.ErrorPagePolicy)
+
+	for _, route := range g.Routes {
+		addContents(route.ErrorPagePolicy)
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+
+	return contents
+}
+
+// generateSnippetName generates the name of the snippet of a SnippetsFilter or of the snippet annotations of
+// an HTTPRoute for an NGINX context. It is guaranteed to be unique per unique kind, namespaced name and context.
+// The name is safe to use as a file name.
+func generateSnippetName(kind v1.Kind, context ngfAPI.NginxContext, owner types.NamespacedName) string {
+	return fmt.Sprintf("%s_%s_%s_%s", kind, context, owner.Namespace, owner.Name)
+}
+
+func newSnippet(sf *graph.SnippetsFilter, context ngfAPI.NginxContext) *Snippet {
+	contents, exists := sf.Snippets[context]
+	if !exists {
+		return nil
+	}
+
+	return &Snippet{
+		Name:     generateSnippetName(graph.SnippetsFilterKind, context, client.ObjectKeyFromObject(sf.Source)),
+		Contents: contents,
+	}
+}
+
+func newRouteSnippet(route *graph.L7Route, context ngfAPI.NginxContext) *Snippet {
+	contents, exists := route.Snippets[context]
+	if !exists {
+		return nil
+	}
+
+	return &Snippet{
+		Name:     generateSnippetName(graph.HTTPRouteKind, context, client.ObjectKeyFromObject(route.Source)),
+		Contents: contents,
+	}
+}
+
+// addRouteSnippets adds the server and location snippets of the snippet annotations of the route to the HTTPFilters.
+func addRouteSnippets(route *graph.L7Route, result *HTTPFilters) {
+	serverSnippet := newRouteSnippet(route, ngfAPI.NginxContextHTTPServer)
+	locationSnippet := newRouteSnippet(route, ngfAPI.NginxContextHTTPServerLocation)
+
+	if serverSnippet == nil && locationSnippet == nil {
+		return
+	}
+
+	result.SnippetsFilters = append(result.SnippetsFilters, SnippetsFilter{
+		ServerSnippet:   serverSnippet,
+		LocationSnippet: locationSnippet,
+	})
+}
+
+// extensionRefFilterConverters hold the functions that add the filter resources referenced by ExtensionRef filters
+// to the HTTPFilters, by kind. To support a new NGF filter CRD, add a converter for its kind.
+var extensionRefFilterConverters = map[v1.Kind]func(filter graph.ExtensionRefFilter, result *HTTPFilters){
+	graph.SnippetsFilterKind: addSnippetsFilter,
+}
+
+// addExtensionRefFilters adds the filter resources referenced by the ExtensionRef filters of a route rule
+// to the HTTPFilters.
+func addExtensionRefFilters(filters []graph.ExtensionRefFilter, result *HTTPFilters) {
+	for _, filter := range filters {
+		if convert, exists := extensionRefFilterConverters[filter.Kind]; exists {
+			convert(filter, result)
+		}
+	}
+}
+
+// addSnippetsFilter adds the server and location snippets of the SnippetsFilter to the HTTPFilters.
+func addSnippetsFilter(filter graph.ExtensionRefFilter, result *HTTPFilters) {
+	sf, ok := filter.Filter.(*graph.SnippetsFilter)
+	if !ok {
+		return
+	}
+
+	result.SnippetsFilters = append(result.SnippetsFilters, SnippetsFilter{
+		ServerSnippet:   newSnippet(sf, ngfAPI.NginxContextHTTPServer),
+		LocationSnippet: newSnippet(sf, ngfAPI.NginxContextHTTPServerLocation),
+	})
+}
+
+// buildSnippets builds the main and http snippets of the SnippetsFilters that are referenced by
+// the valid rules of the routes attached to the valid listeners, and of the snippet annotations of those routes.
+func buildSnippets(listeners []*graph.Listener) (mainSnippets, httpSnippets []Snippet) {
+	referencedFilters := make(map[types.NamespacedName]*graph.SnippetsFilter)
+	annotatedRoutes := make(map[types.NamespacedName]*graph.L7Route)
+
+	for _, l := range listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, route := range l.Routes {
+			if !route.Valid {
+				continue
+			}
+
+			if len(route.Snippets) > 0 {
+				annotatedRoutes[client.ObjectKeyFromObject(route.Source)] = route
+			}
+
+			for _, rule := range route.Spec.Rules {
+				if !rule.ValidMatches || !rule.ValidFilters {
+					continue
+				}
+
+				for _, filter := range rule.ExtensionRefFilters {
+					if sf, ok := filter.Filter.(*graph.SnippetsFilter); ok {
+						referencedFilters[filter.NsName] = sf
+					}
+				}
+			}
+		}
+	}
+
+	filterNsNames := make([]types.NamespacedName, 0, len(referencedFilters))
+	for nsname := range referencedFilters {
+		filterNsNames = append(filterNsNames, nsname)
+	}
+
+	// sort the filters so that the order of the snippets in the configuration is stable
+	sort.Slice(filterNsNames, func(i, j int) bool {
+		return filterNsNames[i].String() < filterNsNames[j].String()
+	})
+
+	for _, nsname := range filterNsNames {
+		sf := referencedFilters[nsname]
+
+		if snippet := newSnippet(sf, ngfAPI.NginxContextMain); snippet != nil {
+			mainSnippets = append(mainSnippets, *snippet)
+		}
+
+		if snippet := newSnippet(sf, ngfAPI.NginxContextHTTP); snippet != nil {
+			httpSnippets = append(httpSnippets, *snippet)
+		}
+	}
+
+	routeNsNames := make([]types.NamespacedName, 0, len(annotatedRoutes))
+	for nsname := range annotatedRoutes {
+		routeNsNames = append(routeNsNames, nsname)
+	}
+
+	sort.Slice(routeNsNames, func(i, j int) bool {
+		return routeNsNames[i].String() < routeNsNames[j].String()
+	})
+
+	for _, nsname := range routeNsNames {
+		route := annotatedRoutes[nsname]
+
+		if snippet := newRouteSnippet(route, ngfAPI.NginxContextMain); snippet != nil {
+			mainSnippets = append(mainSnippets, *snippet)
+		}
+
+		if snippet := newRouteSnippet(route, ngfAPI.NginxContextHTTP); snippet != nil {
+			httpSnippets = append(httpSnippets, *snippet)
+		}
+	}
+
+	return mainSnippets, httpSnippets
+}
+
 // buildTelemetry generates the Otel configuration.
 func buildTelemetry(g *graph.Graph) Telemetry {
 	if g.NginxProxy == nil || g.NginxProxy.Spec.Telemetry == nil || g.NginxProxy.Spec.Telemetry.Exporter == nil {
@@ -614,3 +1029,94 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 
 	return tel
 }
+
+// buildDNSResolver generates the DNS resolver configuration.
+func buildDNSResolver(g *graph.Graph) *DNSResolver {
+	if g.NginxProxy == nil || g.NginxProxy.Spec.DNSResolver == nil {
+		return nil
+	}
+
+	dnsResolver := g.NginxProxy.Spec.DNSResolver
+
+	addresses := make([]string, 0, len(dnsResolver.Addresses))
+	for _, addr := range dnsResolver.Addresses {
+		addresses = append(addresses, string(addr))
+	}
+
+	result := &DNSResolver{
+		Addresses: addresses,
+	}
+
+	if dnsResolver.Valid != nil {
+		result.Valid = string(*dnsResolver.Valid)
+	}
+	if dnsResolver.Timeout != nil {
+		result.Timeout = string(*dnsResolver.Timeout)
+	}
+	if dnsResolver.DisableIPv6 != nil {
+		result.DisableIPv6 = *dnsResolver.DisableIPv6
+	}
+
+	return result
+}
+
+// buildCompression generates the default compression configuration.
+func buildCompression(g *graph.Graph) *Compression {
+	compression := getNginxProxyCompression(g)
+	if compression == nil {
+		return nil
+	}
+
+	return convertCompression(compression)
+}
+
+// getNginxProxyCompression returns the default compression settings of the NginxProxy, if they exist.
+func getNginxProxyCompression(g *graph.Graph) *ngfAPI.Compression {
+	if g.NginxProxy == nil {
+		return nil
+	}
+
+	return g.NginxProxy.Spec.Compression
+}
+
+// mergeCompression returns the compression settings of a CompressionPolicy, with the settings that
+// are not specified inherited from the default settings of the NginxProxy.
+func mergeCompression(compression, defaults *ngfAPI.Compression) *ngfAPI.Compression {
+	merged := compression.DeepCopy()
+	if defaults == nil {
+		return merged
+	}
+
+	if merged.Enable == nil {
+		merged.Enable = defaults.Enable
+	}
+	if merged.Brotli == nil {
+		merged.Brotli = defaults.Brotli
+	}
+	if merged.MinLength == nil {
+		merged.MinLength = defaults.MinLength
+	}
+	if merged.Level == nil {
+		merged.Level = defaults.Level
+	}
+	if len(merged.MIMETypes) == 0 {
+		merged.MIMETypes = defaults.MIMETypes
+	}
+
+	return merged
+}
+
+func convertCompression(compression *ngfAPI.Compression) *Compression {
+	mimeTypes := make([]string, 0, len(compression.MIMETypes))
+	for _, mimeType := range compression.MIMETypes {
+		mimeTypes = append(mimeTypes, string(mimeType))
+	}
+
+	return &Compression{
+		Enabled:   compression.Enable == nil || *compression.Enable,
+		Brotli:    compression.Brotli,
+		MIMETypes: mimeTypes,
+		MinLength: compression.MinLength,
+		Level:     compression.Level,
+	}
+}
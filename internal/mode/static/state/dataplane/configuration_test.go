@@ -218,6 +218,23 @@ func TestBuildConfiguration(t *testing.T) {
 		pathAndType{path: "/", pathType: prefix}, pathAndType{path: invalidFiltersPath, pathType: prefix},
 	)
 
+	hrCompression, expHRCompressionGroups, routeHRCompression := createTestResources(
+		"hr-compression",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+	routeHRCompression.CompressionPolicy = &graph.CompressionPolicy{
+		Source: &ngfAPI.CompressionPolicy{
+			Spec: ngfAPI.CompressionPolicySpec{
+				Compression: ngfAPI.Compression{
+					Level: helpers.GetPointer[int32](6),
+				},
+			},
+		},
+		Valid: true,
+	}
+
 	redirect := v1.HTTPRouteFilter{
 		Type: v1.HTTPRouteFilterRequestRedirect,
 		RequestRedirect: &v1.HTTPRequestRedirectFilter{
@@ -1978,13 +1995,89 @@ func TestBuildConfiguration(t *testing.T) {
 			},
 			msg: "NginxProxy with tracing config",
 		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{},
+					Listeners: []*graph.Listener{
+						{
+							Name:   "listener-80-1",
+							Source: listener80,
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(hrCompression): routeHRCompression,
+							},
+						},
+					},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hrCompression): routeHRCompression,
+				},
+				NginxProxy: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Compression: &ngfAPI.Compression{
+							Enable:    helpers.GetPointer(false),
+							Brotli:    helpers.GetPointer(true),
+							MIMETypes: []ngfAPI.MIMEType{"application/json"},
+							Level:     helpers.GetPointer[int32](4),
+						},
+					},
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										Source:       &hrCompression.ObjectMeta,
+										BackendGroup: expHRCompressionGroups[0],
+										Compression: &Compression{
+											Enabled:   false,
+											Brotli:    helpers.GetPointer(true),
+											MIMETypes: []string{"application/json"},
+											Level:     helpers.GetPointer[int32](6),
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHRCompressionGroups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				CertBundles:   map[CertBundleID]CertBundle{},
+				Compression: &Compression{
+					Enabled:   false,
+					Brotli:    helpers.GetPointer(true),
+					MIMETypes: []string{"application/json"},
+					Level:     helpers.GetPointer[int32](4),
+				},
+			},
+			msg: "NginxProxy with compression config and route with compression policy",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.msg, func(t *testing.T) {
 			g := NewWithT(t)
 
-			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver, 1)
+			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver, 1, "")
 
 			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
 			g.Expect(result.Upstreams).To(ConsistOf(test.expConf.Upstreams))
@@ -1994,6 +2087,7 @@ func TestBuildConfiguration(t *testing.T) {
 			g.Expect(result.Version).To(Equal(1))
 			g.Expect(result.CertBundles).To(Equal(test.expConf.CertBundles))
 			g.Expect(result.Telemetry).To(Equal(test.expConf.Telemetry))
+			g.Expect(result.Compression).To(Equal(test.expConf.Compression))
 		})
 	}
 }
@@ -2302,6 +2396,22 @@ func TestBuildUpstreams(t *testing.T) {
 
 	invalidHRRefs := createBackendRefs("abc")
 
+	// the resolver is not called for backends with hostnames
+	hostnameRefs := []graph.BackendRef{
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "external"},
+			Hostname:    "api.example.com",
+			ServicePort: apiv1.ServicePort{Port: 443},
+			Valid:       true,
+		},
+		{
+			HostnameBackendNsName: types.NamespacedName{Namespace: "test", Name: "hb"},
+			Hostname:              "other.example.com",
+			ServicePort:           apiv1.ServicePort{Port: 8443},
+			Valid:                 true,
+		},
+	}
+
 	routes := map[graph.RouteKey]*graph.L7Route{
 		{NamespacedName: types.NamespacedName{Name: "hr1", Namespace: "test"}}: {
 			Valid: true,
@@ -2318,11 +2428,32 @@ func TestBuildUpstreams(t *testing.T) {
 		{NamespacedName: types.NamespacedName{Name: "hr3", Namespace: "test"}}: {
 			Valid: true,
 			Spec: graph.L7RouteSpec{
-				Rules: refsToValidRules(hr3Refs0),
+				Rules: refsToValidRules(hr3Refs0, hostnameRefs),
 			},
 		},
 	}
 
+	createErrorPagePolicy := func(svcName string) *graph.ErrorPagePolicy {
+		return &graph.ErrorPagePolicy{
+			ErrorPages: []graph.ErrorPage{
+				{Codes: []int32{404}, Content: []byte("not found")},
+				{
+					Codes: []int32{503},
+					BackendRef: &graph.BackendRef{
+						SvcNsName:   types.NamespacedName{Namespace: "test", Name: svcName},
+						ServicePort: apiv1.ServicePort{Port: 80},
+						Valid:       true,
+					},
+					Path: "/",
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	routes[graph.RouteKey{NamespacedName: types.NamespacedName{Name: "hr1", Namespace: "test"}}].ErrorPagePolicy =
+		createErrorPagePolicy("errors")
+
 	routes2 := map[graph.RouteKey]*graph.L7Route{
 		{NamespacedName: types.NamespacedName{Name: "hr4", Namespace: "test"}}: {
 			Valid: true,
@@ -2381,6 +2512,14 @@ func TestBuildUpstreams(t *testing.T) {
 			Name:      "test_bar_80",
 			Endpoints: barEndpoints,
 		},
+		{
+			Name:      "test_errors_80",
+			Endpoints: fooEndpoints,
+		},
+		{
+			Name:      "test_gw-errors_80",
+			Endpoints: barEndpoints,
+		},
 		{
 			Name:      "test_baz2_80",
 			Endpoints: baz2Endpoints,
@@ -2403,6 +2542,26 @@ func TestBuildUpstreams(t *testing.T) {
 			Endpoints: nil,
 			ErrorMsg:  nilEndpointsErrMsg,
 		},
+		{
+			Name: "test_external_443",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "api.example.com",
+					Port:    443,
+					Resolve: true,
+				},
+			},
+		},
+		{
+			Name: "test_hb_hostname_8443",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "other.example.com",
+					Port:    8443,
+					Resolve: true,
+				},
+			},
+		},
 	}
 
 	fakeResolver := &resolverfakes.FakeServiceResolver{}
@@ -2426,6 +2585,10 @@ func TestBuildUpstreams(t *testing.T) {
 			return nil, errors.New(nilEndpointsErrMsg)
 		case "abc":
 			return abcEndpoints, nil
+		case "errors":
+			return fooEndpoints, nil
+		case "gw-errors":
+			return barEndpoints, nil
 		default:
 			return nil, fmt.Errorf("unexpected service %s", svcNsName.Name)
 		}
@@ -2433,7 +2596,13 @@ func TestBuildUpstreams(t *testing.T) {
 
 	g := NewWithT(t)
 
-	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver)
+	upstreams := buildUpstreams(
+		context.TODO(),
+		&graph.Gateway{Listeners: listeners, ErrorPagePolicy: createErrorPagePolicy("gw-errors")},
+		fakeResolver,
+		"",
+		ngfAPI.TerminatingEndpointsPolicyRemove,
+	)
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
 }
 
@@ -2710,3 +2879,791 @@ func TestBuildTelemetry(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDNSResolver(t *testing.T) {
+	tests := []struct {
+		g              *graph.Graph
+		expDNSResolver *DNSResolver
+		msg            string
+	}{
+		{
+			g:              &graph.Graph{},
+			expDNSResolver: nil,
+			msg:            "no NginxProxy",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{},
+			},
+			expDNSResolver: nil,
+			msg:            "no DNS resolver configured",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						DNSResolver: &ngfAPI.DNSResolver{
+							Addresses:   []ngfAPI.ResolverAddress{"10.96.0.10", "[fd00::10]:53"},
+							Valid:       helpers.GetPointer[ngfAPI.Duration]("30s"),
+							Timeout:     helpers.GetPointer[ngfAPI.Duration]("5s"),
+							DisableIPv6: helpers.GetPointer(true),
+						},
+					},
+				},
+			},
+			expDNSResolver: &DNSResolver{
+				Addresses:   []string{"10.96.0.10", "[fd00::10]:53"},
+				Valid:       "30s",
+				Timeout:     "5s",
+				DisableIPv6: true,
+			},
+			msg: "DNS resolver configured",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						DNSResolver: &ngfAPI.DNSResolver{
+							Addresses: []ngfAPI.ResolverAddress{"10.96.0.10"},
+						},
+					},
+				},
+			},
+			expDNSResolver: &DNSResolver{
+				Addresses: []string{"10.96.0.10"},
+			},
+			msg: "DNS resolver with only addresses",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(buildDNSResolver(tc.g)).To(Equal(tc.expDNSResolver))
+		})
+	}
+}
+
+func TestBuildCompression(t *testing.T) {
+	tests := []struct {
+		g              *graph.Graph
+		expCompression *Compression
+		msg            string
+	}{
+		{
+			g:              &graph.Graph{},
+			expCompression: nil,
+			msg:            "no NginxProxy",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{},
+			},
+			expCompression: nil,
+			msg:            "no compression configured",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Compression: &ngfAPI.Compression{
+							Enable:    helpers.GetPointer(true),
+							MIMETypes: []ngfAPI.MIMEType{"application/json", "text/css"},
+							MinLength: helpers.GetPointer[int32](1024),
+							Level:     helpers.GetPointer[int32](6),
+						},
+					},
+				},
+			},
+			expCompression: &Compression{
+				Enabled:   true,
+				MIMETypes: []string{"application/json", "text/css"},
+				MinLength: helpers.GetPointer[int32](1024),
+				Level:     helpers.GetPointer[int32](6),
+			},
+			msg: "compression configured",
+		},
+		{
+			g: &graph.Graph{
+				NginxProxy: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Compression: &ngfAPI.Compression{
+							Enable: helpers.GetPointer(false),
+						},
+					},
+				},
+			},
+			expCompression: &Compression{
+				Enabled:   false,
+				MIMETypes: []string{},
+			},
+			msg: "compression disabled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			compression := buildCompression(tc.g)
+			g.Expect(compression).To(Equal(tc.expCompression))
+		})
+	}
+}
+
+func TestMergeCompression(t *testing.T) {
+	defaults := &ngfAPI.Compression{
+		Enable:    helpers.GetPointer(false),
+		Brotli:    helpers.GetPointer(true),
+		MinLength: helpers.GetPointer[int32](256),
+		Level:     helpers.GetPointer[int32](4),
+		MIMETypes: []ngfAPI.MIMEType{"application/json"},
+	}
+
+	tests := []struct {
+		compression *ngfAPI.Compression
+		defaults    *ngfAPI.Compression
+		expected    *ngfAPI.Compression
+		msg         string
+	}{
+		{
+			compression: &ngfAPI.Compression{
+				Level: helpers.GetPointer[int32](6),
+			},
+			defaults: nil,
+			expected: &ngfAPI.Compression{
+				Level: helpers.GetPointer[int32](6),
+			},
+			msg: "no defaults",
+		},
+		{
+			compression: &ngfAPI.Compression{},
+			defaults:    defaults,
+			expected:    defaults,
+			msg:         "all settings inherited",
+		},
+		{
+			compression: &ngfAPI.Compression{
+				Enable:    helpers.GetPointer(true),
+				Brotli:    helpers.GetPointer(false),
+				MinLength: helpers.GetPointer[int32](0),
+				Level:     helpers.GetPointer[int32](9),
+				MIMETypes: []ngfAPI.MIMEType{"text/css"},
+			},
+			defaults: defaults,
+			expected: &ngfAPI.Compression{
+				Enable:    helpers.GetPointer(true),
+				Brotli:    helpers.GetPointer(false),
+				MinLength: helpers.GetPointer[int32](0),
+				Level:     helpers.GetPointer[int32](9),
+				MIMETypes: []ngfAPI.MIMEType{"text/css"},
+			},
+			msg: "all settings overridden",
+		},
+		{
+			compression: &ngfAPI.Compression{
+				Level: helpers.GetPointer[int32](6),
+			},
+			defaults: defaults,
+			expected: &ngfAPI.Compression{
+				Enable:    helpers.GetPointer(false),
+				Brotli:    helpers.GetPointer(true),
+				MinLength: helpers.GetPointer[int32](256),
+				Level:     helpers.GetPointer[int32](6),
+				MIMETypes: []ngfAPI.MIMEType{"application/json"},
+			},
+			msg: "some settings inherited",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(mergeCompression(tc.compression, tc.defaults)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestBuildErrorPages(t *testing.T) {
+	gwPolicy := &graph.ErrorPagePolicy{
+		Source: &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw-pages", Namespace: "test"},
+		},
+		ErrorPages: []graph.ErrorPage{
+			{
+				Codes:        []int32{404},
+				ResponseCode: helpers.GetPointer[int32](200),
+				ContentType:  "text/html",
+				Content:      []byte("not found"),
+			},
+			{
+				Codes:       []int32{500, 502},
+				ContentType: "application/json",
+				Content:     []byte(`{"error":"internal"}`),
+			},
+		},
+		Valid: true,
+	}
+
+	routePolicy := &graph.ErrorPagePolicy{
+		Source: &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "route-pages", Namespace: "test"},
+		},
+		ErrorPages: []graph.ErrorPage{
+			{
+				Codes:       []int32{503},
+				ContentType: "text/plain",
+				Content:     []byte("unavailable"),
+			},
+			{
+				Codes:       []int32{504},
+				ContentType: "text/html",
+				BackendRef: &graph.BackendRef{
+					SvcNsName:   types.NamespacedName{Namespace: "test", Name: "errors"},
+					ServicePort: apiv1.ServicePort{Port: 80},
+					Valid:       true,
+				},
+				Path: "/504.html",
+			},
+		},
+		Valid: true,
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildErrorPages(nil)).To(BeNil())
+	g.Expect(buildErrorPages(gwPolicy)).To(Equal([]ErrorPage{
+		{
+			ID:           "error_page_test_gw-pages_0",
+			Codes:        []int32{404},
+			ResponseCode: helpers.GetPointer[int32](200),
+			ContentType:  "text/html",
+		},
+		{
+			ID:          "error_page_test_gw-pages_1",
+			Codes:       []int32{500, 502},
+			ContentType: "application/json",
+		},
+	}))
+	g.Expect(buildErrorPages(routePolicy)).To(Equal([]ErrorPage{
+		{
+			ID:          "error_page_test_route-pages_0",
+			Codes:       []int32{503},
+			ContentType: "text/plain",
+		},
+		{
+			ID:           "error_page_test_route-pages_1",
+			Codes:        []int32{504},
+			ContentType:  "text/html",
+			UpstreamName: "test_errors_80",
+			Path:         "/504.html",
+		},
+	}))
+
+	graphWithPolicies := &graph.Graph{
+		Gateway: &graph.Gateway{ErrorPagePolicy: gwPolicy},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"}}: {
+				ErrorPagePolicy: routePolicy,
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-no-policy"}}: {},
+		},
+	}
+
+	g.Expect(buildErrorPageContents(graphWithPolicies)).To(Equal(map[ErrorPageID][]byte{
+		"error_page_test_gw-pages_0":    []byte("not found"),
+		"error_page_test_gw-pages_1":    []byte(`{"error":"internal"}`),
+		"error_page_test_route-pages_0": []byte("unavailable"),
+	}))
+	g.Expect(buildErrorPageContents(&graph.Graph{Gateway: &graph.Gateway{}})).To(BeNil())
+}
+
+func TestBuildSnippets(t *testing.T) {
+	sf1 := &graph.SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Name: "sf1", Namespace: "test"}},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextMain:               "worker_priority 0;",
+			ngfAPI.NginxContextHTTP:               "log_format one '$remote_addr';",
+			ngfAPI.NginxContextHTTPServer:         "client_max_body_size 10m;",
+			ngfAPI.NginxContextHTTPServerLocation: "add_header X-Test test;",
+		},
+		Valid: true,
+	}
+	sf2 := &graph.SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Name: "sf2", Namespace: "test"}},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextHTTP: "log_format two '$remote_addr';",
+		},
+		Valid: true,
+	}
+	unusedFilter := &graph.SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Name: "unused", Namespace: "test"}},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextMain: "worker_priority 1;",
+		},
+		Valid: true,
+	}
+
+	extRef := func(sf *graph.SnippetsFilter) graph.ExtensionRefFilter {
+		return graph.ExtensionRefFilter{
+			Filter: sf,
+			NsName: types.NamespacedName{Namespace: sf.Source.Namespace, Name: sf.Source.Name},
+			Kind:   graph.SnippetsFilterKind,
+			Valid:  true,
+		}
+	}
+
+	g := NewWithT(t)
+
+	var filters HTTPFilters
+	addExtensionRefFilters(nil, &filters)
+	g.Expect(filters.SnippetsFilters).To(BeNil())
+
+	addExtensionRefFilters([]graph.ExtensionRefFilter{extRef(sf1), extRef(sf2)}, &filters)
+	g.Expect(filters.SnippetsFilters).To(Equal([]SnippetsFilter{
+		{
+			ServerSnippet: &Snippet{
+				Name:     "SnippetsFilter_http.server_test_sf1",
+				Contents: "client_max_body_size 10m;",
+			},
+			LocationSnippet: &Snippet{
+				Name:     "SnippetsFilter_http.server.location_test_sf1",
+				Contents: "add_header X-Test test;",
+			},
+		},
+		{},
+	}))
+
+	// a filter of the SnippetsFilter kind without a SnippetsFilter is ignored
+	var mismatchedFilters HTTPFilters
+	addExtensionRefFilters([]graph.ExtensionRefFilter{{Kind: graph.SnippetsFilterKind}}, &mismatchedFilters)
+	g.Expect(mismatchedFilters.SnippetsFilters).To(BeNil())
+
+	annotatedRoute := &graph.L7Route{
+		Source: &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "hr2", Namespace: "test"}},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextMain:       "worker_rlimit_nofile 1024;",
+			ngfAPI.NginxContextHTTP:       "log_format three '$remote_addr';",
+			ngfAPI.NginxContextHTTPServer: "server_tokens off;",
+		},
+		Valid: true,
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					ValidMatches:        true,
+					ValidFilters:        true,
+					ExtensionRefFilters: []graph.ExtensionRefFilter{extRef(sf1)},
+				},
+			},
+		},
+	}
+
+	filters = HTTPFilters{}
+	addRouteSnippets(&graph.L7Route{}, &filters)
+	g.Expect(filters.SnippetsFilters).To(BeNil())
+
+	addRouteSnippets(annotatedRoute, &filters)
+	g.Expect(filters.SnippetsFilters).To(Equal([]SnippetsFilter{
+		{
+			ServerSnippet: &Snippet{
+				Name:     "HTTPRoute_http.server_test_hr2",
+				Contents: "server_tokens off;",
+			},
+		},
+	}))
+
+	listeners := []*graph.Listener{
+		{
+			Valid: true,
+			Routes: map[graph.RouteKey]*graph.L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr1"}}: {
+					Valid: true,
+					Spec: graph.L7RouteSpec{
+						Rules: []graph.RouteRule{
+							{
+								ValidMatches:        true,
+								ValidFilters:        true,
+								ExtensionRefFilters: []graph.ExtensionRefFilter{extRef(sf2), extRef(sf1)},
+							},
+						},
+					},
+				},
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr2"}}: annotatedRoute,
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "invalid"}}: {
+					Valid: false,
+					Spec: graph.L7RouteSpec{
+						Rules: []graph.RouteRule{
+							{
+								ValidMatches:        true,
+								ValidFilters:        true,
+								ExtensionRefFilters: []graph.ExtensionRefFilter{extRef(unusedFilter)},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Valid: false,
+			Routes: map[graph.RouteKey]*graph.L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr3"}}: {
+					Valid: true,
+					Spec: graph.L7RouteSpec{
+						Rules: []graph.RouteRule{
+							{
+								ValidMatches:        true,
+								ValidFilters:        true,
+								ExtensionRefFilters: []graph.ExtensionRefFilter{extRef(unusedFilter)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	mainSnippets, httpSnippets := buildSnippets(listeners)
+	g.Expect(mainSnippets).To(Equal([]Snippet{
+		{
+			Name:     "SnippetsFilter_main_test_sf1",
+			Contents: "worker_priority 0;",
+		},
+		{
+			Name:     "HTTPRoute_main_test_hr2",
+			Contents: "worker_rlimit_nofile 1024;",
+		},
+	}))
+	g.Expect(httpSnippets).To(Equal([]Snippet{
+		{
+			Name:     "SnippetsFilter_http_test_sf1",
+			Contents: "log_format one '$remote_addr';",
+		},
+		{
+			Name:     "SnippetsFilter_http_test_sf2",
+			Contents: "log_format two '$remote_addr';",
+		},
+		{
+			Name:     "HTTPRoute_http_test_hr2",
+			Contents: "log_format three '$remote_addr';",
+		},
+	}))
+
+	mainSnippets, httpSnippets = buildSnippets(nil)
+	g.Expect(mainSnippets).To(BeNil())
+	g.Expect(httpSnippets).To(BeNil())
+}
+
+func TestGetPreferredZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np       *ngfAPI.NginxProxy
+		msg      string
+		expected string
+	}{
+		{
+			msg:      "no NginxProxy",
+			expected: "",
+		},
+		{
+			msg:      "topology-aware routing not configured",
+			np:       &ngfAPI.NginxProxy{},
+			expected: "",
+		},
+		{
+			msg: "topology-aware routing disabled",
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TopologyAwareRouting: &ngfAPI.TopologyAwareRouting{Mode: ngfAPI.TopologyModeDisabled},
+				},
+			},
+			expected: "",
+		},
+		{
+			msg: "prefer same zone",
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TopologyAwareRouting: &ngfAPI.TopologyAwareRouting{Mode: ngfAPI.TopologyModePreferSameZone},
+				},
+			},
+			expected: "zone-a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			zone := getPreferredZone(&graph.Graph{NginxProxy: test.np}, "zone-a")
+			g.Expect(zone).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestPreferZone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg      string
+		eps      []resolver.Endpoint
+		expected []resolver.Endpoint
+	}{
+		{
+			msg:      "no endpoints",
+			eps:      nil,
+			expected: nil,
+		},
+		{
+			msg: "endpoints in zone",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a"},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-b"},
+				{Address: "10.0.0.3", Port: 80},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a"},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-b", Backup: true},
+				{Address: "10.0.0.3", Port: 80, Backup: true},
+			},
+		},
+		{
+			msg: "no endpoints in zone",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b"},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-c"},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b"},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-c"},
+			},
+		},
+		{
+			msg: "all endpoints have hints",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b", ZoneHints: []string{"zone-a"}},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a", ZoneHints: []string{"zone-b"}},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b", ZoneHints: []string{"zone-a"}},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a", ZoneHints: []string{"zone-b"}, Backup: true},
+			},
+		},
+		{
+			msg: "terminating endpoints",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a", Terminating: true, Backup: true},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a"},
+				{Address: "10.0.0.3", Port: 80, Zone: "zone-b"},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a", Terminating: true, Backup: true},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a"},
+				{Address: "10.0.0.3", Port: 80, Zone: "zone-b", Backup: true},
+			},
+		},
+		{
+			msg: "only terminating endpoints in zone",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a", Terminating: true, Drain: true},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-b"},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-a", Terminating: true, Drain: true},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-b"},
+			},
+		},
+		{
+			msg: "some endpoints have no hints",
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b", ZoneHints: []string{"zone-a"}},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a"},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, Zone: "zone-b", ZoneHints: []string{"zone-a"}, Backup: true},
+				{Address: "10.0.0.2", Port: 80, Zone: "zone-a"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(preferZone(test.eps, "zone-a")).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetTerminatingEndpointsPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np       *ngfAPI.NginxProxy
+		msg      string
+		expected ngfAPI.TerminatingEndpointsPolicy
+	}{
+		{
+			msg:      "no NginxProxy",
+			expected: ngfAPI.TerminatingEndpointsPolicyRemove,
+		},
+		{
+			msg:      "terminating endpoints not configured",
+			np:       &ngfAPI.NginxProxy{},
+			expected: ngfAPI.TerminatingEndpointsPolicyRemove,
+		},
+		{
+			msg: "drain",
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TerminatingEndpoints: &ngfAPI.TerminatingEndpoints{
+						Policy: ngfAPI.TerminatingEndpointsPolicyDrain,
+					},
+				},
+			},
+			expected: ngfAPI.TerminatingEndpointsPolicyDrain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := getTerminatingEndpointsPolicy(&graph.Graph{NginxProxy: test.np})
+			g.Expect(policy).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestApplyTerminatingEndpointsPolicy(t *testing.T) {
+	t.Parallel()
+
+	eps := []resolver.Endpoint{
+		{Address: "10.0.0.1", Port: 80},
+		{Address: "10.0.0.2", Port: 80, Terminating: true},
+	}
+
+	allTerminatingEps := []resolver.Endpoint{
+		{Address: "10.0.0.1", Port: 80, Terminating: true},
+		{Address: "10.0.0.2", Port: 80, Terminating: true},
+	}
+
+	tests := []struct {
+		msg      string
+		policy   ngfAPI.TerminatingEndpointsPolicy
+		eps      []resolver.Endpoint
+		expected []resolver.Endpoint
+	}{
+		{
+			msg:    "no terminating endpoints",
+			policy: ngfAPI.TerminatingEndpointsPolicyBackup,
+			eps: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+		},
+		{
+			msg:    "remove",
+			policy: ngfAPI.TerminatingEndpointsPolicyRemove,
+			eps:    eps,
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+		},
+		{
+			msg:    "backup",
+			policy: ngfAPI.TerminatingEndpointsPolicyBackup,
+			eps:    eps,
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80, Terminating: true, Backup: true},
+			},
+		},
+		{
+			msg:    "drain",
+			policy: ngfAPI.TerminatingEndpointsPolicyDrain,
+			eps:    eps,
+			expected: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80, Terminating: true, Drain: true},
+			},
+		},
+		{
+			msg:      "backup; all endpoints are terminating",
+			policy:   ngfAPI.TerminatingEndpointsPolicyBackup,
+			eps:      allTerminatingEps,
+			expected: allTerminatingEps,
+		},
+		{
+			msg:      "drain; all endpoints are terminating",
+			policy:   ngfAPI.TerminatingEndpointsPolicyDrain,
+			eps:      allTerminatingEps,
+			expected: allTerminatingEps,
+		},
+		{
+			msg:      "remove; all endpoints are terminating",
+			policy:   ngfAPI.TerminatingEndpointsPolicyRemove,
+			eps:      allTerminatingEps,
+			expected: []resolver.Endpoint{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(applyTerminatingEndpointsPolicy(test.eps, test.policy)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestDynamicUpstreamsEnabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np       *ngfAPI.NginxProxy
+		msg      string
+		expected bool
+	}{
+		{
+			msg:      "no NginxProxy",
+			expected: false,
+		},
+		{
+			msg:      "dynamic upstreams not configured",
+			np:       &ngfAPI.NginxProxy{},
+			expected: false,
+		},
+		{
+			msg: "dynamic upstreams disabled",
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DynamicUpstreams: helpers.GetPointer(false),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "dynamic upstreams enabled",
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DynamicUpstreams: helpers.GetPointer(true),
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(dynamicUpstreamsEnabled(&graph.Graph{NginxProxy: test.np})).To(Equal(test.expected))
+		})
+	}
+}
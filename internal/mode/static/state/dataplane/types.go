@@ -33,10 +33,27 @@ type Configuration struct {
 	Upstreams []Upstream
 	// BackendGroups holds all unique BackendGroups.
 	BackendGroups []BackendGroup
+	// Compression holds the default compression configuration. If nil, compression is not configured.
+	Compression *Compression
+	// DNSResolver holds the DNS resolver configuration. If nil, the DNS resolver is not configured.
+	DNSResolver *DNSResolver
+	// ErrorPageContents holds the content of all unique ErrorPages that aren't served by an upstream.
+	ErrorPageContents map[ErrorPageID][]byte
+	// ErrorPages holds the error pages of the Gateway. They apply to all servers.
+	ErrorPages []ErrorPage
+	// MainSnippets holds the snippets of the SnippetsFilters and the snippet annotations of HTTPRoutes
+	// for the main context.
+	MainSnippets []Snippet
+	// HTTPSnippets holds the snippets of the SnippetsFilters and the snippet annotations of HTTPRoutes
+	// for the http context.
+	HTTPSnippets []Snippet
 	// Telemetry holds the Otel configuration.
 	Telemetry Telemetry
 	// Version represents the version of the generated configuration.
 	Version int
+	// DynamicUpstreams indicates that NGINX open source chooses the servers of the upstreams from shared memory,
+	// so that the endpoints can be updated without a reload.
+	DynamicUpstreams bool
 }
 
 // SSLKeyPairID is a unique identifier for a SSLKeyPair.
@@ -47,6 +64,10 @@ type SSLKeyPairID string
 // The ID is safe to use as a file name.
 type CertBundleID string
 
+// ErrorPageID is a unique identifier for an ErrorPage.
+// The ID is safe to use as a file name.
+type ErrorPageID string
+
 // CertBundle is a Certificate bundle.
 type CertBundle []byte
 
@@ -114,6 +135,26 @@ type HTTPFilters struct {
 	RequestURLRewrite *HTTPURLRewriteFilter
 	// RequestHeaderModifiers holds the HTTPHeaderFilter.
 	RequestHeaderModifiers *HTTPHeaderFilter
+	// SnippetsFilters holds the snippets of the SnippetsFilters and the snippet annotations of the HTTPRoute
+	// for the server and location contexts.
+	SnippetsFilters []SnippetsFilter
+}
+
+// SnippetsFilter holds the server and location snippets of a SnippetsFilter.
+type SnippetsFilter struct {
+	// ServerSnippet is the snippet for the server context. If nil, the SnippetsFilter doesn't have one.
+	ServerSnippet *Snippet
+	// LocationSnippet is the snippet for the location context. If nil, the SnippetsFilter doesn't have one.
+	LocationSnippet *Snippet
+}
+
+// Snippet is an NGINX configuration snippet.
+type Snippet struct {
+	// Name is the name of the snippet. It is unique per owner (a SnippetsFilter or an HTTPRoute) and context,
+	// and is safe to use as a file name.
+	Name string
+	// Contents is the content of the snippet.
+	Contents string
 }
 
 // HTTPHeader represents an HTTP header.
@@ -198,6 +239,11 @@ type MatchRule struct {
 	Filters HTTPFilters
 	// Source is the ObjectMeta of the resource that includes the rule.
 	Source *metav1.ObjectMeta
+	// Compression holds the compression configuration that overrides the default one. If nil, the default is used.
+	Compression *Compression
+	// ErrorPages holds the error pages of the route. They take precedence over the error pages of the Gateway
+	// for the same status codes.
+	ErrorPages []ErrorPage
 	// Match holds the match for the rule.
 	Match Match
 	// BackendGroup is the group of Backends that the rule routes to.
@@ -277,3 +323,47 @@ type SpanAttribute struct {
 	// Value is the value for a span attribute.
 	Value string
 }
+
+// DNSResolver holds the configuration of the DNS resolver that NGINX uses to resolve the hostnames of
+// upstream servers.
+type DNSResolver struct {
+	// Valid overrides the time NGINX caches resolved addresses. If empty, the TTL of the DNS response is used.
+	Valid string
+	// Timeout is the timeout for name resolution. If empty, the NGINX default is used.
+	Timeout string
+	// Addresses are the addresses of the DNS servers.
+	Addresses []string
+	// DisableIPv6 disables looking up IPv6 addresses.
+	DisableIPv6 bool
+}
+
+// Compression represents gzip and Brotli compression configuration for the dataplane.
+type Compression struct {
+	// MinLength specifies the minimum length of a response that will be compressed.
+	MinLength *int32
+	// Level specifies the gzip compression level.
+	Level *int32
+	// Brotli indicates whether Brotli compression is enabled. If nil, Brotli compression is not configured.
+	Brotli *bool
+	// MIMETypes are the MIME types of the responses to compress in addition to "text/html".
+	MIMETypes []string
+	// Enabled indicates whether compression is enabled.
+	Enabled bool
+}
+
+// ErrorPage is a custom response for a set of status codes.
+type ErrorPage struct {
+	// ResponseCode is the status code of the response. If nil, the original status code is used.
+	ResponseCode *int32
+	// ID is the ID of the content of the error page.
+	ID ErrorPageID
+	// ContentType is the media type of the content.
+	ContentType string
+	// UpstreamName is the name of the upstream that serves the error page.
+	// If empty, the error page is served from its content.
+	UpstreamName string
+	// Path is the path of the error page on the upstream.
+	Path string
+	// Codes are the status codes that are replaced with the error page.
+	Codes []int32
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// The functions in this file run the same validation as building the Graph, so that invalid resources
+// can be rejected at admission time. They only return errors for the resources that the Graph would reject.
+
+// ValidateHTTPRoute validates the hostnames and the rules of an HTTPRoute. Like the Graph, it accepts a route
+// with some invalid rules, as long as at least one rule is valid.
+func ValidateHTTPRoute(validator validation.HTTPFieldsValidator, route *v1.HTTPRoute) field.ErrorList {
+	if allErrs := validateHostnameList(route.Spec.Hostnames, field.NewPath("spec").Child("hostnames")); len(allErrs) > 0 {
+		return allErrs
+	}
+
+	_, atLeastOneValid, rulesErrs := processHTTPRouteRules(route.Spec.Rules, validator)
+	if atLeastOneValid {
+		return nil
+	}
+
+	return rulesErrs
+}
+
+// ValidateGRPCRoute validates the hostnames and the rules of a GRPCRoute. Like the Graph, it accepts a route
+// with some invalid rules, as long as at least one rule is valid.
+func ValidateGRPCRoute(validator validation.HTTPFieldsValidator, route *v1alpha2.GRPCRoute) field.ErrorList {
+	if allErrs := validateHostnameList(route.Spec.Hostnames, field.NewPath("spec").Child("hostnames")); len(allErrs) > 0 {
+		return allErrs
+	}
+
+	_, atLeastOneValid, rulesErrs := processGRPCRouteRules(route.Spec.Rules, validator)
+	if atLeastOneValid {
+		return nil
+	}
+
+	return rulesErrs
+}
+
+// ValidateNginxProxy validates an NginxProxy. Brotli compression is only valid with NGINX Plus.
+func ValidateNginxProxy(validator validation.GenericValidator, np *ngfAPI.NginxProxy, plus bool) field.ErrorList {
+	return append(
+		validateNginxProxy(validator, np),
+		validateBrotli(plus, np.Spec.Compression, field.NewPath("spec").Child("compression"))...,
+	)
+}
+
+// ValidateClientSettingsPolicy validates a ClientSettingsPolicy.
+func ValidateClientSettingsPolicy(
+	validator validation.GenericValidator,
+	pol *ngfAPI.ClientSettingsPolicy,
+) field.ErrorList {
+	return validateClientSettingsPolicy(validator, pol)
+}
+
+// ValidateCompressionPolicy validates a CompressionPolicy. Brotli compression is only valid with NGINX Plus.
+func ValidateCompressionPolicy(
+	validator validation.GenericValidator,
+	pol *ngfAPI.CompressionPolicy,
+	plus bool,
+) field.ErrorList {
+	return append(
+		validateCompressionPolicy(validator, pol),
+		validateBrotli(plus, &pol.Spec.Compression, field.NewPath("spec").Child("compression"))...,
+	)
+}
+
+// ValidateErrorPagePolicy validates an ErrorPagePolicy.
+func ValidateErrorPagePolicy(validator validation.GenericValidator, pol *ngfAPI.ErrorPagePolicy) field.ErrorList {
+	return validateErrorPagePolicy(validator, pol)
+}
+
+// ValidateSnippetsFilter validates a SnippetsFilter. The snippetValidator is optional.
+func ValidateSnippetsFilter(
+	validator validation.GenericValidator,
+	snippetValidator validation.SnippetValidator,
+	sf *ngfAPI.SnippetsFilter,
+) field.ErrorList {
+	return validateSnippetsFilter(validator, snippetValidator, sf)
+}
@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestValidateHTTPRoute(t *testing.T) {
+	validRoute := createHTTPRoute("hr", "gateway", "example.com", "/")
+	validRoute.Spec.Rules[0].BackendRefs = []gatewayv1.HTTPBackendRef{
+		{
+			Filters: []gatewayv1.HTTPRouteFilter{
+				{
+					Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{},
+				},
+			},
+		},
+	}
+
+	invalidPathValidator := &validationfakes.FakeHTTPFieldsValidator{}
+	invalidPathValidator.ValidatePathInMatchReturns(errors.New("invalid path value"))
+
+	partiallyInvalidPathValidator := &validationfakes.FakeHTTPFieldsValidator{}
+	partiallyInvalidPathValidator.ValidatePathInMatchReturnsOnCall(1, errors.New("invalid path value"))
+
+	tests := []struct {
+		route           *gatewayv1.HTTPRoute
+		validator       *validationfakes.FakeHTTPFieldsValidator
+		name            string
+		expErrSubstring string
+		expErrCount     int
+	}{
+		{
+			name:      "valid",
+			route:     validRoute,
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+		},
+		{
+			name:            "invalid hostname",
+			route:           createHTTPRoute("hr", "gateway", "", "/"),
+			validator:       &validationfakes.FakeHTTPFieldsValidator{},
+			expErrSubstring: "spec.hostnames[0]",
+			expErrCount:     1,
+		},
+		{
+			name:            "invalid rules",
+			route:           createHTTPRoute("hr", "gateway", "example.com", "/", "/other"),
+			validator:       invalidPathValidator,
+			expErrSubstring: "spec.rules[1].matches[0].path",
+			expErrCount:     2,
+		},
+		{
+			name:      "some invalid rules",
+			route:     createHTTPRoute("hr", "gateway", "example.com", "/", "/other"),
+			validator: partiallyInvalidPathValidator,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := ValidateHTTPRoute(test.validator, test.route)
+			g.Expect(allErrs).To(HaveLen(test.expErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
+
+func TestValidateGRPCRoute(t *testing.T) {
+	validRule := createGRPCMethodMatch("myService", "myMethod", "Exact")
+	validRule.BackendRefs = []v1alpha2.GRPCBackendRef{
+		{
+			Filters: []v1alpha2.GRPCRouteFilter{
+				{
+					Type: v1alpha2.GRPCRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+						Set: []gatewayv1.HTTPHeader{{Name: "MyHeader", Value: "value"}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		route           *v1alpha2.GRPCRoute
+		name            string
+		expErrSubstring string
+		expErrCount     int
+	}{
+		{
+			name:  "valid",
+			route: createGRPCRoute("gr", "gateway", "example.com", []v1alpha2.GRPCRouteRule{validRule}),
+		},
+		{
+			name: "invalid hostname and rules",
+			route: createGRPCRoute(
+				"gr",
+				"gateway",
+				"*.*.example.com",
+				[]v1alpha2.GRPCRouteRule{createGRPCMethodMatch("", "myMethod", "Exact")},
+			),
+			expErrSubstring: "spec.hostnames[0]",
+			expErrCount:     1,
+		},
+		{
+			name: "invalid rules",
+			route: createGRPCRoute(
+				"gr",
+				"gateway",
+				"example.com",
+				[]v1alpha2.GRPCRouteRule{createGRPCMethodMatch("", "myMethod", "Exact")},
+			),
+			expErrSubstring: "spec.rules[0].matches[0].method",
+			expErrCount:     1,
+		},
+		{
+			name: "some invalid rules",
+			route: createGRPCRoute(
+				"gr",
+				"gateway",
+				"example.com",
+				[]v1alpha2.GRPCRouteRule{validRule, createGRPCMethodMatch("", "myMethod", "Exact")},
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := ValidateGRPCRoute(&validationfakes.FakeHTTPFieldsValidator{}, test.route)
+			g.Expect(allErrs).To(HaveLen(test.expErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
+
+func TestValidateBrotli(t *testing.T) {
+	compression := ngfAPI.Compression{Brotli: helpers.GetPointer(true)}
+
+	np := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-proxy"},
+		Spec:       ngfAPI.NginxProxySpec{Compression: &compression},
+	}
+	pol := &ngfAPI.CompressionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy"},
+		Spec: ngfAPI.CompressionPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: gatewayv1.GroupName,
+				Kind:  "HTTPRoute",
+				Name:  "hr",
+			},
+			Compression: compression,
+		},
+	}
+
+	g := NewWithT(t)
+	validator := &validationfakes.FakeGenericValidator{}
+
+	g.Expect(ValidateNginxProxy(validator, np, true)).To(BeEmpty())
+	g.Expect(ValidateNginxProxy(validator, np, false)).To(ConsistOf(
+		HaveField("Field", "spec.compression.brotli"),
+	))
+
+	g.Expect(ValidateCompressionPolicy(validator, pol, true)).To(BeEmpty())
+	g.Expect(ValidateCompressionPolicy(validator, pol, false)).To(ConsistOf(
+		HaveField("Field", "spec.compression.brotli"),
+	))
+}
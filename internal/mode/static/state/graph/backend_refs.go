@@ -1,28 +1,41 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
+// HostnameBackendKind is the kind of the HostnameBackend resource.
+const HostnameBackendKind gatewayv1.Kind = "HostnameBackend"
+
 // BackendRef is an internal representation of a backendRef in an HTTP/GRPCRoute.
 type BackendRef struct {
 	// BackendTLSPolicy is the BackendTLSPolicy of the Service which is referenced by the backendRef.
 	BackendTLSPolicy *BackendTLSPolicy
 	// SvcNsName is the NamespacedName of the Service referenced by the backendRef.
 	SvcNsName types.NamespacedName
+	// HostnameBackendNsName is the NamespacedName of the HostnameBackend referenced by the backendRef.
+	HostnameBackendNsName types.NamespacedName
+	// Hostname is the DNS hostname that NGINX resolves to find the endpoints of the backend.
+	// It is set for ExternalName Services and HostnameBackends.
+	Hostname string
 	// ServicePort is the ServicePort of the Service which is referenced by the backendRef.
+	// For a HostnameBackend, only the Port is set, to the port of the backendRef.
 	ServicePort v1.ServicePort
 	// Weight is the weight of the backendRef.
 	Weight int32
@@ -36,17 +49,45 @@ func (b BackendRef) ServicePortReference() string {
 	if !b.Valid {
 		return ""
 	}
+
+	if b.HostnameBackendNsName != (types.NamespacedName{}) {
+		return fmt.Sprintf(
+			"%s_%s_hostname_%d",
+			b.HostnameBackendNsName.Namespace,
+			b.HostnameBackendNsName.Name,
+			b.ServicePort.Port,
+		)
+	}
+
 	return fmt.Sprintf("%s_%s_%d", b.SvcNsName.Namespace, b.SvcNsName.Name, b.ServicePort.Port)
 }
 
+// checkDNSResolution returns an error if NGINX can't resolve the hostnames of backends, such as ExternalName
+// Services and HostnameBackends.
+// Resolving upstream servers at runtime requires NGINX Plus and a DNS resolver configured in the NginxProxy.
+func checkDNSResolution(plus bool, npCfg *ngfAPI.NginxProxy) error {
+	if !plus {
+		return errors.New("resolving backend hostnames requires NGINX Plus")
+	}
+
+	if npCfg == nil || npCfg.Spec.DNSResolver == nil {
+		return errors.New("resolving backend hostnames requires a DNS resolver configured in the NginxProxy " +
+			"referenced by the GatewayClass")
+	}
+
+	return nil
+}
+
 func addBackendRefsToRouteRules(
 	routes map[RouteKey]*L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
+	hostnameBackends map[types.NamespacedName]*ngfAPI.HostnameBackend,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	dnsResolutionErr error,
 ) {
 	for _, r := range routes {
-		addBackendRefsToRules(r, refGrantResolver, services, backendTLSPolicies)
+		addBackendRefsToRules(r, refGrantResolver, services, hostnameBackends, backendTLSPolicies, dnsResolutionErr)
 	}
 }
 
@@ -56,7 +97,9 @@ func addBackendRefsToRules(
 	route *L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
+	hostnameBackends map[types.NamespacedName]*ngfAPI.HostnameBackend,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	dnsResolutionErr error,
 ) {
 	if !route.Valid {
 		return
@@ -85,8 +128,10 @@ func addBackendRefsToRules(
 				route.Source.GetNamespace(),
 				refGrantResolver,
 				services,
+				hostnameBackends,
 				refPath,
 				backendTLSPolicies,
+				dnsResolutionErr,
 			)
 
 			backendRefs = append(backendRefs, ref)
@@ -114,8 +159,10 @@ func createBackendRef(
 	sourceNamespace string,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
+	hostnameBackends map[types.NamespacedName]*ngfAPI.HostnameBackend,
 	refPath *field.Path,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	dnsResolutionErr error,
 ) (BackendRef, *conditions.Condition) {
 	// Data plane will handle invalid ref by responding with 500.
 	// Because of that, we always need to add a BackendRef to group.Backends, even if the ref is invalid.
@@ -142,6 +189,17 @@ func createBackendRef(
 		return backendRef, &cond
 	}
 
+	if isHostnameBackendRef(ref.BackendRef) {
+		return createHostnameBackendRef(
+			ref.BackendRef,
+			sourceNamespace,
+			weight,
+			hostnameBackends,
+			refPath,
+			dnsResolutionErr,
+		)
+	}
+
 	svcNsName, svcPort, err := getServiceAndPortFromRef(ref.BackendRef, sourceNamespace, services, refPath)
 	if err != nil {
 		backendRef = BackendRef{
@@ -173,8 +231,27 @@ func createBackendRef(
 		return backendRef, &cond
 	}
 
+	var hostname string
+	if svc := services[svcNsName]; svc.Spec.Type == v1.ServiceTypeExternalName {
+		if dnsResolutionErr != nil {
+			backendRef = BackendRef{
+				SvcNsName:   svcNsName,
+				ServicePort: svcPort,
+				Weight:      weight,
+				Valid:       false,
+			}
+
+			msg := fmt.Sprintf("ExternalName Service %s can't be resolved: %s", svcNsName, dnsResolutionErr)
+			cond := staticConds.NewRouteBackendRefDNSResolutionUnavailable(msg)
+			return backendRef, &cond
+		}
+
+		hostname = svc.Spec.ExternalName
+	}
+
 	backendRef = BackendRef{
 		SvcNsName:        svcNsName,
+		Hostname:         hostname,
 		BackendTLSPolicy: backendTLSPolicy,
 		ServicePort:      svcPort,
 		Valid:            true,
@@ -184,6 +261,62 @@ func createBackendRef(
 	return backendRef, nil
 }
 
+func isHostnameBackendRef(ref gatewayv1.BackendRef) bool {
+	return ref.Group != nil && *ref.Group == ngfAPI.GroupName && ref.Kind != nil && *ref.Kind == HostnameBackendKind
+}
+
+// createHostnameBackendRef creates a BackendRef for a backendRef that references a HostnameBackend.
+// The backendRef must be already validated by validateBackendRef.
+func createHostnameBackendRef(
+	ref gatewayv1.BackendRef,
+	routeNamespace string,
+	weight int32,
+	hostnameBackends map[types.NamespacedName]*ngfAPI.HostnameBackend,
+	refPath *field.Path,
+	dnsResolutionErr error,
+) (BackendRef, *conditions.Condition) {
+	ns := routeNamespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+
+	nsname := types.NamespacedName{Namespace: ns, Name: string(ref.Name)}
+
+	backendRef := BackendRef{
+		HostnameBackendNsName: nsname,
+		// safe to dereference port here because we already validated that the port is not nil in validateBackendRef.
+		ServicePort: v1.ServicePort{Port: int32(*ref.Port)},
+		Weight:      weight,
+	}
+
+	hb, ok := hostnameBackends[nsname]
+	if !ok {
+		cond := staticConds.NewRouteBackendRefRefBackendNotFound(
+			field.NotFound(refPath.Child("name"), ref.Name).Error(),
+		)
+		return backendRef, &cond
+	}
+
+	// The hostname is validated by the CRD schema, but we re-validate it because it ends up in the NGINX config.
+	if errs := validation.IsDNS1123Subdomain(hb.Spec.Hostname); len(errs) > 0 {
+		msg := fmt.Sprintf("HostnameBackend %s has an invalid hostname %q: %s",
+			nsname, hb.Spec.Hostname, strings.Join(errs, ", "))
+		cond := staticConds.NewRouteBackendRefUnsupportedValue(msg)
+		return backendRef, &cond
+	}
+
+	if dnsResolutionErr != nil {
+		msg := fmt.Sprintf("HostnameBackend %s can't be resolved: %s", nsname, dnsResolutionErr)
+		cond := staticConds.NewRouteBackendRefDNSResolutionUnavailable(msg)
+		return backendRef, &cond
+	}
+
+	backendRef.Hostname = hb.Spec.Hostname
+	backendRef.Valid = true
+
+	return backendRef, nil
+}
+
 // validateBackendTLSPolicyMatchingAllBackends validates that all backends in a rule reference the same
 // BackendTLSPolicy. We require that all backends in a group have the same backend TLS policy configuration.
 // The backend TLS policy configuration is considered matching if: 1. CACertRefs reference the same ConfigMap, or
@@ -324,12 +457,24 @@ func validateBackendRef(
 ) (valid bool, cond conditions.Condition) {
 	// Because all errors cause same condition but different reasons, we return as soon as we find an error
 
-	if ref.Group != nil && !(*ref.Group == "core" || *ref.Group == "") {
-		valErr := field.NotSupported(path.Child("group"), *ref.Group, []string{"core", ""})
+	if ref.Group != nil && !(*ref.Group == "core" || *ref.Group == "" || *ref.Group == ngfAPI.GroupName) {
+		valErr := field.NotSupported(path.Child("group"), *ref.Group, []string{"core", "", ngfAPI.GroupName})
+		return false, staticConds.NewRouteBackendRefInvalidKind(valErr.Error())
+	}
+
+	hostnameBackend := ref.Group != nil && *ref.Group == ngfAPI.GroupName
+
+	if hostnameBackend && (ref.Kind == nil || *ref.Kind != HostnameBackendKind) {
+		kind := gatewayv1.Kind("Service") // Service is the default kind of a backendRef
+		if ref.Kind != nil {
+			kind = *ref.Kind
+		}
+
+		valErr := field.NotSupported(path.Child("kind"), kind, []string{string(HostnameBackendKind)})
 		return false, staticConds.NewRouteBackendRefInvalidKind(valErr.Error())
 	}
 
-	if ref.Kind != nil && *ref.Kind != "Service" {
+	if !hostnameBackend && ref.Kind != nil && *ref.Kind != "Service" {
 		valErr := field.NotSupported(path.Child("kind"), *ref.Kind, []string{"Service"})
 		return false, staticConds.NewRouteBackendRefInvalidKind(valErr.Error())
 	}
@@ -339,8 +484,21 @@ func validateBackendRef(
 	if ref.Namespace != nil && string(*ref.Namespace) != routeNs {
 		refNsName := types.NamespacedName{Namespace: string(*ref.Namespace), Name: string(ref.Name)}
 
-		if !refGrantResolver.refAllowed(toService(refNsName), fromHTTPRoute(routeNs)) {
-			msg := fmt.Sprintf("Backend ref to Service %s not permitted by any ReferenceGrant", refNsName)
+		to, kind := toService(refNsName), "Service"
+		if hostnameBackend {
+			to, kind = toHostnameBackend(refNsName), string(HostnameBackendKind)
+		}
+
+		if !refGrantResolver.refAllowed(to, fromHTTPRoute(routeNs)) {
+			msg := fmt.Sprintf("Backend ref to %s %s not permitted by any ReferenceGrant", kind, refNsName)
+			if !refGrantResolver.namespaceWatched(refNsName.Namespace) {
+				msg = fmt.Sprintf(
+					"Backend ref to %s %s not permitted: Namespace %s is not watched by NGINX Gateway Fabric",
+					kind,
+					refNsName,
+					refNsName.Namespace,
+				)
+			}
 
 			return false, staticConds.NewRouteBackendRefRefNotPermitted(msg)
 		}
@@ -383,5 +541,11 @@ func getServicePort(svc *v1.Service, port int32) (v1.ServicePort, error) {
 		}
 	}
 
+	// The ports of an ExternalName Service are optional and only informational,
+	// so the port of the backendRef is used as is.
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return v1.ServicePort{Port: port}, nil
+	}
+
 	return v1.ServicePort{}, fmt.Errorf("no matching port for Service %s and port %d", svc.Name, port)
 }
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -84,7 +86,7 @@ func TestValidateRouteBackendRef(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			resolver := newReferenceGrantResolver(nil)
+			resolver := newReferenceGrantResolver(nil, allNamespacesWatched)
 
 			valid, cond := validateRouteBackendRef(test.ref, "test", resolver, field.NewPath("test"))
 
@@ -116,12 +118,17 @@ func TestValidateBackendRef(t *testing.T) {
 	allInNamespaceRefGrant := specificRefGrant.DeepCopy()
 	allInNamespaceRefGrant.Spec.To[0].Name = nil
 
+	hostnameBackendRefGrant := specificRefGrant.DeepCopy()
+	hostnameBackendRefGrant.Spec.To[0].Group = ngfAPI.GroupName
+	hostnameBackendRefGrant.Spec.To[0].Kind = HostnameBackendKind
+
 	tests := []struct {
-		ref               gatewayv1.BackendRef
-		refGrants         map[types.NamespacedName]*v1beta1.ReferenceGrant
-		expectedCondition conditions.Condition
-		name              string
-		expectedValid     bool
+		ref                gatewayv1.BackendRef
+		refGrants          map[types.NamespacedName]*v1beta1.ReferenceGrant
+		isNamespaceWatched isNamespaceWatchedFunc
+		expectedCondition  conditions.Condition
+		name               string
+		expectedValid      bool
 	}{
 		{
 			name:          "normal case",
@@ -174,7 +181,56 @@ func TestValidateBackendRef(t *testing.T) {
 			}),
 			expectedValid: false,
 			expectedCondition: staticConds.NewRouteBackendRefInvalidKind(
-				`test.group: Unsupported value: "invalid": supported values: "core", ""`,
+				`test.group: Unsupported value: "invalid": supported values: "core", "", "gateway.nginx.org"`,
+			),
+		},
+		{
+			name: "normal case with HostnameBackend",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](ngfAPI.GroupName)
+				backend.Kind = helpers.GetPointer(HostnameBackendKind)
+				return backend
+			}),
+			expectedValid: true,
+		},
+		{
+			name: "HostnameBackend ref allowed by reference grant",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](ngfAPI.GroupName)
+				backend.Kind = helpers.GetPointer(HostnameBackendKind)
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("cross-ns")
+				return backend
+			}),
+			refGrants: map[types.NamespacedName]*v1beta1.ReferenceGrant{
+				{Namespace: "cross-ns", Name: "rg"}: hostnameBackendRefGrant,
+			},
+			expectedValid: true,
+		},
+		{
+			name: "HostnameBackend ref not allowed by reference grant for Services",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](ngfAPI.GroupName)
+				backend.Kind = helpers.GetPointer(HostnameBackendKind)
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("cross-ns")
+				return backend
+			}),
+			refGrants: map[types.NamespacedName]*v1beta1.ReferenceGrant{
+				{Namespace: "cross-ns", Name: "rg"}: specificRefGrant,
+			},
+			expectedValid: false,
+			expectedCondition: staticConds.NewRouteBackendRefRefNotPermitted(
+				"Backend ref to HostnameBackend cross-ns/service1 not permitted by any ReferenceGrant",
+			),
+		},
+		{
+			name: "not a HostnameBackend kind in the NGF group",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](ngfAPI.GroupName)
+				return backend
+			}),
+			expectedValid: false,
+			expectedCondition: staticConds.NewRouteBackendRefInvalidKind(
+				`test.kind: Unsupported value: "Service": supported values: "HostnameBackend"`,
 			),
 		},
 		{
@@ -199,6 +255,24 @@ func TestValidateBackendRef(t *testing.T) {
 				"Backend ref to Service invalid/service1 not permitted by any ReferenceGrant",
 			),
 		},
+		{
+			name: "backend ref to a namespace that is not watched",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("cross-ns")
+				return backend
+			}),
+			refGrants: map[types.NamespacedName]*v1beta1.ReferenceGrant{
+				{Namespace: "cross-ns", Name: "rg"}: specificRefGrant,
+			},
+			isNamespaceWatched: func(namespace string) bool {
+				return namespace == "test"
+			},
+			expectedValid: false,
+			expectedCondition: staticConds.NewRouteBackendRefRefNotPermitted(
+				"Backend ref to Service cross-ns/service1 not permitted: " +
+					"Namespace cross-ns is not watched by NGINX Gateway Fabric",
+			),
+		},
 		{
 			name: "invalid weight",
 			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
@@ -227,7 +301,12 @@ func TestValidateBackendRef(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			resolver := newReferenceGrantResolver(test.refGrants)
+			isNamespaceWatched := test.isNamespaceWatched
+			if isNamespaceWatched == nil {
+				isNamespaceWatched = allNamespacesWatched
+			}
+
+			resolver := newReferenceGrantResolver(test.refGrants, isNamespaceWatched)
 			valid, cond := validateBackendRef(test.ref, "test", resolver, field.NewPath("test"))
 
 			g.Expect(valid).To(Equal(test.expectedValid))
@@ -675,8 +754,8 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			resolver := newReferenceGrantResolver(nil)
-			addBackendRefsToRules(test.route, resolver, services, test.policies)
+			resolver := newReferenceGrantResolver(nil, allNamespacesWatched)
+			addBackendRefsToRules(test.route, resolver, services, nil, test.policies, nil)
 
 			var actual []BackendRef
 			if test.route.Spec.Rules != nil {
@@ -712,6 +791,45 @@ func TestCreateBackend(t *testing.T) {
 	svc2NamespacedName := types.NamespacedName{Namespace: "test", Name: "service2"}
 	svc3NamespacedName := types.NamespacedName{Namespace: "test", Name: "service3"}
 
+	externalNameSvc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "external",
+			Namespace: "test",
+		},
+		Spec: v1.ServiceSpec{
+			Type:         v1.ServiceTypeExternalName,
+			ExternalName: "api.example.com",
+		},
+	}
+	externalNameSvcNsName := types.NamespacedName{Namespace: "test", Name: "external"}
+
+	createHostnameBackend := func(name, hostname string) *ngfAPI.HostnameBackend {
+		return &ngfAPI.HostnameBackend{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: ngfAPI.HostnameBackendSpec{
+				Hostname: hostname,
+			},
+		}
+	}
+	hb := createHostnameBackend("hb", "api.example.com")
+	invalidHb := createHostnameBackend("invalid-hb", "api.example.com; return 200")
+	hbNsName := types.NamespacedName{Namespace: "test", Name: "hb"}
+
+	getHostnameBackendRef := func(name gatewayv1.ObjectName) gatewayv1.BackendRef {
+		return getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+			backend.Group = helpers.GetPointer[gatewayv1.Group](ngfAPI.GroupName)
+			backend.Kind = helpers.GetPointer(HostnameBackendKind)
+			backend.Name = name
+			backend.Port = helpers.GetPointer[gatewayv1.PortNumber](443)
+			return backend
+		})
+	}
+
+	dnsResolutionErr := errors.New("resolving backend hostnames requires NGINX Plus")
+
 	btp := BackendTLSPolicy{
 		Source: &v1alpha2.BackendTLSPolicy{
 			ObjectMeta: metav1.ObjectMeta{
@@ -765,6 +883,7 @@ func TestCreateBackend(t *testing.T) {
 
 	tests := []struct {
 		expectedCondition            *conditions.Condition
+		dnsResolutionErr             error
 		name                         string
 		expectedServicePortReference string
 		ref                          gatewayv1.HTTPBackendRef
@@ -901,12 +1020,132 @@ func TestCreateBackend(t *testing.T) {
 			),
 			name: "invalid policy",
 		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					backend.Port = helpers.GetPointer[gatewayv1.PortNumber](443)
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:   externalNameSvcNsName,
+				Hostname:    "api.example.com",
+				ServicePort: v1.ServicePort{Port: 443},
+				Weight:      5,
+				Valid:       true,
+			},
+			expectedServicePortReference: "test_external_443",
+			expectedCondition:            nil,
+			name:                         "ExternalName service",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					backend.Port = helpers.GetPointer[gatewayv1.PortNumber](443)
+					return backend
+				}),
+			},
+			dnsResolutionErr: dnsResolutionErr,
+			expectedBackend: BackendRef{
+				SvcNsName:   externalNameSvcNsName,
+				ServicePort: v1.ServicePort{Port: 443},
+				Weight:      5,
+				Valid:       false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefDNSResolutionUnavailable(
+					"ExternalName Service test/external can't be resolved: " +
+						"resolving backend hostnames requires NGINX Plus",
+				),
+			),
+			name: "ExternalName service when DNS resolution is not possible",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getHostnameBackendRef("hb"),
+			},
+			expectedBackend: BackendRef{
+				HostnameBackendNsName: hbNsName,
+				Hostname:              "api.example.com",
+				ServicePort:           v1.ServicePort{Port: 443},
+				Weight:                5,
+				Valid:                 true,
+			},
+			expectedServicePortReference: "test_hb_hostname_443",
+			expectedCondition:            nil,
+			name:                         "HostnameBackend",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getHostnameBackendRef("hb"),
+			},
+			dnsResolutionErr: dnsResolutionErr,
+			expectedBackend: BackendRef{
+				HostnameBackendNsName: hbNsName,
+				ServicePort:           v1.ServicePort{Port: 443},
+				Weight:                5,
+				Valid:                 false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefDNSResolutionUnavailable(
+					"HostnameBackend test/hb can't be resolved: resolving backend hostnames requires NGINX Plus",
+				),
+			),
+			name: "HostnameBackend when DNS resolution is not possible",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getHostnameBackendRef("not-exist"),
+			},
+			expectedBackend: BackendRef{
+				HostnameBackendNsName: types.NamespacedName{Namespace: "test", Name: "not-exist"},
+				ServicePort:           v1.ServicePort{Port: 443},
+				Weight:                5,
+				Valid:                 false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefRefBackendNotFound(`test.name: Not found: "not-exist"`),
+			),
+			name: "HostnameBackend doesn't exist",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getHostnameBackendRef("invalid-hb"),
+			},
+			expectedBackend: BackendRef{
+				HostnameBackendNsName: types.NamespacedName{Namespace: "test", Name: "invalid-hb"},
+				ServicePort:           v1.ServicePort{Port: 443},
+				Weight:                5,
+				Valid:                 false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefUnsupportedValue(
+					`HostnameBackend test/invalid-hb has an invalid hostname "api.example.com; return 200": ` +
+						"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', " +
+						"and must start and end with an alphanumeric character (e.g. 'example.com', " +
+						`regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+				),
+			),
+			name: "HostnameBackend with invalid hostname",
+		},
 	}
 
 	services := map[types.NamespacedName]*v1.Service{
 		client.ObjectKeyFromObject(svc1): svc1,
 		client.ObjectKeyFromObject(svc2): svc2,
 		client.ObjectKeyFromObject(svc3): svc3,
+
+		client.ObjectKeyFromObject(externalNameSvc): externalNameSvc,
+	}
+	hostnameBackends := map[types.NamespacedName]*ngfAPI.HostnameBackend{
+		client.ObjectKeyFromObject(hb):        hb,
+		client.ObjectKeyFromObject(invalidHb): invalidHb,
 	}
 	policies := map[types.NamespacedName]*BackendTLSPolicy{
 		client.ObjectKeyFromObject(btp.Source):  &btp,
@@ -921,7 +1160,7 @@ func TestCreateBackend(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			resolver := newReferenceGrantResolver(nil)
+			resolver := newReferenceGrantResolver(nil, allNamespacesWatched)
 
 			rbr := RouteBackendRef{
 				test.ref.BackendRef,
@@ -932,8 +1171,10 @@ func TestCreateBackend(t *testing.T) {
 				sourceNamespace,
 				resolver,
 				services,
+				hostnameBackends,
 				refPath,
 				policies,
+				test.dnsResolutionErr,
 			)
 
 			g.Expect(helpers.Diff(test.expectedBackend, backend)).To(BeEmpty())
@@ -973,6 +1214,69 @@ func TestGetServicePort(t *testing.T) {
 	port, err := getServicePort(svc, 83)
 	g.Expect(err).Should(HaveOccurred())
 	g.Expect(port.Port).To(Equal(int32(0)))
+
+	// ExternalName Service without ports
+	externalNameSvc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Type:         v1.ServiceTypeExternalName,
+			ExternalName: "api.example.com",
+		},
+	}
+	port, err = getServicePort(externalNameSvc, 443)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(port).To(Equal(v1.ServicePort{Port: 443}))
+}
+
+func TestCheckDNSResolution(t *testing.T) {
+	npWithResolver := &ngfAPI.NginxProxy{
+		Spec: ngfAPI.NginxProxySpec{
+			DNSResolver: &ngfAPI.DNSResolver{
+				Addresses: []ngfAPI.ResolverAddress{"10.96.0.10"},
+			},
+		},
+	}
+
+	tests := []struct {
+		npCfg     *ngfAPI.NginxProxy
+		name      string
+		expErrStr string
+		plus      bool
+	}{
+		{
+			name:  "plus with resolver",
+			npCfg: npWithResolver,
+			plus:  true,
+		},
+		{
+			name:      "oss with resolver",
+			npCfg:     npWithResolver,
+			expErrStr: "requires NGINX Plus",
+		},
+		{
+			name:      "plus without NginxProxy",
+			plus:      true,
+			expErrStr: "requires a DNS resolver",
+		},
+		{
+			name:      "plus without resolver",
+			npCfg:     &ngfAPI.NginxProxy{},
+			plus:      true,
+			expErrStr: "requires a DNS resolver",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := checkDNSResolution(test.plus, test.npCfg)
+			if test.expErrStr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrStr)))
+			}
+		})
+	}
 }
 
 func TestValidateBackendTLSPolicyMatchingAllBackends(t *testing.T) {
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// validateClientSettingsPolicy performs re-validation on the ClientSettingsPolicy in the case of CRD validation
+// failure.
+func validateClientSettingsPolicy(
+	validator validation.GenericValidator,
+	pol *ngfAPI.ClientSettingsPolicy,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	targetRefPath := spec.Child("targetRef")
+
+	ref := pol.Spec.TargetRef
+	if ref.Group != v1.GroupName {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("group"), ref.Group, []string{v1.GroupName}))
+	}
+
+	supportedKinds := []string{"Gateway", "HTTPRoute"}
+	if ref.Kind != v1.Kind("Gateway") && ref.Kind != v1.Kind("HTTPRoute") {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("kind"), ref.Kind, supportedKinds))
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != pol.Namespace {
+		allErrs = append(
+			allErrs,
+			field.Invalid(targetRefPath.Child("namespace"), *ref.Namespace, "must be the same as the policy namespace"),
+		)
+	}
+
+	if body := pol.Spec.Body; body != nil {
+		bodyPath := spec.Child("body")
+
+		if body.MaxSize != nil {
+			if err := validator.ValidateNginxSize(string(*body.MaxSize)); err != nil {
+				allErrs = append(allErrs, field.Invalid(bodyPath.Child("maxSize"), *body.MaxSize, err.Error()))
+			}
+		}
+
+		if body.Timeout != nil {
+			if err := validator.ValidateNginxDuration(string(*body.Timeout)); err != nil {
+				allErrs = append(allErrs, field.Invalid(bodyPath.Child("timeout"), *body.Timeout, err.Error()))
+			}
+		}
+	}
+
+	if keepAlive := pol.Spec.KeepAlive; keepAlive != nil {
+		keepAlivePath := spec.Child("keepAlive")
+
+		if keepAlive.Requests != nil && *keepAlive.Requests < 0 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(keepAlivePath.Child("requests"), *keepAlive.Requests, "must be greater than or equal to 0"),
+			)
+		}
+
+		if keepAlive.Time != nil {
+			if err := validator.ValidateNginxDuration(string(*keepAlive.Time)); err != nil {
+				allErrs = append(allErrs, field.Invalid(keepAlivePath.Child("time"), *keepAlive.Time, err.Error()))
+			}
+		}
+
+		if timeout := keepAlive.Timeout; timeout != nil {
+			timeoutPath := keepAlivePath.Child("timeout")
+
+			if timeout.Server != nil {
+				if err := validator.ValidateNginxDuration(string(*timeout.Server)); err != nil {
+					allErrs = append(allErrs, field.Invalid(timeoutPath.Child("server"), *timeout.Server, err.Error()))
+				}
+			}
+
+			if timeout.Header != nil {
+				if err := validator.ValidateNginxDuration(string(*timeout.Header)); err != nil {
+					allErrs = append(allErrs, field.Invalid(timeoutPath.Child("header"), *timeout.Header, err.Error()))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestValidateClientSettingsPolicy(t *testing.T) {
+	createPolicy := func(modify func(*ngfAPI.ClientSettingsPolicy)) *ngfAPI.ClientSettingsPolicy {
+		pol := &ngfAPI.ClientSettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "test"},
+			Spec: ngfAPI.ClientSettingsPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  "Gateway",
+					Name:  "gateway",
+				},
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Requests: helpers.GetPointer[int32](100),
+					Time:     helpers.GetPointer[ngfAPI.Duration]("1h"),
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Server: helpers.GetPointer[ngfAPI.Duration]("60s"),
+						Header: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+		}
+
+		if modify != nil {
+			modify(pol)
+		}
+
+		return pol
+	}
+
+	invalidSizeValidator := &validationfakes.FakeGenericValidator{}
+	invalidSizeValidator.ValidateNginxSizeReturns(errors.New("error"))
+
+	invalidDurationValidator := &validationfakes.FakeGenericValidator{}
+	invalidDurationValidator.ValidateNginxDurationReturns(errors.New("error"))
+
+	tests := []struct {
+		policy          *ngfAPI.ClientSettingsPolicy
+		validator       *validationfakes.FakeGenericValidator
+		name            string
+		expErrSubstring string
+		expErrCount     int
+	}{
+		{
+			name:      "valid",
+			policy:    createPolicy(nil),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "valid HTTPRoute target in the same namespace",
+			policy: createPolicy(func(pol *ngfAPI.ClientSettingsPolicy) {
+				pol.Spec.TargetRef.Kind = "HTTPRoute"
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("test")
+			}),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "valid without body and keepAlive",
+			policy: createPolicy(func(pol *ngfAPI.ClientSettingsPolicy) {
+				pol.Spec.Body = nil
+				pol.Spec.KeepAlive = nil
+			}),
+			validator: invalidDurationValidator,
+		},
+		{
+			name: "invalid target",
+			policy: createPolicy(func(pol *ngfAPI.ClientSettingsPolicy) {
+				pol.Spec.TargetRef.Group = "core"
+				pol.Spec.TargetRef.Kind = "Service"
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("other")
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.targetRef",
+			expErrCount:     3,
+		},
+		{
+			name:            "invalid max size",
+			policy:          createPolicy(nil),
+			validator:       invalidSizeValidator,
+			expErrSubstring: "spec.body.maxSize",
+			expErrCount:     1,
+		},
+		{
+			name:            "invalid durations",
+			policy:          createPolicy(nil),
+			validator:       invalidDurationValidator,
+			expErrSubstring: "spec.keepAlive.timeout.header",
+			expErrCount:     4,
+		},
+		{
+			name: "invalid keepAlive requests",
+			policy: createPolicy(func(pol *ngfAPI.ClientSettingsPolicy) {
+				pol.Spec.KeepAlive.Requests = helpers.GetPointer[int32](-1)
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.keepAlive.requests",
+			expErrCount:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := validateClientSettingsPolicy(test.validator, test.policy)
+			g.Expect(allErrs).To(HaveLen(test.expErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
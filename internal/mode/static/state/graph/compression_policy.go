@@ -0,0 +1,202 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// CompressionPolicy represents a CompressionPolicy.
+type CompressionPolicy struct {
+	// Source is the source resource.
+	Source *ngfAPI.CompressionPolicy
+	// Gateway is the name of the Gateway that is being checked for this CompressionPolicy.
+	Gateway types.NamespacedName
+	// Conditions include Conditions for the CompressionPolicy.
+	Conditions []conditions.Condition
+	// Valid shows whether the CompressionPolicy is valid and applied to its target.
+	Valid bool
+}
+
+// processCompressionPolicies validates the CompressionPolicies and attaches the valid ones to their target
+// HTTPRoutes. If several policies target the same HTTPRoute, the oldest one wins.
+func processCompressionPolicies(
+	policies map[types.NamespacedName]*ngfAPI.CompressionPolicy,
+	routes map[RouteKey]*L7Route,
+	validator validation.GenericValidator,
+	gateway *Gateway,
+	plus bool,
+) map[types.NamespacedName]*CompressionPolicy {
+	if len(policies) == 0 || gateway == nil {
+		return nil
+	}
+
+	sortedPolicies := make([]*ngfAPI.CompressionPolicy, 0, len(policies))
+	for _, pol := range policies {
+		sortedPolicies = append(sortedPolicies, pol)
+	}
+
+	sort.Slice(sortedPolicies, func(i, j int) bool {
+		return ngfsort.LessObjectMeta(&sortedPolicies[i].ObjectMeta, &sortedPolicies[j].ObjectMeta)
+	})
+
+	gwNsName := types.NamespacedName{Namespace: gateway.Source.Namespace, Name: gateway.Source.Name}
+
+	processedPolicies := make(map[types.NamespacedName]*CompressionPolicy, len(policies))
+	for _, pol := range sortedPolicies {
+		processedPolicy := &CompressionPolicy{
+			Source:  pol,
+			Gateway: gwNsName,
+		}
+		processedPolicies[types.NamespacedName{Namespace: pol.Namespace, Name: pol.Name}] = processedPolicy
+
+		errs := validateCompressionPolicy(validator, pol)
+		errs = append(errs, validateBrotli(plus, &pol.Spec.Compression, field.NewPath("spec").Child("compression"))...)
+		if len(errs) > 0 {
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewCompressionPolicyInvalid(errs.ToAggregate().Error()),
+			)
+			continue
+		}
+
+		route := findPolicyTargetRoute(pol.Namespace, pol.Spec.TargetRef.Name, routes, gwNsName)
+		if route == nil {
+			msg := fmt.Sprintf(
+				"HTTPRoute %s/%s does not exist or is not attached to the Gateway",
+				pol.Namespace,
+				pol.Spec.TargetRef.Name,
+			)
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewCompressionPolicyTargetNotFound(msg),
+			)
+			continue
+		}
+
+		if route.CompressionPolicy != nil {
+			msg := fmt.Sprintf(
+				"HTTPRoute %s/%s is already targeted by CompressionPolicy %s/%s",
+				pol.Namespace,
+				pol.Spec.TargetRef.Name,
+				route.CompressionPolicy.Source.Namespace,
+				route.CompressionPolicy.Source.Name,
+			)
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewCompressionPolicyConflicted(msg),
+			)
+			continue
+		}
+
+		processedPolicy.Valid = true
+		processedPolicy.Conditions = append(processedPolicy.Conditions, staticConds.NewCompressionPolicyAccepted())
+		route.CompressionPolicy = processedPolicy
+	}
+
+	return processedPolicies
+}
+
+// findPolicyTargetRoute returns the HTTPRoute targeted by a policy, if that HTTPRoute is valid and
+// attached to the Gateway.
+func findPolicyTargetRoute(
+	namespace string,
+	name v1.ObjectName,
+	routes map[RouteKey]*L7Route,
+	gwNsName types.NamespacedName,
+) *L7Route {
+	key := RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: string(name)},
+		RouteType:      RouteTypeHTTP,
+	}
+
+	route, exists := routes[key]
+	if !exists || !route.Valid {
+		return nil
+	}
+
+	for _, ref := range route.ParentRefs {
+		if ref.Gateway == gwNsName && ref.Attachment != nil && ref.Attachment.Attached {
+			return route
+		}
+	}
+
+	return nil
+}
+
+func validateCompressionPolicy(
+	validator validation.GenericValidator,
+	pol *ngfAPI.CompressionPolicy,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	targetRefPath := spec.Child("targetRef")
+
+	ref := pol.Spec.TargetRef
+	if ref.Group != v1.GroupName {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("group"), ref.Group, []string{v1.GroupName}))
+	}
+
+	if ref.Kind != v1.Kind("HTTPRoute") {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("kind"), ref.Kind, []string{"HTTPRoute"}))
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != pol.Namespace {
+		allErrs = append(
+			allErrs,
+			field.Invalid(targetRefPath.Child("namespace"), *ref.Namespace, "must be the same as the policy namespace"),
+		)
+	}
+
+	allErrs = append(allErrs, validateCompression(validator, &pol.Spec.Compression, spec.Child("compression"))...)
+
+	return allErrs
+}
+
+// validateCompression performs re-validation on the compression settings in the case of CRD validation failure.
+func validateCompression(
+	validator validation.GenericValidator,
+	compression *ngfAPI.Compression,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	mimeTypesPath := path.Child("mimeTypes")
+	for i, mimeType := range compression.MIMETypes {
+		if err := validator.ValidateMIMEType(string(mimeType)); err != nil {
+			allErrs = append(allErrs, field.Invalid(mimeTypesPath.Index(i), mimeType, err.Error()))
+		}
+	}
+
+	if compression.MinLength != nil && *compression.MinLength < 0 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(path.Child("minLength"), *compression.MinLength, "must be greater than or equal to 0"),
+		)
+	}
+
+	if compression.Level != nil && (*compression.Level < 1 || *compression.Level > 9) {
+		allErrs = append(allErrs, field.Invalid(path.Child("level"), *compression.Level, "must be between 1 and 9"))
+	}
+
+	return allErrs
+}
+
+// validateBrotli checks that Brotli compression is only configured with NGINX Plus,
+// because only the NGINX Plus image includes the Brotli module.
+func validateBrotli(plus bool, compression *ngfAPI.Compression, path *field.Path) field.ErrorList {
+	if plus || compression == nil || compression.Brotli == nil {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(path.Child("brotli"), "Brotli compression requires NGINX Plus")}
+}
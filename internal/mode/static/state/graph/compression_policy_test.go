@@ -0,0 +1,356 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessCompressionPolicies(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	gateway := &Gateway{
+		Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: gwNsName.Name, Namespace: gwNsName.Namespace}},
+	}
+
+	createRoute := func(name string, attached bool) *L7Route {
+		return &L7Route{
+			Source: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			},
+			RouteType: RouteTypeHTTP,
+			Valid:     true,
+			ParentRefs: []ParentRef{
+				{
+					Gateway:    gwNsName,
+					Attachment: &ParentRefAttachmentStatus{Attached: attached},
+				},
+			},
+		}
+	}
+
+	createRoutes := func() map[RouteKey]*L7Route {
+		return map[RouteKey]*L7Route{
+			{
+				NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"},
+				RouteType:      RouteTypeHTTP,
+			}: createRoute("hr", true),
+			{
+				NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-not-attached"},
+				RouteType:      RouteTypeHTTP,
+			}: createRoute("hr-not-attached", false),
+		}
+	}
+
+	createPolicy := func(name, target string, created time.Time) *ngfAPI.CompressionPolicy {
+		return &ngfAPI.CompressionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "test",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: ngfAPI.CompressionPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  "HTTPRoute",
+					Name:  gatewayv1.ObjectName(target),
+				},
+				Compression: ngfAPI.Compression{
+					MIMETypes: []ngfAPI.MIMEType{"application/json"},
+					Level:     helpers.GetPointer[int32](5),
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+
+	validPolicy := createPolicy("valid", "hr", now)
+	conflictedPolicy := createPolicy("conflicted", "hr", now.Add(time.Second))
+	notFoundPolicy := createPolicy("not-found", "does-not-exist", now)
+	notAttachedPolicy := createPolicy("not-attached", "hr-not-attached", now)
+
+	wrongKindPolicy := createPolicy("wrong-kind", "hr", now)
+	wrongKindPolicy.Spec.TargetRef.Kind = "Gateway"
+
+	invalidCompressionPolicy := createPolicy("invalid-compression", "hr", now)
+	invalidCompressionPolicy.Spec.Compression.Level = helpers.GetPointer[int32](0)
+
+	brotliPolicy := createPolicy("brotli", "hr", now)
+	brotliPolicy.Spec.Compression.Brotli = helpers.GetPointer(true)
+
+	tests := []struct {
+		policies   map[types.NamespacedName]*ngfAPI.CompressionPolicy
+		gateway    *Gateway
+		validator  *validationfakes.FakeGenericValidator
+		expected   map[types.NamespacedName]*CompressionPolicy
+		expRouteCP map[string]*ngfAPI.CompressionPolicy
+		name       string
+		plus       bool
+	}{
+		{
+			name:      "no policies",
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			expected:  nil,
+		},
+		{
+			name: "nil gateway",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "valid"}: validPolicy,
+			},
+			validator: &validationfakes.FakeGenericValidator{},
+			expected:  nil,
+		},
+		{
+			name: "valid and conflicted policies",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "conflicted"}: conflictedPolicy,
+				{Namespace: "test", Name: "valid"}:      validPolicy,
+			},
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			expected: map[types.NamespacedName]*CompressionPolicy{
+				{Namespace: "test", Name: "valid"}: {
+					Source:     validPolicy,
+					Gateway:    gwNsName,
+					Conditions: []conditions.Condition{staticConds.NewCompressionPolicyAccepted()},
+					Valid:      true,
+				},
+				{Namespace: "test", Name: "conflicted"}: {
+					Source:  conflictedPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyConflicted(
+							"HTTPRoute test/hr is already targeted by CompressionPolicy test/valid",
+						),
+					},
+				},
+			},
+			expRouteCP: map[string]*ngfAPI.CompressionPolicy{
+				"hr": validPolicy,
+			},
+		},
+		{
+			name: "target not found or not attached",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "not-found"}:    notFoundPolicy,
+				{Namespace: "test", Name: "not-attached"}: notAttachedPolicy,
+			},
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			expected: map[types.NamespacedName]*CompressionPolicy{
+				{Namespace: "test", Name: "not-found"}: {
+					Source:  notFoundPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyTargetNotFound(
+							"HTTPRoute test/does-not-exist does not exist or is not attached to the Gateway",
+						),
+					},
+				},
+				{Namespace: "test", Name: "not-attached"}: {
+					Source:  notAttachedPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyTargetNotFound(
+							"HTTPRoute test/hr-not-attached does not exist or is not attached to the Gateway",
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid policies",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "wrong-kind"}:          wrongKindPolicy,
+				{Namespace: "test", Name: "invalid-compression"}: invalidCompressionPolicy,
+			},
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			expected: map[types.NamespacedName]*CompressionPolicy{
+				{Namespace: "test", Name: "wrong-kind"}: {
+					Source:  wrongKindPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyInvalid(
+							`spec.targetRef.kind: Unsupported value: "Gateway": supported values: "HTTPRoute"`,
+						),
+					},
+				},
+				{Namespace: "test", Name: "invalid-compression"}: {
+					Source:  invalidCompressionPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyInvalid(
+							"spec.compression.level: Invalid value: 0: must be between 1 and 9",
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "brotli policy in OSS",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "brotli"}: brotliPolicy,
+			},
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			expected: map[types.NamespacedName]*CompressionPolicy{
+				{Namespace: "test", Name: "brotli"}: {
+					Source:  brotliPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewCompressionPolicyInvalid(
+							"spec.compression.brotli: Forbidden: Brotli compression requires NGINX Plus",
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "brotli policy in NGINX Plus",
+			policies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				{Namespace: "test", Name: "brotli"}: brotliPolicy,
+			},
+			gateway:   gateway,
+			validator: &validationfakes.FakeGenericValidator{},
+			plus:      true,
+			expected: map[types.NamespacedName]*CompressionPolicy{
+				{Namespace: "test", Name: "brotli"}: {
+					Source:     brotliPolicy,
+					Gateway:    gwNsName,
+					Conditions: []conditions.Condition{staticConds.NewCompressionPolicyAccepted()},
+					Valid:      true,
+				},
+			},
+			expRouteCP: map[string]*ngfAPI.CompressionPolicy{
+				"hr": brotliPolicy,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			routes := createRoutes()
+
+			processed := processCompressionPolicies(test.policies, routes, test.validator, test.gateway, test.plus)
+			g.Expect(processed).To(Equal(test.expected))
+
+			for key, route := range routes {
+				expPolicy, exists := test.expRouteCP[key.NamespacedName.Name]
+				if !exists {
+					g.Expect(route.CompressionPolicy).To(BeNil())
+					continue
+				}
+
+				g.Expect(route.CompressionPolicy).ToNot(BeNil())
+				g.Expect(route.CompressionPolicy.Source).To(Equal(expPolicy))
+			}
+		})
+	}
+}
+
+func TestValidateCompressionPolicy(t *testing.T) {
+	createPolicy := func(modify func(*ngfAPI.CompressionPolicy)) *ngfAPI.CompressionPolicy {
+		pol := &ngfAPI.CompressionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "test"},
+			Spec: ngfAPI.CompressionPolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  "HTTPRoute",
+					Name:  "hr",
+				},
+				Compression: ngfAPI.Compression{
+					Enable:    helpers.GetPointer(true),
+					MIMETypes: []ngfAPI.MIMEType{"application/json", "text/css"},
+					MinLength: helpers.GetPointer[int32](0),
+					Level:     helpers.GetPointer[int32](1),
+				},
+			},
+		}
+
+		if modify != nil {
+			modify(pol)
+		}
+
+		return pol
+	}
+
+	invalidValidator := &validationfakes.FakeGenericValidator{}
+	invalidValidator.ValidateMIMETypeReturns(errors.New("error"))
+
+	tests := []struct {
+		policy          *ngfAPI.CompressionPolicy
+		validator       *validationfakes.FakeGenericValidator
+		name            string
+		expErrSubstring string
+		expErrCount     int
+	}{
+		{
+			name:      "valid",
+			policy:    createPolicy(nil),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "valid target namespace",
+			policy: createPolicy(func(pol *ngfAPI.CompressionPolicy) {
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("test")
+			}),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "invalid target",
+			policy: createPolicy(func(pol *ngfAPI.CompressionPolicy) {
+				pol.Spec.TargetRef.Group = "core"
+				pol.Spec.TargetRef.Kind = "Service"
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("other")
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.targetRef",
+			expErrCount:     3,
+		},
+		{
+			name:            "invalid mime types",
+			policy:          createPolicy(nil),
+			validator:       invalidValidator,
+			expErrSubstring: "spec.compression.mimeTypes",
+			expErrCount:     2,
+		},
+		{
+			name: "invalid min length and level",
+			policy: createPolicy(func(pol *ngfAPI.CompressionPolicy) {
+				pol.Spec.Compression.MinLength = helpers.GetPointer[int32](-1)
+				pol.Spec.Compression.Level = helpers.GetPointer[int32](10)
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.compression",
+			expErrCount:     2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := validateCompressionPolicy(test.validator, test.policy)
+			g.Expect(allErrs).To(HaveLen(test.expErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
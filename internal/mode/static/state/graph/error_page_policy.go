@@ -0,0 +1,444 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// defaultErrorPageContentType is the content type of an error page that doesn't specify one.
+const defaultErrorPageContentType = "text/html"
+
+// ErrorPagePolicy represents an ErrorPagePolicy.
+type ErrorPagePolicy struct {
+	// Source is the source resource.
+	Source *ngfAPI.ErrorPagePolicy
+	// Gateway is the name of the Gateway that is being checked for this ErrorPagePolicy.
+	Gateway types.NamespacedName
+	// ErrorPages holds the error pages of the ErrorPagePolicy with their content resolved.
+	// It is only set if the ErrorPagePolicy is valid.
+	ErrorPages []ErrorPage
+	// Conditions include Conditions for the ErrorPagePolicy.
+	Conditions []conditions.Condition
+	// Valid shows whether the ErrorPagePolicy is valid and applied to its target.
+	Valid bool
+}
+
+// ErrorPage is a custom response for a set of status codes.
+type ErrorPage struct {
+	// ResponseCode is the status code of the response. If nil, the original status code is used.
+	ResponseCode *int32
+	// BackendRef is the Service that serves the error page. If nil, the Content is served.
+	BackendRef *BackendRef
+	// ContentType is the media type of the content.
+	ContentType string
+	// Path is the path of the error page on the Service of the BackendRef.
+	Path string
+	// Codes are the status codes that are replaced with the error page.
+	Codes []int32
+	// Content is the content of the error page.
+	Content []byte
+}
+
+// processErrorPagePolicies validates the ErrorPagePolicies, resolves the content of their error pages and
+// attaches the valid ones to their target Gateway or HTTPRoutes. If several policies target the same resource,
+// the oldest one wins.
+func processErrorPagePolicies(
+	policies map[types.NamespacedName]*ngfAPI.ErrorPagePolicy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	services map[types.NamespacedName]*apiv1.Service,
+	routes map[RouteKey]*L7Route,
+	validator validation.GenericValidator,
+	gateway *Gateway,
+) map[types.NamespacedName]*ErrorPagePolicy {
+	if len(policies) == 0 || gateway == nil {
+		return nil
+	}
+
+	sortedPolicies := make([]*ngfAPI.ErrorPagePolicy, 0, len(policies))
+	for _, pol := range policies {
+		sortedPolicies = append(sortedPolicies, pol)
+	}
+
+	sort.Slice(sortedPolicies, func(i, j int) bool {
+		return ngfsort.LessObjectMeta(&sortedPolicies[i].ObjectMeta, &sortedPolicies[j].ObjectMeta)
+	})
+
+	gwNsName := types.NamespacedName{Namespace: gateway.Source.Namespace, Name: gateway.Source.Name}
+
+	processedPolicies := make(map[types.NamespacedName]*ErrorPagePolicy, len(policies))
+	for _, pol := range sortedPolicies {
+		processedPolicy := &ErrorPagePolicy{
+			Source:  pol,
+			Gateway: gwNsName,
+		}
+		processedPolicies[types.NamespacedName{Namespace: pol.Namespace, Name: pol.Name}] = processedPolicy
+
+		if errs := validateErrorPagePolicy(validator, pol); len(errs) > 0 {
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewErrorPagePolicyInvalid(errs.ToAggregate().Error()),
+			)
+			continue
+		}
+
+		errorPages, errs := resolveErrorPages(pol, configMaps, services)
+		if len(errs) > 0 {
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewErrorPagePolicyInvalid(errs.ToAggregate().Error()),
+			)
+			continue
+		}
+
+		ref := pol.Spec.TargetRef
+
+		var existing *ErrorPagePolicy
+
+		if ref.Kind == v1.Kind("Gateway") {
+			if string(ref.Name) != gwNsName.Name || pol.Namespace != gwNsName.Namespace {
+				msg := fmt.Sprintf("Gateway %s/%s does not exist or is not processed", pol.Namespace, ref.Name)
+				processedPolicy.Conditions = append(
+					processedPolicy.Conditions,
+					staticConds.NewErrorPagePolicyTargetNotFound(msg),
+				)
+				continue
+			}
+
+			existing = gateway.ErrorPagePolicy
+			if existing == nil {
+				gateway.ErrorPagePolicy = processedPolicy
+			}
+		} else {
+			route := findPolicyTargetRoute(pol.Namespace, ref.Name, routes, gwNsName)
+			if route == nil {
+				msg := fmt.Sprintf(
+					"HTTPRoute %s/%s does not exist or is not attached to the Gateway",
+					pol.Namespace,
+					ref.Name,
+				)
+				processedPolicy.Conditions = append(
+					processedPolicy.Conditions,
+					staticConds.NewErrorPagePolicyTargetNotFound(msg),
+				)
+				continue
+			}
+
+			existing = route.ErrorPagePolicy
+			if existing == nil {
+				route.ErrorPagePolicy = processedPolicy
+			}
+		}
+
+		if existing != nil {
+			msg := fmt.Sprintf(
+				"%s %s/%s is already targeted by ErrorPagePolicy %s/%s",
+				ref.Kind,
+				pol.Namespace,
+				ref.Name,
+				existing.Source.Namespace,
+				existing.Source.Name,
+			)
+			processedPolicy.Conditions = append(
+				processedPolicy.Conditions,
+				staticConds.NewErrorPagePolicyConflicted(msg),
+			)
+			continue
+		}
+
+		processedPolicy.Valid = true
+		processedPolicy.ErrorPages = errorPages
+		processedPolicy.Conditions = append(processedPolicy.Conditions, staticConds.NewErrorPagePolicyAccepted())
+	}
+
+	return processedPolicies
+}
+
+// resolveErrorPages resolves the content or the Service of the error pages of the ErrorPagePolicy.
+func resolveErrorPages(
+	pol *ngfAPI.ErrorPagePolicy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	services map[types.NamespacedName]*apiv1.Service,
+) ([]ErrorPage, field.ErrorList) {
+	var allErrs field.ErrorList
+	errorPagesPath := field.NewPath("spec").Child("errorPages")
+
+	errorPages := make([]ErrorPage, 0, len(pol.Spec.ErrorPages))
+
+	for i, page := range pol.Spec.ErrorPages {
+		errorPage := ErrorPage{
+			ResponseCode: page.ResponseCode,
+			ContentType:  defaultErrorPageContentType,
+			Codes:        make([]int32, 0, len(page.Codes)),
+		}
+
+		if page.ContentType != nil {
+			errorPage.ContentType = string(*page.ContentType)
+		}
+
+		for _, code := range page.Codes {
+			errorPage.Codes = append(errorPage.Codes, int32(code))
+		}
+
+		switch {
+		case page.Body != nil:
+			errorPage.Content = []byte(*page.Body)
+		case page.BackendRef != nil:
+			backendRef, err := getErrorPageBackendRef(pol.Namespace, *page.BackendRef, services)
+			if err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(errorPagesPath.Index(i).Child("backendRef"), page.BackendRef.Name, err.Error()),
+				)
+				continue
+			}
+			errorPage.BackendRef = backendRef
+			errorPage.Path = "/"
+			if page.BackendRef.Path != nil {
+				errorPage.Path = *page.BackendRef.Path
+			}
+		default:
+			content, err := getConfigMapKeyContent(pol.Namespace, *page.ConfigMapRef, configMaps)
+			if err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(errorPagesPath.Index(i).Child("configMapRef"), page.ConfigMapRef.Name, err.Error()),
+				)
+				continue
+			}
+			errorPage.Content = content
+		}
+
+		errorPages = append(errorPages, errorPage)
+	}
+
+	return errorPages, allErrs
+}
+
+func getErrorPageBackendRef(
+	namespace string,
+	ref ngfAPI.ErrorPageBackendRef,
+	services map[types.NamespacedName]*apiv1.Service,
+) (*BackendRef, error) {
+	svcNsName := types.NamespacedName{Namespace: namespace, Name: ref.Name}
+
+	svc, exists := services[svcNsName]
+	if !exists {
+		return nil, fmt.Errorf("Service %s does not exist", svcNsName)
+	}
+
+	if svc.Spec.Type == apiv1.ServiceTypeExternalName {
+		return nil, fmt.Errorf("Service %s is an ExternalName Service, which is not supported", svcNsName)
+	}
+
+	svcPort, err := getServicePort(svc, ref.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackendRef{
+		SvcNsName:   svcNsName,
+		ServicePort: svcPort,
+		Valid:       true,
+	}, nil
+}
+
+func getConfigMapKeyContent(
+	namespace string,
+	ref ngfAPI.ConfigMapKeyReference,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) ([]byte, error) {
+	cm, exists := configMaps[types.NamespacedName{Namespace: namespace, Name: ref.Name}]
+	if !exists {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not exist", namespace, ref.Name)
+	}
+
+	if data, exists := cm.Data[ref.Key]; exists {
+		return []byte(data), nil
+	}
+
+	if data, exists := cm.BinaryData[ref.Key]; exists {
+		return data, nil
+	}
+
+	return nil, fmt.Errorf(
+		"ConfigMap %s/%s does not have the data or binaryData field %s",
+		namespace,
+		ref.Name,
+		ref.Key,
+	)
+}
+
+// buildReferencedErrorPageConfigMaps returns the NamespacedNames of all the ConfigMaps referenced by
+// ErrorPagePolicies, including invalid policies and ConfigMaps that don't exist.
+func buildReferencedErrorPageConfigMaps(
+	policies map[types.NamespacedName]*ngfAPI.ErrorPagePolicy,
+) map[types.NamespacedName]struct{} {
+	referencedConfigMaps := make(map[types.NamespacedName]struct{})
+
+	for _, pol := range policies {
+		for _, page := range pol.Spec.ErrorPages {
+			if page.ConfigMapRef != nil {
+				nsname := types.NamespacedName{Namespace: pol.Namespace, Name: page.ConfigMapRef.Name}
+				referencedConfigMaps[nsname] = struct{}{}
+			}
+		}
+	}
+
+	if len(referencedConfigMaps) == 0 {
+		return nil
+	}
+
+	return referencedConfigMaps
+}
+
+// addReferencedErrorPageServices adds the NamespacedNames of all the Services referenced by ErrorPagePolicies,
+// including invalid policies and Services that don't exist, to the referenced Services.
+func addReferencedErrorPageServices(
+	referencedServices map[types.NamespacedName]struct{},
+	policies map[types.NamespacedName]*ngfAPI.ErrorPagePolicy,
+) map[types.NamespacedName]struct{} {
+	for _, pol := range policies {
+		for _, page := range pol.Spec.ErrorPages {
+			if page.BackendRef == nil {
+				continue
+			}
+
+			if referencedServices == nil {
+				referencedServices = make(map[types.NamespacedName]struct{})
+			}
+
+			nsname := types.NamespacedName{Namespace: pol.Namespace, Name: page.BackendRef.Name}
+			referencedServices[nsname] = struct{}{}
+		}
+	}
+
+	return referencedServices
+}
+
+func validateErrorPagePolicy(
+	validator validation.GenericValidator,
+	pol *ngfAPI.ErrorPagePolicy,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	targetRefPath := spec.Child("targetRef")
+
+	ref := pol.Spec.TargetRef
+	if ref.Group != v1.GroupName {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("group"), ref.Group, []string{v1.GroupName}))
+	}
+
+	supportedKinds := []string{"Gateway", "HTTPRoute"}
+	if ref.Kind != v1.Kind("Gateway") && ref.Kind != v1.Kind("HTTPRoute") {
+		allErrs = append(allErrs, field.NotSupported(targetRefPath.Child("kind"), ref.Kind, supportedKinds))
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != pol.Namespace {
+		allErrs = append(
+			allErrs,
+			field.Invalid(targetRefPath.Child("namespace"), *ref.Namespace, "must be the same as the policy namespace"),
+		)
+	}
+
+	errorPagesPath := spec.Child("errorPages")
+	if len(pol.Spec.ErrorPages) == 0 {
+		allErrs = append(allErrs, field.Required(errorPagesPath, "must specify at least one error page"))
+	}
+
+	for i, page := range pol.Spec.ErrorPages {
+		allErrs = append(allErrs, validateErrorPage(validator, page, errorPagesPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateErrorPage performs re-validation on the error page in the case of CRD validation failure.
+func validateErrorPage(
+	validator validation.GenericValidator,
+	page ngfAPI.ErrorPage,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	codesPath := path.Child("codes")
+	if len(page.Codes) == 0 {
+		allErrs = append(allErrs, field.Required(codesPath, "must specify at least one status code"))
+	}
+
+	for i, code := range page.Codes {
+		if code < 300 || code > 599 {
+			allErrs = append(allErrs, field.Invalid(codesPath.Index(i), code, "must be between 300 and 599"))
+		}
+	}
+
+	if page.ResponseCode != nil && (*page.ResponseCode < 200 || *page.ResponseCode > 599) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(path.Child("responseCode"), *page.ResponseCode, "must be between 200 and 599"),
+		)
+	}
+
+	if page.ContentType != nil {
+		contentTypePath := path.Child("contentType")
+		if *page.ContentType == "*" {
+			allErrs = append(allErrs, field.Invalid(contentTypePath, *page.ContentType, "must not be *"))
+		} else if err := validator.ValidateMIMEType(string(*page.ContentType)); err != nil {
+			allErrs = append(allErrs, field.Invalid(contentTypePath, *page.ContentType, err.Error()))
+		}
+	}
+
+	sources := 0
+	for _, set := range []bool{page.Body != nil, page.ConfigMapRef != nil, page.BackendRef != nil} {
+		if set {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(path, "", "exactly one of body, configMapRef or backendRef must be specified"),
+		)
+	}
+
+	if page.BackendRef != nil {
+		allErrs = append(allErrs, validateErrorPageBackendRef(validator, *page.BackendRef, path.Child("backendRef"))...)
+	}
+
+	return allErrs
+}
+
+func validateErrorPageBackendRef(
+	validator validation.GenericValidator,
+	ref ngfAPI.ErrorPageBackendRef,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ref.Port < 1 || ref.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(path.Child("port"), ref.Port, "must be between 1 and 65535"))
+	}
+
+	if ref.Path != nil {
+		pathPath := path.Child("path")
+		if !strings.HasPrefix(*ref.Path, "/") {
+			allErrs = append(allErrs, field.Invalid(pathPath, *ref.Path, "must start with /"))
+		} else if err := validator.ValidateEscapedStringNoVarExpansion(*ref.Path); err != nil {
+			allErrs = append(allErrs, field.Invalid(pathPath, *ref.Path, err.Error()))
+		}
+	}
+
+	return allErrs
+}
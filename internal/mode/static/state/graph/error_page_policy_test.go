@@ -0,0 +1,576 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessErrorPagePolicies(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	createGateway := func() *Gateway {
+		return &Gateway{
+			Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: gwNsName.Name, Namespace: gwNsName.Namespace}},
+		}
+	}
+
+	createRoutes := func() map[RouteKey]*L7Route {
+		return map[RouteKey]*L7Route{
+			{
+				NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"},
+				RouteType:      RouteTypeHTTP,
+			}: {
+				Source: &gatewayv1.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{Name: "hr", Namespace: "test"},
+				},
+				RouteType: RouteTypeHTTP,
+				Valid:     true,
+				ParentRefs: []ParentRef{
+					{
+						Gateway:    gwNsName,
+						Attachment: &ParentRefAttachmentStatus{Attached: true},
+					},
+				},
+			},
+		}
+	}
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		{Namespace: "test", Name: "pages"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "pages", Namespace: "test"},
+			Data:       map[string]string{"404.html": "not found"},
+			BinaryData: map[string][]byte{"500.json": []byte(`{"error":"internal"}`)},
+		},
+	}
+
+	services := map[types.NamespacedName]*apiv1.Service{
+		{Namespace: "test", Name: "errors"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "errors", Namespace: "test"},
+			Spec: apiv1.ServiceSpec{
+				Ports: []apiv1.ServicePort{{Name: "http", Port: 80}},
+			},
+		},
+		{Namespace: "test", Name: "external"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "test"},
+			Spec: apiv1.ServiceSpec{
+				Type:         apiv1.ServiceTypeExternalName,
+				ExternalName: "errors.example.com",
+			},
+		},
+	}
+
+	createPolicy := func(name, kind, target string, created time.Time) *ngfAPI.ErrorPagePolicy {
+		return &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "test",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  gatewayv1.Kind(kind),
+					Name:  gatewayv1.ObjectName(target),
+				},
+				ErrorPages: []ngfAPI.ErrorPage{
+					{
+						Codes: []ngfAPI.ErrorStatusCode{404},
+						Body:  helpers.GetPointer("oops"),
+					},
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+
+	gwPolicy := createPolicy("gw", "Gateway", "gateway", now)
+	gwPolicy.Spec.ErrorPages = []ngfAPI.ErrorPage{
+		{
+			Codes:        []ngfAPI.ErrorStatusCode{404},
+			ResponseCode: helpers.GetPointer[int32](200),
+			ConfigMapRef: &ngfAPI.ConfigMapKeyReference{Name: "pages", Key: "404.html"},
+		},
+		{
+			Codes:        []ngfAPI.ErrorStatusCode{500, 502},
+			ContentType:  helpers.GetPointer[ngfAPI.MIMEType]("application/json"),
+			ConfigMapRef: &ngfAPI.ConfigMapKeyReference{Name: "pages", Key: "500.json"},
+		},
+	}
+	routePolicy := createPolicy("route", "HTTPRoute", "hr", now)
+	routePolicy.Spec.ErrorPages = append(routePolicy.Spec.ErrorPages, ngfAPI.ErrorPage{
+		Codes:      []ngfAPI.ErrorStatusCode{503},
+		BackendRef: &ngfAPI.ErrorPageBackendRef{Name: "errors", Port: 80, Path: helpers.GetPointer("/503.html")},
+	})
+	conflictedPolicy := createPolicy("conflicted", "HTTPRoute", "hr", now.Add(time.Second))
+	otherGwPolicy := createPolicy("other-gw", "Gateway", "other", now)
+	notFoundPolicy := createPolicy("not-found", "HTTPRoute", "does-not-exist", now)
+
+	missingCMPolicy := createPolicy("missing-cm", "HTTPRoute", "hr", now)
+	missingCMPolicy.Spec.ErrorPages[0].Body = nil
+	missingCMPolicy.Spec.ErrorPages[0].ConfigMapRef = &ngfAPI.ConfigMapKeyReference{Name: "missing", Key: "key"}
+
+	missingKeyPolicy := createPolicy("missing-key", "HTTPRoute", "hr", now)
+	missingKeyPolicy.Spec.ErrorPages[0].Body = nil
+	missingKeyPolicy.Spec.ErrorPages[0].ConfigMapRef = &ngfAPI.ConfigMapKeyReference{Name: "pages", Key: "missing"}
+
+	missingSvcPolicy := createPolicy("missing-svc", "HTTPRoute", "hr", now)
+	missingSvcPolicy.Spec.ErrorPages[0].Body = nil
+	missingSvcPolicy.Spec.ErrorPages[0].BackendRef = &ngfAPI.ErrorPageBackendRef{Name: "missing", Port: 80}
+
+	missingPortPolicy := createPolicy("missing-port", "HTTPRoute", "hr", now)
+	missingPortPolicy.Spec.ErrorPages[0].Body = nil
+	missingPortPolicy.Spec.ErrorPages[0].BackendRef = &ngfAPI.ErrorPageBackendRef{Name: "errors", Port: 8080}
+
+	externalNamePolicy := createPolicy("external-name", "HTTPRoute", "hr", now)
+	externalNamePolicy.Spec.ErrorPages[0].Body = nil
+	externalNamePolicy.Spec.ErrorPages[0].BackendRef = &ngfAPI.ErrorPageBackendRef{Name: "external", Port: 80}
+
+	tests := []struct {
+		policies    map[types.NamespacedName]*ngfAPI.ErrorPagePolicy
+		expected    map[types.NamespacedName]*ErrorPagePolicy
+		expGwPolicy *ngfAPI.ErrorPagePolicy
+		expRoutePol *ngfAPI.ErrorPagePolicy
+		name        string
+		nilGateway  bool
+	}{
+		{
+			name:     "no policies",
+			expected: nil,
+		},
+		{
+			name: "nil gateway",
+			policies: map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+				{Namespace: "test", Name: "route"}: routePolicy,
+			},
+			nilGateway: true,
+			expected:   nil,
+		},
+		{
+			name: "gateway and route policies",
+			policies: map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+				{Namespace: "test", Name: "gw"}:         gwPolicy,
+				{Namespace: "test", Name: "route"}:      routePolicy,
+				{Namespace: "test", Name: "conflicted"}: conflictedPolicy,
+			},
+			expected: map[types.NamespacedName]*ErrorPagePolicy{
+				{Namespace: "test", Name: "gw"}: {
+					Source:  gwPolicy,
+					Gateway: gwNsName,
+					ErrorPages: []ErrorPage{
+						{
+							Codes:        []int32{404},
+							ResponseCode: helpers.GetPointer[int32](200),
+							ContentType:  "text/html",
+							Content:      []byte("not found"),
+						},
+						{
+							Codes:       []int32{500, 502},
+							ContentType: "application/json",
+							Content:     []byte(`{"error":"internal"}`),
+						},
+					},
+					Conditions: []conditions.Condition{staticConds.NewErrorPagePolicyAccepted()},
+					Valid:      true,
+				},
+				{Namespace: "test", Name: "route"}: {
+					Source:  routePolicy,
+					Gateway: gwNsName,
+					ErrorPages: []ErrorPage{
+						{
+							Codes:       []int32{404},
+							ContentType: "text/html",
+							Content:     []byte("oops"),
+						},
+						{
+							Codes:       []int32{503},
+							ContentType: "text/html",
+							BackendRef: &BackendRef{
+								SvcNsName:   types.NamespacedName{Namespace: "test", Name: "errors"},
+								ServicePort: apiv1.ServicePort{Name: "http", Port: 80},
+								Valid:       true,
+							},
+							Path: "/503.html",
+						},
+					},
+					Conditions: []conditions.Condition{staticConds.NewErrorPagePolicyAccepted()},
+					Valid:      true,
+				},
+				{Namespace: "test", Name: "conflicted"}: {
+					Source:  conflictedPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyConflicted(
+							"HTTPRoute test/hr is already targeted by ErrorPagePolicy test/route",
+						),
+					},
+				},
+			},
+			expGwPolicy: gwPolicy,
+			expRoutePol: routePolicy,
+		},
+		{
+			name: "targets not found",
+			policies: map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+				{Namespace: "test", Name: "other-gw"}:  otherGwPolicy,
+				{Namespace: "test", Name: "not-found"}: notFoundPolicy,
+			},
+			expected: map[types.NamespacedName]*ErrorPagePolicy{
+				{Namespace: "test", Name: "other-gw"}: {
+					Source:  otherGwPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyTargetNotFound(
+							"Gateway test/other does not exist or is not processed",
+						),
+					},
+				},
+				{Namespace: "test", Name: "not-found"}: {
+					Source:  notFoundPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyTargetNotFound(
+							"HTTPRoute test/does-not-exist does not exist or is not attached to the Gateway",
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "unresolvable content",
+			policies: map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+				{Namespace: "test", Name: "missing-cm"}:    missingCMPolicy,
+				{Namespace: "test", Name: "missing-key"}:   missingKeyPolicy,
+				{Namespace: "test", Name: "missing-svc"}:   missingSvcPolicy,
+				{Namespace: "test", Name: "missing-port"}:  missingPortPolicy,
+				{Namespace: "test", Name: "external-name"}: externalNamePolicy,
+			},
+			expected: map[types.NamespacedName]*ErrorPagePolicy{
+				{Namespace: "test", Name: "missing-cm"}: {
+					Source:  missingCMPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyInvalid(
+							`spec.errorPages[0].configMapRef: Invalid value: "missing": ` +
+								"ConfigMap test/missing does not exist",
+						),
+					},
+				},
+				{Namespace: "test", Name: "missing-key"}: {
+					Source:  missingKeyPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyInvalid(
+							`spec.errorPages[0].configMapRef: Invalid value: "pages": ` +
+								"ConfigMap test/pages does not have the data or binaryData field missing",
+						),
+					},
+				},
+				{Namespace: "test", Name: "missing-svc"}: {
+					Source:  missingSvcPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyInvalid(
+							`spec.errorPages[0].backendRef: Invalid value: "missing": ` +
+								"Service test/missing does not exist",
+						),
+					},
+				},
+				{Namespace: "test", Name: "missing-port"}: {
+					Source:  missingPortPolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyInvalid(
+							`spec.errorPages[0].backendRef: Invalid value: "errors": ` +
+								"no matching port for Service errors and port 8080",
+						),
+					},
+				},
+				{Namespace: "test", Name: "external-name"}: {
+					Source:  externalNamePolicy,
+					Gateway: gwNsName,
+					Conditions: []conditions.Condition{
+						staticConds.NewErrorPagePolicyInvalid(
+							`spec.errorPages[0].backendRef: Invalid value: "external": ` +
+								"Service test/external is an ExternalName Service, which is not supported",
+						),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var gateway *Gateway
+			if !test.nilGateway {
+				gateway = createGateway()
+			}
+			routes := createRoutes()
+
+			processed := processErrorPagePolicies(
+				test.policies,
+				configMaps,
+				services,
+				routes,
+				&validationfakes.FakeGenericValidator{},
+				gateway,
+			)
+			g.Expect(processed).To(Equal(test.expected))
+
+			if gateway != nil {
+				if test.expGwPolicy == nil {
+					g.Expect(gateway.ErrorPagePolicy).To(BeNil())
+				} else {
+					g.Expect(gateway.ErrorPagePolicy).ToNot(BeNil())
+					g.Expect(gateway.ErrorPagePolicy.Source).To(Equal(test.expGwPolicy))
+				}
+			}
+
+			for _, route := range routes {
+				if test.expRoutePol == nil {
+					g.Expect(route.ErrorPagePolicy).To(BeNil())
+					continue
+				}
+
+				g.Expect(route.ErrorPagePolicy).ToNot(BeNil())
+				g.Expect(route.ErrorPagePolicy.Source).To(Equal(test.expRoutePol))
+			}
+		})
+	}
+}
+
+func TestValidateErrorPagePolicy(t *testing.T) {
+	createPolicy := func(modify func(*ngfAPI.ErrorPagePolicy)) *ngfAPI.ErrorPagePolicy {
+		pol := &ngfAPI.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "test"},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				TargetRef: v1alpha2.PolicyTargetReference{
+					Group: gatewayv1.GroupName,
+					Kind:  "Gateway",
+					Name:  "gateway",
+				},
+				ErrorPages: []ngfAPI.ErrorPage{
+					{
+						Codes:        []ngfAPI.ErrorStatusCode{404, 500},
+						ResponseCode: helpers.GetPointer[int32](200),
+						ContentType:  helpers.GetPointer[ngfAPI.MIMEType]("text/plain"),
+						Body:         helpers.GetPointer("oops"),
+					},
+				},
+			},
+		}
+
+		if modify != nil {
+			modify(pol)
+		}
+
+		return pol
+	}
+
+	invalidValidator := &validationfakes.FakeGenericValidator{}
+	invalidValidator.ValidateMIMETypeReturns(errors.New("error"))
+
+	tests := []struct {
+		policy          *ngfAPI.ErrorPagePolicy
+		validator       *validationfakes.FakeGenericValidator
+		name            string
+		expErrSubstring string
+		expErrCount     int
+	}{
+		{
+			name:      "valid",
+			policy:    createPolicy(nil),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "valid HTTPRoute target",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.TargetRef.Kind = "HTTPRoute"
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("test")
+			}),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "invalid target",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.TargetRef.Group = "core"
+				pol.Spec.TargetRef.Kind = "Service"
+				pol.Spec.TargetRef.Namespace = helpers.GetPointer[gatewayv1.Namespace]("other")
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.targetRef",
+			expErrCount:     3,
+		},
+		{
+			name: "no error pages",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages = nil
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.errorPages",
+			expErrCount:     1,
+		},
+		{
+			name: "invalid codes",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].Codes = []ngfAPI.ErrorStatusCode{200, 600}
+				pol.Spec.ErrorPages[0].ResponseCode = helpers.GetPointer[int32](100)
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.errorPages[0]",
+			expErrCount:     3,
+		},
+		{
+			name:            "invalid content type",
+			policy:          createPolicy(nil),
+			validator:       invalidValidator,
+			expErrSubstring: "spec.errorPages[0].contentType",
+			expErrCount:     1,
+		},
+		{
+			name: "wildcard content type",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].ContentType = helpers.GetPointer[ngfAPI.MIMEType]("*")
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.errorPages[0].contentType",
+			expErrCount:     1,
+		},
+		{
+			name: "both body and configMapRef",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].ConfigMapRef = &ngfAPI.ConfigMapKeyReference{Name: "cm", Key: "key"}
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "exactly one of body, configMapRef or backendRef must be specified",
+			expErrCount:     1,
+		},
+		{
+			name: "no content",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].Body = nil
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "exactly one of body, configMapRef or backendRef must be specified",
+			expErrCount:     1,
+		},
+		{
+			name: "valid backendRef",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].Body = nil
+				pol.Spec.ErrorPages[0].BackendRef = &ngfAPI.ErrorPageBackendRef{
+					Name: "errors",
+					Port: 80,
+					Path: helpers.GetPointer("/404.html"),
+				}
+			}),
+			validator: &validationfakes.FakeGenericValidator{},
+		},
+		{
+			name: "invalid backendRef",
+			policy: createPolicy(func(pol *ngfAPI.ErrorPagePolicy) {
+				pol.Spec.ErrorPages[0].Body = nil
+				pol.Spec.ErrorPages[0].BackendRef = &ngfAPI.ErrorPageBackendRef{
+					Name: "errors",
+					Port: 0,
+					Path: helpers.GetPointer("404.html"),
+				}
+			}),
+			validator:       &validationfakes.FakeGenericValidator{},
+			expErrSubstring: "spec.errorPages[0].backendRef",
+			expErrCount:     2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			allErrs := validateErrorPagePolicy(test.validator, test.policy)
+			g.Expect(allErrs).To(HaveLen(test.expErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
+
+func TestBuildReferencedErrorPageConfigMaps(t *testing.T) {
+	policies := map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+		{Namespace: "test", Name: "policy"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "test"},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				ErrorPages: []ngfAPI.ErrorPage{
+					{ConfigMapRef: &ngfAPI.ConfigMapKeyReference{Name: "cm-1", Key: "key"}},
+					{Body: helpers.GetPointer("body")},
+					{ConfigMapRef: &ngfAPI.ConfigMapKeyReference{Name: "cm-2", Key: "key"}},
+				},
+			},
+		},
+		{Namespace: "other", Name: "policy"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "other"},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				ErrorPages: []ngfAPI.ErrorPage{
+					{ConfigMapRef: &ngfAPI.ConfigMapKeyReference{Name: "cm-1", Key: "key"}},
+				},
+			},
+		},
+	}
+
+	expected := map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "cm-1"}:  {},
+		{Namespace: "test", Name: "cm-2"}:  {},
+		{Namespace: "other", Name: "cm-1"}: {},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildReferencedErrorPageConfigMaps(policies)).To(Equal(expected))
+	g.Expect(buildReferencedErrorPageConfigMaps(nil)).To(BeNil())
+}
+
+func TestAddReferencedErrorPageServices(t *testing.T) {
+	policies := map[types.NamespacedName]*ngfAPI.ErrorPagePolicy{
+		{Namespace: "test", Name: "policy"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "test"},
+			Spec: ngfAPI.ErrorPagePolicySpec{
+				ErrorPages: []ngfAPI.ErrorPage{
+					{BackendRef: &ngfAPI.ErrorPageBackendRef{Name: "errors", Port: 80}},
+					{Body: helpers.GetPointer("body")},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(addReferencedErrorPageServices(nil, policies)).To(Equal(map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "errors"}: {},
+	}))
+
+	referencedServices := map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "backend"}: {},
+	}
+	g.Expect(addReferencedErrorPageServices(referencedServices, policies)).To(Equal(map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "backend"}: {},
+		{Namespace: "test", Name: "errors"}:  {},
+	}))
+
+	g.Expect(addReferencedErrorPageServices(nil, nil)).To(BeNil())
+}
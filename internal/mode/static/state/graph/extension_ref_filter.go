@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// ExtensionRefFilter is an NGF filter resource referenced by an ExtensionRef filter of a route rule.
+type ExtensionRefFilter struct {
+	// Filter is the processed filter resource. Its type depends on the Kind, for example *SnippetsFilter for
+	// the SnippetsFilter kind.
+	Filter any
+	// NsName is the NamespacedName of the filter resource.
+	NsName types.NamespacedName
+	// Kind is the kind of the filter resource.
+	Kind v1.Kind
+	// Valid indicates whether the filter resource is valid.
+	Valid bool
+}
+
+// extensionRefFilterResolver returns the filter resource of a kind with the given NamespacedName.
+// If the resource doesn't exist, it returns nil.
+type extensionRefFilterResolver func(nsname types.NamespacedName) *ExtensionRefFilter
+
+// extensionRefFilterKey identifies a kind of filter resources.
+type extensionRefFilterKey struct {
+	group v1.Group
+	kind  v1.Kind
+}
+
+// extensionRefFilterRegistry holds the resolvers of the filter kinds that route rules can reference through
+// ExtensionRef filters. To support a new NGF filter CRD, register a resolver for its kind in BuildGraph and
+// convert the resolved filter into dataplane.HTTPFilters in the dataplane package.
+type extensionRefFilterRegistry struct {
+	resolvers map[extensionRefFilterKey]extensionRefFilterResolver
+}
+
+func newExtensionRefFilterRegistry() *extensionRefFilterRegistry {
+	return &extensionRefFilterRegistry{
+		resolvers: make(map[extensionRefFilterKey]extensionRefFilterResolver),
+	}
+}
+
+// register registers the resolver for the filter resources of the group and kind.
+func (r *extensionRefFilterRegistry) register(group v1.Group, kind v1.Kind, resolver extensionRefFilterResolver) {
+	r.resolvers[extensionRefFilterKey{group: group, kind: kind}] = resolver
+}
+
+// supportedKinds returns the registered kinds in the form group/kind.
+func (r *extensionRefFilterRegistry) supportedKinds() []string {
+	kinds := make([]string, 0, len(r.resolvers))
+	for key := range r.resolvers {
+		kinds = append(kinds, fmt.Sprintf("%s/%s", key.group, key.kind))
+	}
+
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// resolveExtensionRefFilters resolves the filter resources referenced by the ExtensionRef filters of the
+// HTTPRoute rules. If a referenced filter is of an unsupported kind, doesn't exist or is invalid, the filters of
+// the rule are marked as invalid and the route gets a ResolvedRefs condition.
+func resolveExtensionRefFilters(routes map[RouteKey]*L7Route, registry *extensionRefFilterRegistry) {
+	for _, route := range routes {
+		if !route.Valid || route.RouteType != RouteTypeHTTP {
+			continue
+		}
+
+		var invalidKindErrs, invalidFilterErrs field.ErrorList
+
+		for i := range route.Spec.Rules {
+			rule := &route.Spec.Rules[i]
+			if !rule.ValidFilters {
+				continue
+			}
+
+			filtersPath := field.NewPath("spec").Child("rules").Index(i).Child("filters")
+
+			var ruleErrCount int
+			var resolvedFilters []ExtensionRefFilter
+
+			for j, filter := range rule.Filters {
+				if filter.Type != v1.HTTPRouteFilterExtensionRef || filter.ExtensionRef == nil {
+					continue
+				}
+
+				ref := filter.ExtensionRef
+				refPath := filtersPath.Index(j).Child("extensionRef")
+
+				resolver, supported := registry.resolvers[extensionRefFilterKey{group: ref.Group, kind: ref.Kind}]
+				if !supported {
+					invalidKindErrs = append(
+						invalidKindErrs,
+						field.NotSupported(refPath, fmt.Sprintf("%s/%s", ref.Group, ref.Kind), registry.supportedKinds()),
+					)
+					ruleErrCount++
+					continue
+				}
+
+				nsname := types.NamespacedName{Namespace: route.Source.GetNamespace(), Name: string(ref.Name)}
+
+				resolved := resolver(nsname)
+				switch {
+				case resolved == nil:
+					invalidFilterErrs = append(
+						invalidFilterErrs,
+						field.NotFound(refPath, fmt.Sprintf("%s %s", ref.Kind, nsname)),
+					)
+					ruleErrCount++
+				case !resolved.Valid:
+					invalidFilterErrs = append(
+						invalidFilterErrs,
+						field.Invalid(refPath, ref.Name, fmt.Sprintf("%s %s is invalid", ref.Kind, nsname)),
+					)
+					ruleErrCount++
+				default:
+					resolvedFilters = append(resolvedFilters, *resolved)
+				}
+			}
+
+			if ruleErrCount > 0 {
+				rule.ValidFilters = false
+				continue
+			}
+
+			rule.ExtensionRefFilters = resolvedFilters
+		}
+
+		if len(invalidKindErrs) > 0 {
+			route.Conditions = append(
+				route.Conditions,
+				staticConds.NewRouteResolvedRefsInvalidFilterKind(invalidKindErrs.ToAggregate().Error()),
+			)
+		}
+
+		if len(invalidFilterErrs) > 0 {
+			route.Conditions = append(
+				route.Conditions,
+				staticConds.NewRouteResolvedRefsInvalidFilter(invalidFilterErrs.ToAggregate().Error()),
+			)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestResolveExtensionRefFilters(t *testing.T) {
+	validFilter := &SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "test"}},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextHTTPServerLocation: "add_header X-Test test;",
+		},
+		Valid: true,
+	}
+	invalidFilter := &SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "test"}},
+	}
+
+	registry := newExtensionRefFilterRegistry()
+	registry.registerSnippetsFilters(map[types.NamespacedName]*SnippetsFilter{
+		{Namespace: "test", Name: "valid"}:   validFilter,
+		{Namespace: "test", Name: "invalid"}: invalidFilter,
+	})
+
+	createExtRefFilter := func(group gatewayv1.Group, kind gatewayv1.Kind, name string) gatewayv1.HTTPRouteFilter {
+		return gatewayv1.HTTPRouteFilter{
+			Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gatewayv1.LocalObjectReference{
+				Group: group,
+				Kind:  kind,
+				Name:  gatewayv1.ObjectName(name),
+			},
+		}
+	}
+
+	createSnippetsFilterRef := func(name string) gatewayv1.HTTPRouteFilter {
+		return createExtRefFilter(ngfAPI.GroupName, SnippetsFilterKind, name)
+	}
+
+	createRoute := func(filters ...gatewayv1.HTTPRouteFilter) *L7Route {
+		return &L7Route{
+			Source: &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "hr", Namespace: "test"},
+			},
+			RouteType: RouteTypeHTTP,
+			Valid:     true,
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{
+						ValidMatches: true,
+						ValidFilters: true,
+						Filters:      filters,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		route             *L7Route
+		registry          *extensionRefFilterRegistry
+		name              string
+		expectedFilters   []ExtensionRefFilter
+		expectedConds     []conditions.Condition
+		expectValidFilter bool
+	}{
+		{
+			name: "no extension ref filters",
+			route: createRoute(gatewayv1.HTTPRouteFilter{
+				Type:                  gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{},
+			}),
+			expectValidFilter: true,
+		},
+		{
+			name:  "valid snippets filter",
+			route: createRoute(createSnippetsFilterRef("valid")),
+			expectedFilters: []ExtensionRefFilter{
+				{
+					Filter: validFilter,
+					NsName: types.NamespacedName{Namespace: "test", Name: "valid"},
+					Kind:   SnippetsFilterKind,
+					Valid:  true,
+				},
+			},
+			expectValidFilter: true,
+		},
+		{
+			name:  "filter does not exist",
+			route: createRoute(createSnippetsFilterRef("valid"), createSnippetsFilterRef("missing")),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilter(
+					`spec.rules[0].filters[1].extensionRef: Not found: "SnippetsFilter test/missing"`,
+				),
+			},
+			expectValidFilter: false,
+		},
+		{
+			name:  "filter is invalid",
+			route: createRoute(createSnippetsFilterRef("invalid")),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "invalid": SnippetsFilter test/invalid is invalid`,
+				),
+			},
+			expectValidFilter: false,
+		},
+		{
+			name:  "filter of unsupported kind",
+			route: createRoute(createExtRefFilter("example.com", "Unknown", "unknown")),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilterKind(
+					`spec.rules[0].filters[0].extensionRef: Unsupported value: "example.com/Unknown": ` +
+						`supported values: "gateway.nginx.org/SnippetsFilter"`,
+				),
+			},
+			expectValidFilter: false,
+		},
+		{
+			name:     "snippets filter when SnippetsFilters are disabled",
+			route:    createRoute(createSnippetsFilterRef("valid")),
+			registry: newExtensionRefFilterRegistry(),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilterKind(
+					`spec.rules[0].filters[0].extensionRef: Unsupported value: "gateway.nginx.org/SnippetsFilter"`,
+				),
+			},
+			expectValidFilter: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			routes := map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"}, RouteType: RouteTypeHTTP}: test.route,
+			}
+
+			testRegistry := registry
+			if test.registry != nil {
+				testRegistry = test.registry
+			}
+
+			resolveExtensionRefFilters(routes, testRegistry)
+
+			g.Expect(test.route.Spec.Rules[0].ExtensionRefFilters).To(Equal(test.expectedFilters))
+			g.Expect(test.route.Spec.Rules[0].ValidFilters).To(Equal(test.expectValidFilter))
+			g.Expect(test.route.Conditions).To(Equal(test.expectedConds))
+		})
+	}
+}
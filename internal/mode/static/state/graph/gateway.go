@@ -1,9 +1,12 @@
 package graph
 
 import (
+	"net"
 	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -19,6 +22,8 @@ type Gateway struct {
 	Source *v1.Gateway
 	// Listeners include the listeners of the Gateway.
 	Listeners []*Listener
+	// ErrorPagePolicy is the valid ErrorPagePolicy that targets the Gateway, if any.
+	ErrorPagePolicy *ErrorPagePolicy
 	// Conditions holds the conditions for the Gateway.
 	Conditions []conditions.Condition
 	// Valid indicates whether the Gateway Spec is valid.
@@ -127,12 +132,41 @@ func validateGateway(gw *v1.Gateway, gc *GatewayClass) []conditions.Condition {
 		conds = append(conds, staticConds.NewGatewayInvalid("GatewayClass is invalid")...)
 	}
 
-	if len(gw.Spec.Addresses) > 0 {
-		path := field.NewPath("spec", "addresses")
-		valErr := field.Forbidden(path, "addresses are not supported")
-
-		conds = append(conds, staticConds.NewGatewayUnsupportedValue(valErr.Error())...)
+	if errs := validateGatewayAddresses(gw.Spec.Addresses); len(errs) > 0 {
+		conds = append(conds, staticConds.NewGatewayUnsupportedAddress(errs.ToAggregate().Error())...)
 	}
 
 	return conds
 }
+
+// validateGatewayAddresses validates the addresses requested in spec.addresses of the Gateway.
+// NGF supports IPAddress and Hostname addresses. The IPAddress type is the default.
+func validateGatewayAddresses(addresses []v1.GatewayAddress) field.ErrorList {
+	var allErrs field.ErrorList
+
+	supportedTypes := []string{string(v1.IPAddressType), string(v1.HostnameAddressType)}
+
+	for i, addr := range addresses {
+		path := field.NewPath("spec", "addresses").Index(i)
+
+		addrType := v1.IPAddressType
+		if addr.Type != nil {
+			addrType = *addr.Type
+		}
+
+		switch addrType {
+		case v1.IPAddressType:
+			if net.ParseIP(addr.Value) == nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("value"), addr.Value, "must be a valid IP address"))
+			}
+		case v1.HostnameAddressType:
+			if msgs := k8svalidation.IsDNS1123Subdomain(addr.Value); len(msgs) > 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("value"), addr.Value, strings.Join(msgs, ", ")))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(path.Child("type"), addrType, supportedTypes))
+		}
+	}
+
+	return allErrs
+}
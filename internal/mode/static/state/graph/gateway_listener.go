@@ -445,6 +445,13 @@ func createExternalReferencesForTLSSecretsResolver(
 		if certRefNs != gwNs {
 			if !refGrantResolver.refAllowed(toSecret(certRefNsName), fromGateway(gwNs)) {
 				msg := fmt.Sprintf("Certificate ref to secret %s not permitted by any ReferenceGrant", certRefNsName)
+				if !refGrantResolver.namespaceWatched(certRefNs) {
+					msg = fmt.Sprintf(
+						"Certificate ref to secret %s not permitted: Namespace %s is not watched by NGINX Gateway Fabric",
+						certRefNsName,
+						certRefNs,
+					)
+				}
 
 				l.Conditions = append(l.Conditions, staticConds.NewListenerRefNotPermitted(msg)...)
 				l.Valid = false
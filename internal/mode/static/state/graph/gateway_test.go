@@ -869,22 +869,62 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "port/protocol collisions",
 		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners: []v1.Listener{foo80Listener1},
+					addresses: []v1.GatewayAddress{
+						{Value: "10.0.0.1"},
+						{Type: helpers.GetPointer(v1.IPAddressType), Value: "fd00::1"},
+						{Type: helpers.GetPointer(v1.HostnameAddressType), Value: "gateway.example.com"},
+					},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGetaway(),
+				Listeners: []*Listener{
+					{
+						Name:       "foo-80-1",
+						Source:     foo80Listener1,
+						Valid:      true,
+						Attachable: true,
+						Routes:     map[RouteKey]*L7Route{},
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: "HTTPRoute"},
+						},
+					},
+				},
+				Valid: true,
+			},
+			name: "valid gateway addresses",
+		},
 		{
 			gateway: createGateway(
 				gatewayCfg{
 					listeners: []v1.Listener{foo80Listener1, foo443HTTPSListener1},
-					addresses: []v1.GatewayAddress{{}},
+					addresses: []v1.GatewayAddress{
+						{Value: "invalid"},
+						{Type: helpers.GetPointer(v1.HostnameAddressType), Value: "$example.com"},
+						{Type: helpers.GetPointer(v1.NamedAddressType), Value: "my-address"},
+					},
 				},
 			),
 			gatewayClass: validGC,
 			expected: &Gateway{
 				Source: getLastCreatedGetaway(),
 				Valid:  false,
-				Conditions: staticConds.NewGatewayUnsupportedValue("spec." +
-					"addresses: Forbidden: addresses are not supported",
+				Conditions: staticConds.NewGatewayUnsupportedAddress("[" +
+					`spec.addresses[0].value: Invalid value: "invalid": must be a valid IP address, ` +
+					`spec.addresses[1].value: Invalid value: "$example.com": a lowercase RFC 1123 subdomain ` +
+					"must consist of lower case alphanumeric characters, '-' or '.', and must start and end " +
+					"with an alphanumeric character (e.g. 'example.com', regex used for validation is " +
+					`'[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), ` +
+					`spec.addresses[2].type: Unsupported value: "NamedAddress": ` +
+					`supported values: "IPAddress", "Hostname"]`,
 				),
 			},
-			name: "gateway addresses are not supported",
+			name: "invalid gateway addresses",
 		},
 		{
 			gateway:  nil,
@@ -921,12 +961,14 @@ func TestBuildGateway(t *testing.T) {
 		map[types.NamespacedName]*apiv1.Secret{
 			client.ObjectKeyFromObject(secretSameNs):        secretSameNs,
 			client.ObjectKeyFromObject(secretDiffNamespace): secretDiffNamespace,
-		})
+		},
+		allNamespacesWatched,
+	)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			resolver := newReferenceGrantResolver(test.refGrants)
+			resolver := newReferenceGrantResolver(test.refGrants, allNamespacesWatched)
 			result := buildGateway(test.gateway, secretResolver, test.gatewayClass, resolver, protectedPorts)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
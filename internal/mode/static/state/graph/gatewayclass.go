@@ -67,12 +67,13 @@ func buildGatewayClass(
 	npCfg *ngfAPI.NginxProxy,
 	crdVersions map[types.NamespacedName]*metav1.PartialObjectMetadata,
 	validator validation.GenericValidator,
+	plus bool,
 ) *GatewayClass {
 	if gc == nil {
 		return nil
 	}
 
-	conds, valid := validateGatewayClass(gc, npCfg, crdVersions, validator)
+	conds, valid := validateGatewayClass(gc, npCfg, crdVersions, validator, plus)
 
 	return &GatewayClass{
 		Source:     gc,
@@ -86,6 +87,7 @@ func validateGatewayClass(
 	npCfg *ngfAPI.NginxProxy,
 	crdVersions map[types.NamespacedName]*metav1.PartialObjectMetadata,
 	validator validation.GenericValidator,
+	plus bool,
 ) ([]conditions.Condition, bool) {
 	var conds []conditions.Condition
 
@@ -99,6 +101,10 @@ func validateGatewayClass(
 			conds = append(conds, staticConds.NewGatewayClassRefNotFound())
 		} else {
 			nginxProxyErrs := validateNginxProxy(validator, npCfg)
+			nginxProxyErrs = append(
+				nginxProxyErrs,
+				validateBrotli(plus, npCfg.Spec.Compression, field.NewPath("spec").Child("compression"))...,
+			)
 			if len(nginxProxyErrs) > 0 {
 				err = errors.New(nginxProxyErrs.ToAggregate().Error())
 			}
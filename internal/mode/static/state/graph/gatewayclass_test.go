@@ -188,6 +188,7 @@ func TestBuildGatewayClass(t *testing.T) {
 		crdMetadata map[types.NamespacedName]*metav1.PartialObjectMetadata
 		expected    *GatewayClass
 		name        string
+		plus        bool
 	}{
 		{
 			gc:          validGC,
@@ -283,6 +284,51 @@ func TestBuildGatewayClass(t *testing.T) {
 			},
 			name: "invalid gatewayclass with invalid paramsRef resource",
 		},
+		{
+			gc: gcWithParams,
+			np: &ngfAPI.NginxProxy{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "NginxProxy",
+				},
+				Spec: ngfAPI.NginxProxySpec{
+					Compression: &ngfAPI.Compression{
+						Brotli: helpers.GetPointer(true),
+					},
+				},
+			},
+			validator: createValidNPValidator(),
+			expected: &GatewayClass{
+				Source: gcWithParams,
+				Valid:  true,
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayClassInvalidParameters(
+						"spec.compression.brotli: Forbidden: Brotli compression requires NGINX Plus",
+					),
+				},
+			},
+			name: "invalid gatewayclass with brotli compression in OSS",
+		},
+		{
+			gc: gcWithParams,
+			np: &ngfAPI.NginxProxy{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "NginxProxy",
+				},
+				Spec: ngfAPI.NginxProxySpec{
+					Compression: &ngfAPI.Compression{
+						Brotli: helpers.GetPointer(true),
+					},
+				},
+			},
+			validator: createValidNPValidator(),
+			plus:      true,
+			expected: &GatewayClass{
+				Source:     gcWithParams,
+				Valid:      true,
+				Conditions: []conditions.Condition{staticConds.NewGatewayClassResolvedRefs()},
+			},
+			name: "valid gatewayclass with brotli compression in NGINX Plus",
+		},
 		{
 			gc:          validGC,
 			crdMetadata: invalidCRDs,
@@ -299,7 +345,7 @@ func TestBuildGatewayClass(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			result := buildGatewayClass(test.gc, test.np, test.crdMetadata, test.validator)
+			result := buildGatewayClass(test.gc, test.np, test.crdMetadata, test.validator, test.plus)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
@@ -17,18 +17,22 @@ import (
 
 // ClusterState includes cluster resources necessary to build the Graph.
 type ClusterState struct {
-	GatewayClasses     map[types.NamespacedName]*gatewayv1.GatewayClass
-	Gateways           map[types.NamespacedName]*gatewayv1.Gateway
-	HTTPRoutes         map[types.NamespacedName]*gatewayv1.HTTPRoute
-	Services           map[types.NamespacedName]*v1.Service
-	Namespaces         map[types.NamespacedName]*v1.Namespace
-	ReferenceGrants    map[types.NamespacedName]*v1beta1.ReferenceGrant
-	Secrets            map[types.NamespacedName]*v1.Secret
-	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
-	BackendTLSPolicies map[types.NamespacedName]*v1alpha2.BackendTLSPolicy
-	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
-	NginxProxies       map[types.NamespacedName]*ngfAPI.NginxProxy
-	GRPCRoutes         map[types.NamespacedName]*v1alpha2.GRPCRoute
+	GatewayClasses      map[types.NamespacedName]*gatewayv1.GatewayClass
+	Gateways            map[types.NamespacedName]*gatewayv1.Gateway
+	HTTPRoutes          map[types.NamespacedName]*gatewayv1.HTTPRoute
+	Services            map[types.NamespacedName]*v1.Service
+	Namespaces          map[types.NamespacedName]*v1.Namespace
+	ReferenceGrants     map[types.NamespacedName]*v1beta1.ReferenceGrant
+	Secrets             map[types.NamespacedName]*v1.Secret
+	CRDMetadata         map[types.NamespacedName]*metav1.PartialObjectMetadata
+	BackendTLSPolicies  map[types.NamespacedName]*v1alpha2.BackendTLSPolicy
+	ConfigMaps          map[types.NamespacedName]*v1.ConfigMap
+	NginxProxies        map[types.NamespacedName]*ngfAPI.NginxProxy
+	GRPCRoutes          map[types.NamespacedName]*v1alpha2.GRPCRoute
+	CompressionPolicies map[types.NamespacedName]*ngfAPI.CompressionPolicy
+	ErrorPagePolicies   map[types.NamespacedName]*ngfAPI.ErrorPagePolicy
+	SnippetsFilters     map[types.NamespacedName]*ngfAPI.SnippetsFilter
+	HostnameBackends    map[types.NamespacedName]*ngfAPI.HostnameBackend
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -59,10 +63,19 @@ type Graph struct {
 	ReferencedServices map[types.NamespacedName]struct{}
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// ReferencedErrorPageConfigMaps includes the NamespacedNames of all the ConfigMaps that are referenced by
+	// ErrorPagePolicies. Like ReferencedSecrets, it includes entries for ConfigMaps that do not exist in the cluster.
+	ReferencedErrorPageConfigMaps map[types.NamespacedName]struct{}
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
 	// NginxProxy holds the NginxProxy config for the GatewayClass.
 	NginxProxy *ngfAPI.NginxProxy
+	// CompressionPolicies holds CompressionPolicy resources.
+	CompressionPolicies map[types.NamespacedName]*CompressionPolicy
+	// ErrorPagePolicies holds ErrorPagePolicy resources.
+	ErrorPagePolicies map[types.NamespacedName]*ErrorPagePolicy
+	// SnippetsFilters holds SnippetsFilter resources.
+	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
 }
 
 // ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of each port.
@@ -75,8 +88,9 @@ func (g *Graph) IsReferenced(resourceType client.Object, nsname types.Namespaced
 		_, exists := g.ReferencedSecrets[nsname]
 		return exists
 	case *v1.ConfigMap:
-		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, isCaCert := g.ReferencedCaCertConfigMaps[nsname]
+		_, isErrorPage := g.ReferencedErrorPageConfigMaps[nsname]
+		return isCaCert || isErrorPage
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -119,6 +133,9 @@ func BuildGraph(
 	gcName string,
 	validators validation.Validators,
 	protectedPorts ProtectedPorts,
+	watchedNamespaces WatchedNamespaces,
+	plus bool,
+	snippetsFilters bool,
 ) *Graph {
 	processedGwClasses, gcExists := processGatewayClasses(state.GatewayClasses, gcName, controllerName)
 	if gcExists && processedGwClasses.Winner == nil {
@@ -127,14 +144,19 @@ func BuildGraph(
 	}
 
 	npCfg := getNginxProxy(state.NginxProxies, processedGwClasses.Winner)
-	gc := buildGatewayClass(processedGwClasses.Winner, npCfg, state.CRDMetadata, validators.GenericValidator)
+	gc := buildGatewayClass(processedGwClasses.Winner, npCfg, state.CRDMetadata, validators.GenericValidator, plus)
+	// The GatewayClass reports the unsupported Brotli settings, but the NginxProxy settings still apply, so Brotli
+	// is removed from them to keep it out of the NGINX configuration.
+	npCfg = removeUnsupportedBrotli(npCfg, plus)
+
+	isNamespaceWatched := newIsNamespaceWatchedFunc(watchedNamespaces)
 
-	secretResolver := newSecretResolver(state.Secrets)
+	secretResolver := newSecretResolver(state.Secrets, isNamespaceWatched)
 	configMapResolver := newConfigMapResolver(state.ConfigMaps)
 
 	processedGws := processGateways(state.Gateways, gcName)
 
-	refGrantResolver := newReferenceGrantResolver(state.ReferenceGrants)
+	refGrantResolver := newReferenceGrantResolver(state.ReferenceGrants, isNamespaceWatched)
 	gw := buildGateway(processedGws.Winner, secretResolver, gc, refGrantResolver, protectedPorts)
 
 	processedBackendTLSPolicies := processBackendTLSPolicies(
@@ -150,12 +172,58 @@ func BuildGraph(
 		state.GRPCRoutes,
 		processedGws.GetAllNsNames(),
 	)
+	invalidateRoutesInNotWatchedNamespaces(routes, isNamespaceWatched)
+	if snippetsFilters {
+		processRouteSnippetAnnotations(routes, validators.GenericValidator, validators.SnippetValidator)
+	}
 	bindRoutesToListeners(routes, gw, state.Namespaces)
-	addBackendRefsToRouteRules(routes, refGrantResolver, state.Services, processedBackendTLSPolicies)
+
+	processedSnippetsFilters := processSnippetsFilters(
+		state.SnippetsFilters,
+		validators.GenericValidator,
+		validators.SnippetValidator,
+	)
+
+	// If SnippetsFilters are disabled, their resolver is not registered, so the routes that reference them
+	// get the InvalidKind reason.
+	extRefFilterRegistry := newExtensionRefFilterRegistry()
+	if snippetsFilters {
+		extRefFilterRegistry.registerSnippetsFilters(processedSnippetsFilters)
+	}
+	resolveExtensionRefFilters(routes, extRefFilterRegistry)
+
+	addBackendRefsToRouteRules(
+		routes,
+		refGrantResolver,
+		state.Services,
+		state.HostnameBackends,
+		processedBackendTLSPolicies,
+		checkDNSResolution(plus, npCfg),
+	)
 
 	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gw)
 
-	referencedServices := buildReferencedServices(routes)
+	referencedServices := addReferencedErrorPageServices(
+		buildReferencedServices(routes),
+		state.ErrorPagePolicies,
+	)
+
+	processedCompressionPolicies := processCompressionPolicies(
+		state.CompressionPolicies,
+		routes,
+		validators.GenericValidator,
+		gw,
+		plus,
+	)
+
+	processedErrorPagePolicies := processErrorPagePolicies(
+		state.ErrorPagePolicies,
+		state.ConfigMaps,
+		state.Services,
+		routes,
+		validators.GenericValidator,
+		gw,
+	)
 
 	g := &Graph{
 		GatewayClass:               gc,
@@ -169,6 +237,11 @@ func BuildGraph(
 		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
 		BackendTLSPolicies:         processedBackendTLSPolicies,
 		NginxProxy:                 npCfg,
+		CompressionPolicies:        processedCompressionPolicies,
+		ErrorPagePolicies:          processedErrorPagePolicies,
+		SnippetsFilters:            processedSnippetsFilters,
+
+		ReferencedErrorPageConfigMaps: buildReferencedErrorPageConfigMaps(state.ErrorPagePolicies),
 	}
 
 	return g
@@ -337,6 +337,30 @@ func TestBuildGraph(t *testing.T) {
 		},
 	}
 
+	compressionPolicy := &ngfAPI.CompressionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "compression-policy",
+		},
+		Spec: ngfAPI.CompressionPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: gatewayv1.GroupName,
+				Kind:  "HTTPRoute",
+				Name:  gatewayv1.ObjectName(hr1.Name),
+			},
+			Compression: ngfAPI.Compression{
+				MIMETypes: []ngfAPI.MIMEType{"application/json"},
+			},
+		},
+	}
+
+	processedCompressionPolicy := &CompressionPolicy{
+		Source:     compressionPolicy,
+		Gateway:    client.ObjectKeyFromObject(gw1),
+		Conditions: []conditions.Condition{staticConds.NewCompressionPolicyAccepted()},
+		Valid:      true,
+	}
+
 	createStateWithGatewayClass := func(gc *gatewayv1.GatewayClass) ClusterState {
 		return ClusterState{
 			GatewayClasses: map[types.NamespacedName]*gatewayv1.GatewayClass{
@@ -376,6 +400,9 @@ func TestBuildGraph(t *testing.T) {
 			NginxProxies: map[types.NamespacedName]*ngfAPI.NginxProxy{
 				client.ObjectKeyFromObject(proxy): proxy,
 			},
+			CompressionPolicies: map[types.NamespacedName]*ngfAPI.CompressionPolicy{
+				client.ObjectKeyFromObject(compressionPolicy): compressionPolicy,
+			},
 		}
 	}
 
@@ -399,6 +426,7 @@ func TestBuildGraph(t *testing.T) {
 			Hostnames: hr1.Spec.Hostnames,
 			Rules:     []RouteRule{createValidRuleWithBackendRefs(routeMatches)},
 		},
+		CompressionPolicy: processedCompressionPolicy,
 	}
 
 	routeGR := &L7Route{
@@ -510,6 +538,9 @@ func TestBuildGraph(t *testing.T) {
 				client.ObjectKeyFromObject(btp.Source): &btp,
 			},
 			NginxProxy: proxy,
+			CompressionPolicies: map[types.NamespacedName]*CompressionPolicy{
+				client.ObjectKeyFromObject(compressionPolicy): processedCompressionPolicy,
+			},
 		}
 	}
 
@@ -565,6 +596,9 @@ func TestBuildGraph(t *testing.T) {
 					GenericValidator:    &validationfakes.FakeGenericValidator{},
 				},
 				protectedPorts,
+				WatchedNamespaces{},
+				false, /* plus */
+				true,  /* snippetsFilters */
 			)
 
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
@@ -680,6 +714,12 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	errorPageConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "error-pages",
+		},
+	}
 
 	gcWithNginxProxy := &GatewayClass{
 		Source: &gatewayv1.GatewayClass{
@@ -724,6 +764,9 @@ func TestIsReferenced(t *testing.T) {
 				CACert: []byte(caBlock),
 			},
 		},
+		ReferencedErrorPageConfigMaps: map[types.NamespacedName]struct{}{
+			client.ObjectKeyFromObject(errorPageConfigMap): {},
+		},
 	}
 
 	tests := []struct {
@@ -827,6 +870,12 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: true,
 		},
+		{
+			name:     "ConfigMap in graph's ReferencedErrorPageConfigMaps is referenced",
+			resource: errorPageConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 		{
 			name:     "ConfigMap not in ReferencedConfigMaps with same Namespace and different Name is not referenced",
 			resource: sameNamespaceDifferentNameConfigMap,
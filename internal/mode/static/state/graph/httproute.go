@@ -12,6 +12,9 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
 
+// HTTPRouteKind is the kind of the HTTPRoute resource.
+const HTTPRouteKind v1.Kind = "HTTPRoute"
+
 func buildHTTPRoute(
 	validator validation.HTTPFieldsValidator,
 	ghr *v1.HTTPRoute,
@@ -106,8 +109,8 @@ func processHTTPRouteRules(
 			var interfaceFilters []interface{}
 			if len(b.Filters) > 0 {
 				interfaceFilters = make([]interface{}, 0, len(b.Filters))
-				for i, v := range b.Filters {
-					interfaceFilters[i] = v
+				for _, v := range b.Filters {
+					interfaceFilters = append(interfaceFilters, v)
 				}
 			}
 			rbr := RouteBackendRef{
@@ -248,6 +251,8 @@ func validateFilter(
 		return validateFilterRewrite(validator, filter, filterPath)
 	case v1.HTTPRouteFilterRequestHeaderModifier:
 		return validateFilterHeaderModifier(validator, filter, filterPath)
+	case v1.HTTPRouteFilterExtensionRef:
+		return validateFilterExtensionRef(filter, filterPath)
 	default:
 		valErr := field.NotSupported(
 			filterPath.Child("type"),
@@ -256,6 +261,7 @@ func validateFilter(
 				string(v1.HTTPRouteFilterRequestRedirect),
 				string(v1.HTTPRouteFilterURLRewrite),
 				string(v1.HTTPRouteFilterRequestHeaderModifier),
+				string(v1.HTTPRouteFilterExtensionRef),
 			},
 		)
 		allErrs = append(allErrs, valErr)
@@ -263,6 +269,16 @@ func validateFilter(
 	}
 }
 
+// validateFilterExtensionRef validates the ExtensionRef filter. The referenced filter resource is resolved
+// after the route is built, see resolveExtensionRefFilters.
+func validateFilterExtensionRef(filter v1.HTTPRouteFilter, filterPath *field.Path) field.ErrorList {
+	if filter.ExtensionRef == nil {
+		return field.ErrorList{field.Required(filterPath.Child("extensionRef"), "extensionRef cannot be nil")}
+	}
+
+	return nil
+}
+
 func validateFilterRedirect(
 	validator validation.HTTPFieldsValidator,
 	filter v1.HTTPRouteFilter,
@@ -12,6 +12,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -865,6 +866,37 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 0,
 			name:           "valid request header modifiers filter",
 		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gatewayv1.LocalObjectReference{
+					Group: ngfAPI.GroupName,
+					Kind:  "SnippetsFilter",
+					Name:  "sf",
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid snippets filter extension ref",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gatewayv1.LocalObjectReference{
+					Group: "example.com",
+					Kind:  "Unknown",
+					Name:  "unknown",
+				},
+			},
+			expectErrCount: 0,
+			name:           "extension ref of unsupported kind is resolved later",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			},
+			expectErrCount: 1,
+			name:           "nil extension ref",
+		},
 		{
 			filter: gatewayv1.HTTPRouteFilter{
 				Type: gatewayv1.HTTPRouteFilterRequestMirror,
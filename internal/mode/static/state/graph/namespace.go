@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"slices"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -46,3 +48,29 @@ func isNamespaceReferenced(ns *v1.Namespace, gw *Gateway) bool {
 
 	return false
 }
+
+// WatchedNamespaces describes the Namespaces in which NGINX Gateway Fabric watches routes, Services, EndpointSlices,
+// Secrets, ConfigMaps and ReferenceGrants. The zero value means all Namespaces are watched.
+type WatchedNamespaces struct {
+	// Names are the names of the watched Namespaces. They must be the same Namespaces that the cache of the resources
+	// is restricted to. If the Namespaces are selected by labels, the selector is evaluated by the caller.
+	Names []string
+}
+
+// isNamespaceWatchedFunc returns true if NGINX Gateway Fabric watches the resources of the Namespace.
+type isNamespaceWatchedFunc func(namespace string) bool
+
+func allNamespacesWatched(string) bool {
+	return true
+}
+
+// newIsNamespaceWatchedFunc creates an isNamespaceWatchedFunc for the watched Namespaces.
+func newIsNamespaceWatchedFunc(watched WatchedNamespaces) isNamespaceWatchedFunc {
+	if len(watched.Names) == 0 {
+		return allNamespacesWatched
+	}
+
+	return func(namespace string) bool {
+		return slices.Contains(watched.Names, namespace)
+	}
+}
@@ -218,3 +218,44 @@ func TestIsNamespaceReferenced(t *testing.T) {
 		})
 	}
 }
+
+func TestNewIsNamespaceWatchedFunc(t *testing.T) {
+	tests := []struct {
+		expected map[string]bool
+		name     string
+		watched  WatchedNamespaces
+	}{
+		{
+			name:    "all namespaces are watched",
+			watched: WatchedNamespaces{},
+			expected: map[string]bool{
+				"ns1":     true,
+				"ns2":     true,
+				"unknown": true,
+			},
+		},
+		{
+			name: "names",
+			watched: WatchedNamespaces{
+				Names: []string{"ns2", "unknown"},
+			},
+			expected: map[string]bool{
+				"ns1":     false,
+				"ns2":     true,
+				"unknown": true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			isNamespaceWatched := newIsNamespaceWatchedFunc(test.watched)
+
+			for namespace, expected := range test.expected {
+				g.Expect(isNamespaceWatched(namespace)).To(Equal(expected), namespace)
+			}
+		})
+	}
+}
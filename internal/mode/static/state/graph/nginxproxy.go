@@ -21,6 +21,20 @@ func getNginxProxy(
 	return nil
 }
 
+// removeUnsupportedBrotli returns a copy of the NginxProxy without the Brotli compression setting if the data plane
+// is not NGINX Plus, because only the NGINX Plus image includes the Brotli module. Otherwise, it returns
+// the NginxProxy as is.
+func removeUnsupportedBrotli(np *ngfAPI.NginxProxy, plus bool) *ngfAPI.NginxProxy {
+	if plus || np == nil || np.Spec.Compression == nil || np.Spec.Compression.Brotli == nil {
+		return np
+	}
+
+	withoutBrotli := np.DeepCopy()
+	withoutBrotli.Spec.Compression.Brotli = nil
+
+	return withoutBrotli
+}
+
 // isNginxProxyReferenced returns whether or not a specific NginxProxy is referenced in the GatewayClass.
 func isNginxProxyReferenced(npNSName types.NamespacedName, gc *GatewayClass) bool {
 	return gc != nil && gcReferencesAnyNginxProxy(gc.Source) && gc.Source.Spec.ParametersRef.Name == npNSName.Name
@@ -84,5 +98,75 @@ func validateNginxProxy(
 		}
 	}
 
+	if npCfg.Spec.Compression != nil {
+		allErrs = append(allErrs, validateCompression(validator, npCfg.Spec.Compression, spec.Child("compression"))...)
+	}
+
+	if npCfg.Spec.DNSResolver != nil {
+		allErrs = append(allErrs, validateDNSResolver(validator, npCfg.Spec.DNSResolver, spec.Child("dnsResolver"))...)
+	}
+
+	if topology := npCfg.Spec.TopologyAwareRouting; topology != nil {
+		switch topology.Mode {
+		case ngfAPI.TopologyModeDisabled, ngfAPI.TopologyModePreferSameZone:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				spec.Child("topologyAwareRouting").Child("mode"),
+				topology.Mode,
+				[]string{string(ngfAPI.TopologyModeDisabled), string(ngfAPI.TopologyModePreferSameZone)},
+			))
+		}
+	}
+
+	if terminating := npCfg.Spec.TerminatingEndpoints; terminating != nil {
+		switch terminating.Policy {
+		case ngfAPI.TerminatingEndpointsPolicyRemove,
+			ngfAPI.TerminatingEndpointsPolicyBackup,
+			ngfAPI.TerminatingEndpointsPolicyDrain:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				spec.Child("terminatingEndpoints").Child("policy"),
+				terminating.Policy,
+				[]string{
+					string(ngfAPI.TerminatingEndpointsPolicyRemove),
+					string(ngfAPI.TerminatingEndpointsPolicyBackup),
+					string(ngfAPI.TerminatingEndpointsPolicyDrain),
+				},
+			))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDNSResolver(
+	validator validation.GenericValidator,
+	resolver *ngfAPI.DNSResolver,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(resolver.Addresses) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("addresses"), "at least one address is required"))
+	}
+
+	for i, addr := range resolver.Addresses {
+		if err := validator.ValidateResolverAddress(string(addr)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("addresses").Index(i), addr, err.Error()))
+		}
+	}
+
+	if resolver.Valid != nil {
+		if err := validator.ValidateNginxDuration(string(*resolver.Valid)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("valid"), *resolver.Valid, err.Error()))
+		}
+	}
+
+	if resolver.Timeout != nil {
+		if err := validator.ValidateNginxDuration(string(*resolver.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeout"), *resolver.Timeout, err.Error()))
+		}
+	}
+
 	return allErrs
 }
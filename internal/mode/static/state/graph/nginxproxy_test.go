@@ -147,6 +147,61 @@ func TestIsNginxProxyReferenced(t *testing.T) {
 	}
 }
 
+func TestRemoveUnsupportedBrotli(t *testing.T) {
+	createNginxProxy := func(brotli *bool) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx-proxy"},
+			Spec: ngfAPI.NginxProxySpec{
+				Compression: &ngfAPI.Compression{
+					Enable: helpers.GetPointer(true),
+					Brotli: brotli,
+				},
+			},
+		}
+	}
+
+	withBrotli := createNginxProxy(helpers.GetPointer(true))
+
+	tests := []struct {
+		np    *ngfAPI.NginxProxy
+		expNp *ngfAPI.NginxProxy
+		name  string
+		plus  bool
+	}{
+		{
+			name: "nil NginxProxy",
+		},
+		{
+			name:  "no compression",
+			np:    &ngfAPI.NginxProxy{},
+			expNp: &ngfAPI.NginxProxy{},
+		},
+		{
+			name:  "Brotli with NGINX Plus",
+			np:    withBrotli,
+			expNp: createNginxProxy(helpers.GetPointer(true)),
+			plus:  true,
+		},
+		{
+			name:  "Brotli with NGINX open source",
+			np:    withBrotli,
+			expNp: createNginxProxy(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(removeUnsupportedBrotli(test.np, test.plus)).To(Equal(test.expNp))
+		})
+	}
+
+	g := NewWithT(t)
+	// the NginxProxy from the cluster state is not modified
+	g.Expect(withBrotli).To(Equal(createNginxProxy(helpers.GetPointer(true))))
+}
+
 func TestGCReferencesAnyNginxProxy(t *testing.T) {
 	tests := []struct {
 		gc     *v1.GatewayClass
@@ -222,6 +277,8 @@ func TestValidateNginxProxy(t *testing.T) {
 		v.ValidateEndpointReturns(nil)
 		v.ValidateServiceNameReturns(nil)
 		v.ValidateNginxDurationReturns(nil)
+		v.ValidateMIMETypeReturns(nil)
+		v.ValidateResolverAddressReturns(nil)
 
 		return v
 	}
@@ -232,6 +289,8 @@ func TestValidateNginxProxy(t *testing.T) {
 		v.ValidateEndpointReturns(errors.New("error"))
 		v.ValidateServiceNameReturns(errors.New("error"))
 		v.ValidateNginxDurationReturns(errors.New("error"))
+		v.ValidateMIMETypeReturns(errors.New("error"))
+		v.ValidateResolverAddressReturns(errors.New("error"))
 
 		return v
 	}
@@ -258,6 +317,23 @@ func TestValidateNginxProxy(t *testing.T) {
 							{Key: "key", Value: "value"},
 						},
 					},
+					Compression: &ngfAPI.Compression{
+						MIMETypes: []ngfAPI.MIMEType{"application/json"},
+						MinLength: helpers.GetPointer[int32](0),
+						Level:     helpers.GetPointer[int32](9),
+					},
+					DNSResolver: &ngfAPI.DNSResolver{
+						Addresses:   []ngfAPI.ResolverAddress{"10.96.0.10", "[fd00::10]:53"},
+						Valid:       helpers.GetPointer[ngfAPI.Duration]("30s"),
+						Timeout:     helpers.GetPointer[ngfAPI.Duration]("5s"),
+						DisableIPv6: helpers.GetPointer(true),
+					},
+					TopologyAwareRouting: &ngfAPI.TopologyAwareRouting{
+						Mode: ngfAPI.TopologyModePreferSameZone,
+					},
+					TerminatingEndpoints: &ngfAPI.TerminatingEndpoints{
+						Policy: ngfAPI.TerminatingEndpointsPolicyDrain,
+					},
 				},
 			},
 			expectErrCount: 0,
@@ -320,6 +396,73 @@ func TestValidateNginxProxy(t *testing.T) {
 			expErrSubstring: "telemetry.spanAttributes",
 			expectErrCount:  2,
 		},
+		{
+			name:      "invalid compression",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Compression: &ngfAPI.Compression{
+						MIMETypes: []ngfAPI.MIMEType{"my-type"}, // any value is invalid by the validator
+						MinLength: helpers.GetPointer[int32](-1),
+						Level:     helpers.GetPointer[int32](10),
+					},
+				},
+			},
+			expErrSubstring: "spec.compression",
+			expectErrCount:  3,
+		},
+		{
+			name:      "invalid dnsResolver",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{
+						Addresses: []ngfAPI.ResolverAddress{"my-resolver"}, // any value is invalid by the validator
+						Valid:     helpers.GetPointer[ngfAPI.Duration]("my-valid"),
+						Timeout:   helpers.GetPointer[ngfAPI.Duration]("my-timeout"),
+					},
+				},
+			},
+			expErrSubstring: "spec.dnsResolver",
+			expectErrCount:  3,
+		},
+		{
+			name:      "invalid topologyAwareRouting mode",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TopologyAwareRouting: &ngfAPI.TopologyAwareRouting{
+						Mode: "PreferSameRegion",
+					},
+				},
+			},
+			expErrSubstring: "spec.topologyAwareRouting.mode",
+			expectErrCount:  1,
+		},
+		{
+			name:      "invalid terminatingEndpoints policy",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TerminatingEndpoints: &ngfAPI.TerminatingEndpoints{
+						Policy: "Keep",
+					},
+				},
+			},
+			expErrSubstring: "spec.terminatingEndpoints.policy",
+			expectErrCount:  1,
+		},
+		{
+			name:      "dnsResolver without addresses",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{},
+				},
+			},
+			expErrSubstring: "spec.dnsResolver.addresses",
+			expectErrCount:  1,
+		},
 	}
 
 	for _, test := range tests {
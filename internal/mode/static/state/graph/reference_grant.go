@@ -4,11 +4,14 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 // referenceGrantResolver resolves references from one resource to another.
 type referenceGrantResolver struct {
-	allowed map[allowedReference]struct{}
+	allowed            map[allowedReference]struct{}
+	isNamespaceWatched isNamespaceWatchedFunc
 }
 
 // allowedReference represents an allowed reference from one resource to another.
@@ -55,6 +58,15 @@ func toService(nsname types.NamespacedName) toResource {
 	}
 }
 
+func toHostnameBackend(nsname types.NamespacedName) toResource {
+	return toResource{
+		group:     ngfAPI.GroupName,
+		kind:      string(HostnameBackendKind),
+		name:      nsname.Name,
+		namespace: nsname.Namespace,
+	}
+}
+
 func fromGateway(namespace string) fromResource {
 	return fromResource{
 		group:     v1.GroupName,
@@ -72,10 +84,18 @@ func fromHTTPRoute(namespace string) fromResource {
 }
 
 // newReferenceGrantResolver creates a new referenceGrantResolver.
-func newReferenceGrantResolver(refGrants map[types.NamespacedName]*v1beta1.ReferenceGrant) *referenceGrantResolver {
+// ReferenceGrants in the Namespaces that are not watched are ignored.
+func newReferenceGrantResolver(
+	refGrants map[types.NamespacedName]*v1beta1.ReferenceGrant,
+	isNamespaceWatched isNamespaceWatchedFunc,
+) *referenceGrantResolver {
 	allowed := make(map[allowedReference]struct{})
 
 	for nsname, grant := range refGrants {
+		if !isNamespaceWatched(nsname.Namespace) {
+			continue
+		}
+
 		for _, to := range grant.Spec.To {
 			for _, from := range grant.Spec.From {
 
@@ -108,7 +128,16 @@ func newReferenceGrantResolver(refGrants map[types.NamespacedName]*v1beta1.Refer
 		}
 	}
 
-	return &referenceGrantResolver{allowed: allowed}
+	return &referenceGrantResolver{
+		allowed:            allowed,
+		isNamespaceWatched: isNamespaceWatched,
+	}
+}
+
+// namespaceWatched returns whether the ReferenceGrants of the Namespace are watched.
+// If not, references to the resources of the Namespace can't be allowed.
+func (r *referenceGrantResolver) namespaceWatched(namespace string) bool {
+	return r.isNamespaceWatched(namespace)
 }
 
 // refAllowed returns whether the reference from the fromResource to the toResource is allowed by a ReferenceGrant.
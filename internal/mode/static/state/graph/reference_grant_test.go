@@ -156,7 +156,7 @@ func TestReferenceGrantResolver(t *testing.T) {
 		},
 	}
 
-	resolver := newReferenceGrantResolver(refGrants)
+	resolver := newReferenceGrantResolver(refGrants, allNamespacesWatched)
 
 	for _, test := range tests {
 		t.Run(test.msg, func(t *testing.T) {
@@ -167,6 +167,40 @@ func TestReferenceGrantResolver(t *testing.T) {
 	}
 }
 
+func TestReferenceGrantResolverNamespaceNotWatched(t *testing.T) {
+	refGrants := map[types.NamespacedName]*v1beta1.ReferenceGrant{
+		{Namespace: "not-watched", Name: "rg"}: {
+			Spec: v1beta1.ReferenceGrantSpec{
+				To: []v1beta1.ReferenceGrantTo{
+					{
+						Kind: "Secret",
+					},
+				},
+				From: []v1beta1.ReferenceGrantFrom{
+					{
+						Group:     v1beta1.GroupName,
+						Kind:      "Gateway",
+						Namespace: "watched",
+					},
+				},
+			},
+		},
+	}
+
+	isNamespaceWatched := func(namespace string) bool {
+		return namespace == "watched"
+	}
+
+	resolver := newReferenceGrantResolver(refGrants, isNamespaceWatched)
+
+	g := NewWithT(t)
+
+	to := toSecret(types.NamespacedName{Namespace: "not-watched", Name: "secret"})
+	g.Expect(resolver.refAllowed(to, fromGateway("watched"))).To(BeFalse())
+	g.Expect(resolver.namespaceWatched("not-watched")).To(BeFalse())
+	g.Expect(resolver.namespaceWatched("watched")).To(BeTrue())
+}
+
 func TestToSecret(t *testing.T) {
 	ref := toSecret(types.NamespacedName{Namespace: "ns", Name: "secret"})
 
@@ -12,6 +12,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
@@ -61,10 +62,23 @@ type RouteKey struct {
 	RouteType      RouteType
 }
 
+// MarshalText returns the RouteKey in the "type/namespace/name" format. It allows maps keyed by RouteKeys to be
+// marshaled to JSON.
+func (k RouteKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%s/%s", k.RouteType, k.NamespacedName)), nil
+}
+
 // L7Route is the generic type for the layer 7 routes, HTTPRoute and GRPCRoute
 type L7Route struct {
 	// Source is the source Gateway API object of the Route.
 	Source client.Object
+	// CompressionPolicy is the valid CompressionPolicy that targets the Route, if any.
+	CompressionPolicy *CompressionPolicy
+	// ErrorPagePolicy is the valid ErrorPagePolicy that targets the Route, if any.
+	ErrorPagePolicy *ErrorPagePolicy
+	// Snippets holds the snippets of the snippet annotations of the Route by NGINX context.
+	// It is only set if the snippets are valid.
+	Snippets map[ngfAPI.NginxContext]string
 	// RouteType is the type (http or grpc) of the Route.
 	RouteType RouteType
 	// Spec is the L7RouteSpec of the Route
@@ -95,6 +109,9 @@ type RouteRule struct {
 	RouteBackendRefs []RouteBackendRef
 	// BackendRefs is an internal representation of a backendRef in a Route.
 	BackendRefs []BackendRef
+	// ExtensionRefFilters are the filter resources referenced by the ExtensionRef filters of the rule, in the order
+	// of the filters. It is only set if the filters are valid.
+	ExtensionRefFilters []ExtensionRefFilter
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
 	ValidMatches bool
 	// ValidFilters indicates if the filters are valid and accepted by the Route.
@@ -158,6 +175,22 @@ func buildRoutesForGateways(
 	return routes
 }
 
+// invalidateRoutesInNotWatchedNamespaces makes the routes from the Namespaces that are not watched invalid,
+// so that they are not considered. Such routes are only present if the resources are not read from the cache that is
+// restricted to the watched Namespaces, for example, when the product telemetry is collected from the Kubernetes API.
+func invalidateRoutesInNotWatchedNamespaces(routes map[RouteKey]*L7Route, isNamespaceWatched isNamespaceWatchedFunc) {
+	for key, r := range routes {
+		ns := key.NamespacedName.Namespace
+		if isNamespaceWatched(ns) {
+			continue
+		}
+
+		r.Valid = false
+		r.Attachable = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteNamespaceNotWatched(ns))
+	}
+}
+
 func buildSectionNameRefs(
 	parentRefs []v1.ParentReference,
 	routeNamespace string,
@@ -530,6 +563,10 @@ func getSectionName(s *v1.SectionName) string {
 }
 
 func validateHostnames(hostnames []v1.Hostname, path *field.Path) error {
+	return validateHostnameList(hostnames, path).ToAggregate()
+}
+
+func validateHostnameList(hostnames []v1.Hostname, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	for i := range hostnames {
@@ -539,7 +576,7 @@ func validateHostnames(hostnames []v1.Hostname, path *field.Path) error {
 		}
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
 
 func validateHeaderMatch(
@@ -133,6 +133,51 @@ func TestBuildSectionNameRefs(t *testing.T) {
 	}
 }
 
+func TestInvalidateRoutesInNotWatchedNamespaces(t *testing.T) {
+	watchedKey := RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "watched", Name: "route"},
+		RouteType:      RouteTypeHTTP,
+	}
+	notWatchedKey := RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "not-watched", Name: "route"},
+		RouteType:      RouteTypeGRPC,
+	}
+
+	routes := map[RouteKey]*L7Route{
+		watchedKey: {
+			Valid:      true,
+			Attachable: true,
+		},
+		notWatchedKey: {
+			Valid:      true,
+			Attachable: true,
+		},
+	}
+
+	isNamespaceWatched := func(namespace string) bool {
+		return namespace == "watched"
+	}
+
+	invalidateRoutesInNotWatchedNamespaces(routes, isNamespaceWatched)
+
+	expected := map[RouteKey]*L7Route{
+		watchedKey: {
+			Valid:      true,
+			Attachable: true,
+		},
+		notWatchedKey: {
+			Valid:      false,
+			Attachable: false,
+			Conditions: []conditions.Condition{
+				staticConds.NewRouteNamespaceNotWatched("not-watched"),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	g.Expect(routes).To(Equal(expected))
+}
+
 func TestFindGatewayForParentRef(t *testing.T) {
 	gwNsName1 := types.NamespacedName{Namespace: "test-1", Name: "gateway-1"}
 	gwNsName2 := types.NamespacedName{Namespace: "test-2", Name: "gateway-2"}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// The annotations of an HTTPRoute with NGINX configuration snippets. They are only processed if SnippetsFilters are
+// enabled.
+const (
+	// MainSnippetAnnotation is the annotation with a snippet for the main context.
+	MainSnippetAnnotation = "gateway.nginx.org/main-snippet"
+	// HTTPSnippetAnnotation is the annotation with a snippet for the http context.
+	HTTPSnippetAnnotation = "gateway.nginx.org/http-snippet"
+	// ServerSnippetAnnotation is the annotation with a snippet for the server contexts of the HTTPRoute hostnames.
+	ServerSnippetAnnotation = "gateway.nginx.org/server-snippet"
+	// LocationSnippetAnnotation is the annotation with a snippet for the location contexts of the HTTPRoute rules.
+	LocationSnippetAnnotation = "gateway.nginx.org/location-snippet"
+)
+
+var snippetAnnotations = []struct {
+	key     string
+	context ngfAPI.NginxContext
+}{
+	{key: MainSnippetAnnotation, context: ngfAPI.NginxContextMain},
+	{key: HTTPSnippetAnnotation, context: ngfAPI.NginxContextHTTP},
+	{key: ServerSnippetAnnotation, context: ngfAPI.NginxContextHTTPServer},
+	{key: LocationSnippetAnnotation, context: ngfAPI.NginxContextHTTPServerLocation},
+}
+
+// processRouteSnippetAnnotations validates the snippet annotations of the valid HTTPRoutes. If all snippets are
+// valid, it saves them in the Route. Otherwise, it invalidates the Route.
+func processRouteSnippetAnnotations(
+	routes map[RouteKey]*L7Route,
+	validator validation.GenericValidator,
+	snippetValidator validation.SnippetValidator,
+) {
+	annotationsPath := field.NewPath("metadata").Child("annotations")
+
+	for _, r := range routes {
+		if r.RouteType != RouteTypeHTTP || !r.Valid {
+			continue
+		}
+
+		annotations := r.Source.GetAnnotations()
+
+		var (
+			allErrs  field.ErrorList
+			snippets map[ngfAPI.NginxContext]string
+		)
+
+		for _, a := range snippetAnnotations {
+			snippet, exists := annotations[a.key]
+			if !exists {
+				continue
+			}
+
+			if err := validateSnippet(validator, snippetValidator, a.context, snippet); err != nil {
+				allErrs = append(allErrs, field.Invalid(annotationsPath.Key(a.key), snippet, err.Error()))
+				continue
+			}
+
+			if snippets == nil {
+				snippets = make(map[ngfAPI.NginxContext]string)
+			}
+			snippets[a.context] = snippet
+		}
+
+		if len(allErrs) > 0 {
+			r.Valid = false
+			r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(allErrs.ToAggregate().Error()))
+
+			continue
+		}
+
+		r.Snippets = snippets
+	}
+}
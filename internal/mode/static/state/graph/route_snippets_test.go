@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessRouteSnippetAnnotations(t *testing.T) {
+	createRoute := func(routeType RouteType, valid bool, annotations map[string]string) *L7Route {
+		return &L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "route",
+					Namespace:   "test",
+					Annotations: annotations,
+				},
+			},
+			RouteType:  routeType,
+			Valid:      valid,
+			Attachable: true,
+		}
+	}
+
+	tests := []struct {
+		route    *L7Route
+		expected *L7Route
+		name     string
+	}{
+		{
+			name:     "no annotations",
+			route:    createRoute(RouteTypeHTTP, true, nil),
+			expected: createRoute(RouteTypeHTTP, true, nil),
+		},
+		{
+			name: "valid annotations",
+			route: createRoute(RouteTypeHTTP, true, map[string]string{
+				MainSnippetAnnotation:     "worker_priority 0;",
+				HTTPSnippetAnnotation:     "log_format one '$remote_addr';",
+				ServerSnippetAnnotation:   "server_tokens off;",
+				LocationSnippetAnnotation: "add_header X-Test test;",
+				"other":                   "value",
+			}),
+			expected: func() *L7Route {
+				r := createRoute(RouteTypeHTTP, true, map[string]string{
+					MainSnippetAnnotation:     "worker_priority 0;",
+					HTTPSnippetAnnotation:     "log_format one '$remote_addr';",
+					ServerSnippetAnnotation:   "server_tokens off;",
+					LocationSnippetAnnotation: "add_header X-Test test;",
+					"other":                   "value",
+				})
+				r.Snippets = map[ngfAPI.NginxContext]string{
+					ngfAPI.NginxContextMain:               "worker_priority 0;",
+					ngfAPI.NginxContextHTTP:               "log_format one '$remote_addr';",
+					ngfAPI.NginxContextHTTPServer:         "server_tokens off;",
+					ngfAPI.NginxContextHTTPServerLocation: "add_header X-Test test;",
+				}
+				return r
+			}(),
+		},
+		{
+			name: "invalid annotations",
+			route: createRoute(RouteTypeHTTP, true, map[string]string{
+				MainSnippetAnnotation:     "worker_priority 0;",
+				ServerSnippetAnnotation:   "invalid",
+				LocationSnippetAnnotation: "listen 80;",
+			}),
+			expected: func() *L7Route {
+				r := createRoute(RouteTypeHTTP, false, map[string]string{
+					MainSnippetAnnotation:     "worker_priority 0;",
+					ServerSnippetAnnotation:   "invalid",
+					LocationSnippetAnnotation: "listen 80;",
+				})
+				r.Conditions = []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`[metadata.annotations[gateway.nginx.org/server-snippet]: Invalid value: "invalid": ` +
+							`invalid snippet, metadata.annotations[gateway.nginx.org/location-snippet]: ` +
+							`Invalid value: "listen 80;": "listen" directive is not allowed here]`,
+					),
+				}
+				return r
+			}(),
+		},
+		{
+			name:     "invalid route",
+			route:    createRoute(RouteTypeHTTP, false, map[string]string{ServerSnippetAnnotation: "invalid"}),
+			expected: createRoute(RouteTypeHTTP, false, map[string]string{ServerSnippetAnnotation: "invalid"}),
+		},
+		{
+			name:     "GRPCRoute",
+			route:    createRoute(RouteTypeGRPC, true, map[string]string{ServerSnippetAnnotation: "invalid"}),
+			expected: createRoute(RouteTypeGRPC, true, map[string]string{ServerSnippetAnnotation: "invalid"}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakeGenericValidator{}
+			validator.ValidateSnippetCalls(func(snippet string) error {
+				if snippet == "invalid" {
+					return errors.New("invalid snippet")
+				}
+				return nil
+			})
+
+			snippetValidator := &validationfakes.FakeSnippetValidator{}
+			snippetValidator.ValidateSnippetCalls(func(context, snippet string) error {
+				if context == string(ngfAPI.NginxContextHTTPServerLocation) && snippet == "listen 80;" {
+					return errors.New(`"listen" directive is not allowed here`)
+				}
+				return nil
+			})
+
+			routes := map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "route"}}: test.route,
+			}
+
+			processRouteSnippetAnnotations(routes, validator, snippetValidator)
+
+			g.Expect(test.route).To(Equal(test.expected))
+		})
+	}
+}
@@ -24,14 +24,19 @@ type secretEntry struct {
 // secretResolver wraps the cluster Secrets so that they can be resolved (includes validation). All resolved
 // Secrets are saved to be used later.
 type secretResolver struct {
-	clusterSecrets  map[types.NamespacedName]*apiv1.Secret
-	resolvedSecrets map[types.NamespacedName]*secretEntry
+	clusterSecrets     map[types.NamespacedName]*apiv1.Secret
+	resolvedSecrets    map[types.NamespacedName]*secretEntry
+	isNamespaceWatched isNamespaceWatchedFunc
 }
 
-func newSecretResolver(secrets map[types.NamespacedName]*apiv1.Secret) *secretResolver {
+func newSecretResolver(
+	secrets map[types.NamespacedName]*apiv1.Secret,
+	isNamespaceWatched isNamespaceWatchedFunc,
+) *secretResolver {
 	return &secretResolver{
-		clusterSecrets:  secrets,
-		resolvedSecrets: make(map[types.NamespacedName]*secretEntry),
+		clusterSecrets:     secrets,
+		resolvedSecrets:    make(map[types.NamespacedName]*secretEntry),
+		isNamespaceWatched: isNamespaceWatched,
 	}
 }
 
@@ -44,7 +49,10 @@ func (r *secretResolver) resolve(nsname types.NamespacedName) error {
 
 	var validationErr error
 
-	if !exist {
+	if !r.isNamespaceWatched(nsname.Namespace) {
+		secret = nil
+		validationErr = fmt.Errorf("namespace %s is not watched by NGINX Gateway Fabric", nsname.Namespace)
+	} else if !exist {
 		validationErr = errors.New("secret does not exist")
 	} else if secret.Type != apiv1.SecretTypeTLS {
 		validationErr = fmt.Errorf("secret type must be %q not %q", apiv1.SecretTypeTLS, secret.Type)
@@ -141,7 +141,9 @@ func TestSecretResolver(t *testing.T) {
 			client.ObjectKeyFromObject(invalidSecretType): invalidSecretType,
 			client.ObjectKeyFromObject(invalidSecretCert): invalidSecretCert,
 			client.ObjectKeyFromObject(invalidSecretKey):  invalidSecretKey,
-		})
+		},
+		allNamespacesWatched,
+	)
 
 	tests := []struct {
 		name           string
@@ -218,3 +220,36 @@ func TestSecretResolver(t *testing.T) {
 	resolved := resolver.getResolvedSecrets()
 	g.Expect(resolved).To(Equal(expectedResolved), "getResolvedSecrets()")
 }
+
+func TestSecretResolverNamespaceNotWatched(t *testing.T) {
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "not-watched",
+			Name:      "secret",
+		},
+		Data: map[string][]byte{
+			apiv1.TLSCertKey:       cert,
+			apiv1.TLSPrivateKeyKey: key,
+		},
+		Type: apiv1.SecretTypeTLS,
+	}
+
+	resolver := newSecretResolver(
+		map[types.NamespacedName]*apiv1.Secret{
+			client.ObjectKeyFromObject(secret): secret,
+		},
+		func(namespace string) bool {
+			return namespace != "not-watched"
+		},
+	)
+
+	g := NewWithT(t)
+
+	err := resolver.resolve(client.ObjectKeyFromObject(secret))
+	g.Expect(err).To(MatchError("namespace not-watched is not watched by NGINX Gateway Fabric"))
+
+	expected := map[types.NamespacedName]*Secret{
+		client.ObjectKeyFromObject(secret): {},
+	}
+	g.Expect(resolver.getResolvedSecrets()).To(Equal(expected))
+}
@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// SnippetsFilterKind is the kind of the SnippetsFilter resource.
+const SnippetsFilterKind v1.Kind = "SnippetsFilter"
+
+// SnippetsFilter represents a SnippetsFilter.
+type SnippetsFilter struct {
+	// Source is the source resource.
+	Source *ngfAPI.SnippetsFilter
+	// Snippets holds the snippets of the SnippetsFilter by NGINX context.
+	// It is only set if the SnippetsFilter is valid.
+	Snippets map[ngfAPI.NginxContext]string
+	// Conditions include Conditions for the SnippetsFilter.
+	Conditions []conditions.Condition
+	// Valid shows whether the SnippetsFilter is valid.
+	Valid bool
+}
+
+// processSnippetsFilters validates the SnippetsFilters.
+func processSnippetsFilters(
+	filters map[types.NamespacedName]*ngfAPI.SnippetsFilter,
+	validator validation.GenericValidator,
+	snippetValidator validation.SnippetValidator,
+) map[types.NamespacedName]*SnippetsFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processedFilters := make(map[types.NamespacedName]*SnippetsFilter, len(filters))
+
+	for nsname, sf := range filters {
+		processedFilter := &SnippetsFilter{
+			Source: sf,
+		}
+		processedFilters[nsname] = processedFilter
+
+		if errs := validateSnippetsFilter(validator, snippetValidator, sf); len(errs) > 0 {
+			processedFilter.Conditions = append(
+				processedFilter.Conditions,
+				staticConds.NewSnippetsFilterInvalid(errs.ToAggregate().Error()),
+			)
+			continue
+		}
+
+		processedFilter.Valid = true
+		processedFilter.Snippets = make(map[ngfAPI.NginxContext]string, len(sf.Spec.Snippets))
+		for _, snippet := range sf.Spec.Snippets {
+			processedFilter.Snippets[snippet.Context] = snippet.Value
+		}
+		processedFilter.Conditions = append(processedFilter.Conditions, staticConds.NewSnippetsFilterAccepted())
+	}
+
+	return processedFilters
+}
+
+// validateSnippetsFilter performs re-validation on the SnippetsFilter in the case of CRD validation failure, and
+// validates the snippets.
+func validateSnippetsFilter(
+	validator validation.GenericValidator,
+	snippetValidator validation.SnippetValidator,
+	sf *ngfAPI.SnippetsFilter,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	snippetsPath := field.NewPath("spec").Child("snippets")
+
+	if len(sf.Spec.Snippets) == 0 {
+		allErrs = append(allErrs, field.Required(snippetsPath, "must specify at least one snippet"))
+	}
+
+	supportedContexts := []string{
+		string(ngfAPI.NginxContextMain),
+		string(ngfAPI.NginxContextHTTP),
+		string(ngfAPI.NginxContextHTTPServer),
+		string(ngfAPI.NginxContextHTTPServerLocation),
+	}
+
+	seenContexts := make(map[ngfAPI.NginxContext]struct{}, len(sf.Spec.Snippets))
+
+	for i, snippet := range sf.Spec.Snippets {
+		snippetPath := snippetsPath.Index(i)
+		contextPath := snippetPath.Child("context")
+
+		switch snippet.Context {
+		case ngfAPI.NginxContextMain,
+			ngfAPI.NginxContextHTTP,
+			ngfAPI.NginxContextHTTPServer,
+			ngfAPI.NginxContextHTTPServerLocation:
+		default:
+			allErrs = append(allErrs, field.NotSupported(contextPath, snippet.Context, supportedContexts))
+		}
+
+		if _, seen := seenContexts[snippet.Context]; seen {
+			allErrs = append(allErrs, field.Duplicate(contextPath, snippet.Context))
+		}
+		seenContexts[snippet.Context] = struct{}{}
+
+		if err := validateSnippet(validator, snippetValidator, snippet.Context, snippet.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(snippetPath.Child("value"), snippet.Value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSnippet checks the syntax of the snippet and, if the snippetValidator is set, validates the snippet
+// with NGINX in the given context.
+func validateSnippet(
+	validator validation.GenericValidator,
+	snippetValidator validation.SnippetValidator,
+	context ngfAPI.NginxContext,
+	snippet string,
+) error {
+	if err := validator.ValidateSnippet(snippet); err != nil {
+		return err
+	}
+
+	if snippetValidator == nil {
+		return nil
+	}
+
+	return snippetValidator.ValidateSnippet(string(context), snippet)
+}
+
+// registerSnippetsFilters registers the resolver of SnippetsFilters.
+func (r *extensionRefFilterRegistry) registerSnippetsFilters(snippetsFilters map[types.NamespacedName]*SnippetsFilter) {
+	r.register(ngfAPI.GroupName, SnippetsFilterKind, func(nsname types.NamespacedName) *ExtensionRefFilter {
+		sf, exists := snippetsFilters[nsname]
+		if !exists {
+			return nil
+		}
+
+		return &ExtensionRefFilter{
+			Filter: sf,
+			NsName: nsname,
+			Kind:   SnippetsFilterKind,
+			Valid:  sf.Valid,
+		}
+	})
+}
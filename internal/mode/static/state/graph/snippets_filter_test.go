@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessSnippetsFilters(t *testing.T) {
+	createFilter := func(name string, snippets ...ngfAPI.Snippet) *ngfAPI.SnippetsFilter {
+		return &ngfAPI.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec: ngfAPI.SnippetsFilterSpec{
+				Snippets: snippets,
+			},
+		}
+	}
+
+	validFilter := createFilter(
+		"valid",
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextMain, Value: "worker_priority 0;"},
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServerLocation, Value: "add_header X-Test test;"},
+	)
+	noSnippets := createFilter("no-snippets")
+	duplicateContext := createFilter(
+		"duplicate-context",
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTP, Value: "log_format one '$remote_addr';"},
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTP, Value: "log_format two '$remote_addr';"},
+	)
+	unknownContext := createFilter(
+		"unknown-context",
+		ngfAPI.Snippet{Context: "stream", Value: "tcp_nodelay on;"},
+	)
+	invalidSnippet := createFilter(
+		"invalid-snippet",
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServer, Value: "invalid"},
+	)
+	rejectedByNginx := createFilter(
+		"rejected-by-nginx",
+		ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServer, Value: "listen 80;"},
+	)
+
+	tests := []struct {
+		filters  map[types.NamespacedName]*ngfAPI.SnippetsFilter
+		expected map[types.NamespacedName]*SnippetsFilter
+		name     string
+	}{
+		{
+			name:     "no filters",
+			filters:  nil,
+			expected: nil,
+		},
+		{
+			name: "valid filter",
+			filters: map[types.NamespacedName]*ngfAPI.SnippetsFilter{
+				{Namespace: "test", Name: "valid"}: validFilter,
+			},
+			expected: map[types.NamespacedName]*SnippetsFilter{
+				{Namespace: "test", Name: "valid"}: {
+					Source: validFilter,
+					Snippets: map[ngfAPI.NginxContext]string{
+						ngfAPI.NginxContextMain:               "worker_priority 0;",
+						ngfAPI.NginxContextHTTPServerLocation: "add_header X-Test test;",
+					},
+					Conditions: []conditions.Condition{staticConds.NewSnippetsFilterAccepted()},
+					Valid:      true,
+				},
+			},
+		},
+		{
+			name: "invalid filters",
+			filters: map[types.NamespacedName]*ngfAPI.SnippetsFilter{
+				{Namespace: "test", Name: "no-snippets"}:       noSnippets,
+				{Namespace: "test", Name: "duplicate-context"}: duplicateContext,
+				{Namespace: "test", Name: "unknown-context"}:   unknownContext,
+				{Namespace: "test", Name: "invalid-snippet"}:   invalidSnippet,
+				{Namespace: "test", Name: "rejected-by-nginx"}: rejectedByNginx,
+			},
+			expected: map[types.NamespacedName]*SnippetsFilter{
+				{Namespace: "test", Name: "no-snippets"}: {
+					Source: noSnippets,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid("spec.snippets: Required value: must specify at least one snippet"),
+					},
+				},
+				{Namespace: "test", Name: "duplicate-context"}: {
+					Source: duplicateContext,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid(`spec.snippets[1].context: Duplicate value: "http"`),
+					},
+				},
+				{Namespace: "test", Name: "unknown-context"}: {
+					Source: unknownContext,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid(
+							`spec.snippets[0].context: Unsupported value: "stream": supported values: ` +
+								`"main", "http", "http.server", "http.server.location"`,
+						),
+					},
+				},
+				{Namespace: "test", Name: "invalid-snippet"}: {
+					Source: invalidSnippet,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid(`spec.snippets[0].value: Invalid value: "invalid": invalid snippet`),
+					},
+				},
+				{Namespace: "test", Name: "rejected-by-nginx"}: {
+					Source: rejectedByNginx,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid(
+							`spec.snippets[0].value: Invalid value: "listen 80;": ` +
+								`[emerg] "listen" directive is duplicate in snippet line 1`,
+						),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakeGenericValidator{}
+			validator.ValidateSnippetCalls(func(snippet string) error {
+				if snippet == "invalid" {
+					return errors.New("invalid snippet")
+				}
+				return nil
+			})
+
+			snippetValidator := &validationfakes.FakeSnippetValidator{}
+			snippetValidator.ValidateSnippetCalls(func(context, snippet string) error {
+				if context == string(ngfAPI.NginxContextHTTPServer) && snippet == "listen 80;" {
+					return errors.New(`[emerg] "listen" directive is duplicate in snippet line 1`)
+				}
+				return nil
+			})
+
+			g.Expect(processSnippetsFilters(test.filters, validator, snippetValidator)).To(Equal(test.expected))
+		})
+	}
+}
@@ -23,10 +23,30 @@ type ServiceResolver interface {
 
 // Endpoint is the internal representation of a Kubernetes endpoint.
 type Endpoint struct {
-	// Address is the IP address of the endpoint.
+	// Address is the IP address of the endpoint, or a hostname if Resolve is true.
 	Address string
+	// Zone is the zone of the endpoint. Empty if unknown.
+	Zone string
+	// ZoneHints are the zones that the endpoint should be consumed from, as set by the EndpointSlice controller
+	// when topology aware hints are enabled for the Service.
+	ZoneHints []string
 	// Port is the port of the endpoint.
 	Port int32
+	// Resolve indicates that the Address is a hostname that NGINX must resolve by DNS.
+	Resolve bool
+	// Backup indicates that the endpoint is only used when the other endpoints are unavailable.
+	Backup bool
+	// Terminating indicates that the endpoint is terminating but still serving.
+	Terminating bool
+	// Drain indicates that the endpoint only serves requests bound to it by session persistence,
+	// so that it can finish in-flight requests before it is removed.
+	Drain bool
+}
+
+// endpointKey uniquely identifies an Endpoint.
+type endpointKey struct {
+	address string
+	port    int32
 }
 
 // ServiceResolverImpl implements ServiceResolver.
@@ -68,20 +88,64 @@ func (e *ServiceResolverImpl) Resolve(
 	return resolveEndpoints(svcNsName, svcPort, endpointSliceList, initEndpointSetWithCalculatedSize)
 }
 
-type initEndpointSetFunc func([]discoveryV1.EndpointSlice) map[Endpoint]struct{}
+// EndpointSliceResolver implements ServiceResolver using a fixed set of EndpointSlices instead of the cluster.
+// It allows building the NGINX configuration offline.
+type EndpointSliceResolver struct {
+	endpointSlices map[types.NamespacedName][]discoveryV1.EndpointSlice
+}
+
+// NewEndpointSliceResolver creates a new instance of an EndpointSliceResolver.
+// EndpointSlices are matched with their Service by the Kubernetes service-name label.
+func NewEndpointSliceResolver(endpointSlices []discoveryV1.EndpointSlice) *EndpointSliceResolver {
+	slicesBySvc := make(map[types.NamespacedName][]discoveryV1.EndpointSlice)
+
+	for _, slice := range endpointSlices {
+		svcName := index.GetServiceNameFromEndpointSlice(&slice)
+		if svcName == "" {
+			continue
+		}
+
+		svcNsName := types.NamespacedName{Namespace: slice.Namespace, Name: svcName}
+		slicesBySvc[svcNsName] = append(slicesBySvc[svcNsName], slice)
+	}
+
+	return &EndpointSliceResolver{endpointSlices: slicesBySvc}
+}
+
+// Resolve resolves a Service's NamespacedName and ServicePort to a list of Endpoints.
+// Returns an error if the Service or ServicePort cannot be resolved.
+func (e *EndpointSliceResolver) Resolve(
+	_ context.Context,
+	svcNsName types.NamespacedName,
+	svcPort v1.ServicePort,
+) ([]Endpoint, error) {
+	slices := e.endpointSlices[svcNsName]
+	if len(slices) == 0 {
+		return nil, fmt.Errorf("no endpoints found for Service %s", svcNsName)
+	}
 
-func initEndpointSetWithCalculatedSize(endpointSlices []discoveryV1.EndpointSlice) map[Endpoint]struct{} {
+	return resolveEndpoints(
+		svcNsName,
+		svcPort,
+		discoveryV1.EndpointSliceList{Items: slices},
+		initEndpointSetWithCalculatedSize,
+	)
+}
+
+type initEndpointSetFunc func([]discoveryV1.EndpointSlice) map[endpointKey]Endpoint
+
+func initEndpointSetWithCalculatedSize(endpointSlices []discoveryV1.EndpointSlice) map[endpointKey]Endpoint {
 	// performance optimization to reduce the cost of growing the map. See the benchamarks for performance comparison.
-	return make(map[Endpoint]struct{}, calculateReadyEndpoints(endpointSlices))
+	return make(map[endpointKey]Endpoint, calculateUsableEndpoints(endpointSlices))
 }
 
-func calculateReadyEndpoints(endpointSlices []discoveryV1.EndpointSlice) int {
+func calculateUsableEndpoints(endpointSlices []discoveryV1.EndpointSlice) int {
 	total := 0
 
 	for _, eps := range endpointSlices {
 		for _, endpoint := range eps.Endpoints {
 
-			if !endpointReady(endpoint) {
+			if !endpointUsable(endpoint) {
 				continue
 			}
 
@@ -111,23 +175,37 @@ func resolveEndpoints(
 	for _, eps := range filteredSlices {
 		for _, endpoint := range eps.Endpoints {
 
-			if !endpointReady(endpoint) {
+			if !endpointUsable(endpoint) {
 				continue
 			}
 
+			terminating := !endpointReady(endpoint)
+
 			// We don't check for a zero port value here because we are only working with EndpointSlices
 			// that have a matching port.
 			endpointPort := findPort(eps.Ports, svcPort)
+			zone, zoneHints := getZoneInfo(endpoint)
 
 			for _, address := range endpoint.Addresses {
-				ep := Endpoint{Address: address, Port: endpointPort}
-				endpointSet[ep] = struct{}{}
+				key := endpointKey{address: address, port: endpointPort}
+				// a ready endpoint takes precedence over a terminating duplicate
+				if existing, exists := endpointSet[key]; exists && (terminating || !existing.Terminating) {
+					continue
+				}
+
+				endpointSet[key] = Endpoint{
+					Address:     address,
+					Port:        endpointPort,
+					Zone:        zone,
+					ZoneHints:   zoneHints,
+					Terminating: terminating,
+				}
 			}
 		}
 	}
 
 	endpoints := make([]Endpoint, 0, len(endpointSet))
-	for ep := range endpointSet {
+	for _, ep := range endpointSet {
 		endpoints = append(endpoints, ep)
 	}
 
@@ -149,6 +227,22 @@ func getDefaultPort(svcPort v1.ServicePort) int32 {
 	return svcPort.Port
 }
 
+// getZoneInfo returns the zone and the zone hints of an endpoint.
+func getZoneInfo(endpoint discoveryV1.Endpoint) (zone string, zoneHints []string) {
+	if endpoint.Zone != nil {
+		zone = *endpoint.Zone
+	}
+
+	if endpoint.Hints != nil && len(endpoint.Hints.ForZones) > 0 {
+		zoneHints = make([]string, 0, len(endpoint.Hints.ForZones))
+		for _, forZone := range endpoint.Hints.ForZones {
+			zoneHints = append(zoneHints, forZone.Name)
+		}
+	}
+
+	return zone, zoneHints
+}
+
 func ignoreEndpointSlice(endpointSlice discoveryV1.EndpointSlice, port v1.ServicePort) bool {
 	if endpointSlice.AddressType != discoveryV1.AddressTypeIPv4 {
 		return true
@@ -163,6 +257,19 @@ func endpointReady(endpoint discoveryV1.Endpoint) bool {
 	return ready != nil && *ready
 }
 
+// endpointServingTerminating returns true if the endpoint is terminating but can still serve requests.
+func endpointServingTerminating(endpoint discoveryV1.Endpoint) bool {
+	serving := endpoint.Conditions.Serving
+	terminating := endpoint.Conditions.Terminating
+
+	return serving != nil && *serving && terminating != nil && *terminating
+}
+
+// endpointUsable returns true if the endpoint is ready or is terminating but still serving.
+func endpointUsable(endpoint discoveryV1.Endpoint) bool {
+	return endpointReady(endpoint) || endpointServingTerminating(endpoint)
+}
+
 func filterEndpointSliceList(
 	endpointSliceList discoveryV1.EndpointSliceList,
 	port v1.ServicePort,
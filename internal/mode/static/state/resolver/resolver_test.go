@@ -1,15 +1,18 @@
 package resolver
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 )
 
@@ -291,6 +294,204 @@ func TestEndpointReady(t *testing.T) {
 	}
 }
 
+func TestEndpointServingTerminating(t *testing.T) {
+	testcases := []struct {
+		endpoint discoveryV1.Endpoint
+		msg      string
+		expected bool
+	}{
+		{
+			msg: "serving and terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Serving:     helpers.GetPointer(true),
+					Terminating: helpers.GetPointer(true),
+				},
+			},
+			expected: true,
+		},
+		{
+			msg: "terminating but not serving",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Serving:     helpers.GetPointer(false),
+					Terminating: helpers.GetPointer(true),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "serving but not terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Ready:       helpers.GetPointer(true),
+					Serving:     helpers.GetPointer(true),
+					Terminating: helpers.GetPointer(false),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg:      "nil conditions",
+			endpoint: discoveryV1.Endpoint{},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(endpointServingTerminating(tc.endpoint)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestResolveEndpointsTerminating(t *testing.T) {
+	g := NewWithT(t)
+
+	port := int32(80)
+	terminatingConditions := discoveryV1.EndpointConditions{
+		Serving:     helpers.GetPointer(true),
+		Terminating: helpers.GetPointer(true),
+	}
+	slices := discoveryV1.EndpointSliceList{
+		Items: []discoveryV1.EndpointSlice{
+			{
+				AddressType: discoveryV1.AddressTypeIPv4,
+				Ports:       []discoveryV1.EndpointPort{{Name: &svcPortName, Port: &port}},
+				Endpoints: []discoveryV1.Endpoint{
+					{
+						Addresses:  []string{"10.0.0.1"},
+						Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+					},
+					{
+						Addresses:  []string{"10.0.0.2", "10.0.0.3"},
+						Conditions: terminatingConditions,
+					},
+				},
+			},
+			{
+				AddressType: discoveryV1.AddressTypeIPv4,
+				Ports:       []discoveryV1.EndpointPort{{Name: &svcPortName, Port: &port}},
+				Endpoints: []discoveryV1.Endpoint{
+					{
+						// duplicate of a ready endpoint
+						Addresses:  []string{"10.0.0.1"},
+						Conditions: terminatingConditions,
+					},
+					{
+						// duplicate of a terminating endpoint; the ready endpoint takes precedence
+						Addresses:  []string{"10.0.0.2"},
+						Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+					},
+				},
+			},
+		},
+	}
+
+	endpoints, err := resolveEndpoints(
+		types.NamespacedName{Namespace: "test", Name: "svc"},
+		v1.ServicePort{Name: svcPortName, Port: 80},
+		slices,
+		initEndpointSetWithCalculatedSize,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(endpoints).To(ConsistOf(
+		Endpoint{Address: "10.0.0.1", Port: 80},
+		Endpoint{Address: "10.0.0.2", Port: 80},
+		Endpoint{Address: "10.0.0.3", Port: 80, Terminating: true},
+	))
+}
+
+func TestGetZoneInfo(t *testing.T) {
+	testcases := []struct {
+		endpoint     discoveryV1.Endpoint
+		msg          string
+		expZone      string
+		expZoneHints []string
+	}{
+		{
+			msg:      "no zone info",
+			endpoint: discoveryV1.Endpoint{},
+		},
+		{
+			msg: "zone without hints",
+			endpoint: discoveryV1.Endpoint{
+				Zone: helpers.GetPointer("zone-a"),
+			},
+			expZone: "zone-a",
+		},
+		{
+			msg: "zone with hints",
+			endpoint: discoveryV1.Endpoint{
+				Zone: helpers.GetPointer("zone-a"),
+				Hints: &discoveryV1.EndpointHints{
+					ForZones: []discoveryV1.ForZone{{Name: "zone-b"}, {Name: "zone-c"}},
+				},
+			},
+			expZone:      "zone-a",
+			expZoneHints: []string{"zone-b", "zone-c"},
+		},
+		{
+			msg: "empty hints",
+			endpoint: discoveryV1.Endpoint{
+				Zone:  helpers.GetPointer("zone-a"),
+				Hints: &discoveryV1.EndpointHints{},
+			},
+			expZone: "zone-a",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewWithT(t)
+
+			zone, zoneHints := getZoneInfo(tc.endpoint)
+			g.Expect(zone).To(Equal(tc.expZone))
+			g.Expect(zoneHints).To(Equal(tc.expZoneHints))
+		})
+	}
+}
+
+func TestResolveEndpointsZoneInfo(t *testing.T) {
+	g := NewWithT(t)
+
+	port := int32(80)
+	slices := discoveryV1.EndpointSliceList{
+		Items: []discoveryV1.EndpointSlice{
+			{
+				AddressType: discoveryV1.AddressTypeIPv4,
+				Ports:       []discoveryV1.EndpointPort{{Name: &svcPortName, Port: &port}},
+				Endpoints: []discoveryV1.Endpoint{
+					{
+						Addresses:  []string{"10.0.0.1"},
+						Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+						Zone:       helpers.GetPointer("zone-a"),
+						Hints: &discoveryV1.EndpointHints{
+							ForZones: []discoveryV1.ForZone{{Name: "zone-a"}},
+						},
+					},
+					{
+						Addresses:  []string{"10.0.0.2"},
+						Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+						Zone:       helpers.GetPointer("zone-b"),
+					},
+				},
+			},
+		},
+	}
+
+	endpoints, err := resolveEndpoints(
+		types.NamespacedName{Namespace: "test", Name: "svc"},
+		v1.ServicePort{Name: svcPortName, Port: 80},
+		slices,
+		initEndpointSetWithCalculatedSize,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(endpoints).To(ConsistOf(
+		Endpoint{Address: "10.0.0.1", Port: 80, Zone: "zone-a", ZoneHints: []string{"zone-a"}},
+		Endpoint{Address: "10.0.0.2", Port: 80, Zone: "zone-b"},
+	))
+}
+
 func TestFindPort(t *testing.T) {
 	testcases := []struct {
 		msg     string
@@ -423,7 +624,7 @@ func TestFindPort(t *testing.T) {
 	}
 }
 
-func TestCalculateReadyEndpoints(t *testing.T) {
+func TestCalculateUsableEndpoints(t *testing.T) {
 	g := NewWithT(t)
 
 	slices := []discoveryV1.EndpointSlice{
@@ -441,6 +642,13 @@ func TestCalculateReadyEndpoints(t *testing.T) {
 						// nil conditions should be treated as not ready
 					},
 				},
+				{
+					Addresses: []string{"1.2.0.1", "1.2.0.2"},
+					Conditions: discoveryV1.EndpointConditions{
+						Serving:     helpers.GetPointer(true),
+						Terminating: helpers.GetPointer(true),
+					},
+				},
 			},
 		},
 		{
@@ -455,9 +663,9 @@ func TestCalculateReadyEndpoints(t *testing.T) {
 		},
 	}
 
-	result := calculateReadyEndpoints(slices)
+	result := calculateUsableEndpoints(slices)
 
-	g.Expect(result).To(Equal(4))
+	g.Expect(result).To(Equal(6))
 }
 
 func generateEndpointSliceList(n int) discoveryV1.EndpointSliceList {
@@ -521,8 +729,8 @@ func BenchmarkResolve(b *testing.B) {
 		Name:      "default-name",
 	}
 
-	initEndpointSet := func([]discoveryV1.EndpointSlice) map[Endpoint]struct{} {
-		return make(map[Endpoint]struct{})
+	initEndpointSet := func([]discoveryV1.EndpointSlice) map[endpointKey]Endpoint {
+		return make(map[endpointKey]Endpoint)
 	}
 
 	for _, count := range counts {
@@ -550,3 +758,58 @@ func bench(b *testing.B, svcNsName types.NamespacedName,
 		}
 	}
 }
+
+func TestEndpointSliceResolver(t *testing.T) {
+	g := NewWithT(t)
+
+	port := int32(80)
+	createSlice := func(namespace, svcName, address string) discoveryV1.EndpointSlice {
+		slice := discoveryV1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      svcName + "-" + address,
+			},
+			AddressType: discoveryV1.AddressTypeIPv4,
+			Ports:       []discoveryV1.EndpointPort{{Name: &svcPortName, Port: &port}},
+			Endpoints: []discoveryV1.Endpoint{
+				{
+					Addresses:  []string{address},
+					Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+				},
+			},
+		}
+
+		if svcName != "" {
+			slice.Labels = map[string]string{index.KubernetesServiceNameLabel: svcName}
+		}
+
+		return slice
+	}
+
+	resolver := NewEndpointSliceResolver([]discoveryV1.EndpointSlice{
+		createSlice("test", "svc", "10.0.0.1"),
+		createSlice("test", "svc", "10.0.0.2"),
+		createSlice("other", "svc", "10.0.0.3"),
+		createSlice("test", "", "10.0.0.4"),
+	})
+
+	svcPort := v1.ServicePort{Name: svcPortName, Port: 80}
+
+	svcNsName := types.NamespacedName{Namespace: "test", Name: "svc"}
+
+	endpoints, err := resolver.Resolve(context.Background(), svcNsName, svcPort)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(endpoints).To(ConsistOf(
+		Endpoint{Address: "10.0.0.1", Port: 80},
+		Endpoint{Address: "10.0.0.2", Port: 80},
+	))
+
+	missingNsName := types.NamespacedName{Namespace: "test", Name: "missing"}
+
+	endpoints, err = resolver.Resolve(context.Background(), missingNsName, svcPort)
+	g.Expect(err).To(MatchError("no endpoints found for Service test/missing"))
+	g.Expect(endpoints).To(BeNil())
+
+	_, err = resolver.Resolve(context.Background(), svcNsName, v1.ServicePort{Name: "other", Port: 8080})
+	g.Expect(err).To(MatchError("no valid endpoints found for Service test/svc and port 8080"))
+}
@@ -47,7 +47,7 @@ func createSlice(
 					"1.0.0.1",
 					"1.0.0.2",
 					"1.0.0.3",
-				}, // these endpoints should be ignored because they are not ready
+				}, // these endpoints should be marked as terminating because they are terminating but still serving
 				Conditions: discoveryV1.EndpointConditions{
 					Serving:     helpers.GetPointer(true),
 					Terminating: helpers.GetPointer(true),
@@ -196,6 +196,36 @@ var _ = Describe("ServiceResolver", func() {
 					Address: "12.0.0.1",
 					Port:    8080,
 				},
+				{
+					Address:     "1.0.0.1",
+					Port:        8080,
+					Terminating: true,
+				},
+				{
+					Address:     "1.0.0.2",
+					Port:        8080,
+					Terminating: true,
+				},
+				{
+					Address:     "1.0.0.3",
+					Port:        8080,
+					Terminating: true,
+				},
+				{
+					Address:     "1.0.0.1",
+					Port:        8081,
+					Terminating: true,
+				},
+				{
+					Address:     "1.0.0.2",
+					Port:        8081,
+					Terminating: true,
+				},
+				{
+					Address:     "1.0.0.3",
+					Port:        8081,
+					Terminating: true,
+				},
 			}
 
 			endpoints, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort)
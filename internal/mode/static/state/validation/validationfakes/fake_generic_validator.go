@@ -30,6 +30,17 @@ type FakeGenericValidator struct {
 	validateEscapedStringNoVarExpansionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateMIMETypeStub        func(string) error
+	validateMIMETypeMutex       sync.RWMutex
+	validateMIMETypeArgsForCall []struct {
+		arg1 string
+	}
+	validateMIMETypeReturns struct {
+		result1 error
+	}
+	validateMIMETypeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateNginxDurationStub        func(string) error
 	validateNginxDurationMutex       sync.RWMutex
 	validateNginxDurationArgsForCall []struct {
@@ -41,6 +52,28 @@ type FakeGenericValidator struct {
 	validateNginxDurationReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateNginxSizeStub        func(string) error
+	validateNginxSizeMutex       sync.RWMutex
+	validateNginxSizeArgsForCall []struct {
+		arg1 string
+	}
+	validateNginxSizeReturns struct {
+		result1 error
+	}
+	validateNginxSizeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateResolverAddressStub        func(string) error
+	validateResolverAddressMutex       sync.RWMutex
+	validateResolverAddressArgsForCall []struct {
+		arg1 string
+	}
+	validateResolverAddressReturns struct {
+		result1 error
+	}
+	validateResolverAddressReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateServiceNameStub        func(string) error
 	validateServiceNameMutex       sync.RWMutex
 	validateServiceNameArgsForCall []struct {
@@ -52,6 +85,17 @@ type FakeGenericValidator struct {
 	validateServiceNameReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateSnippetStub        func(string) error
+	validateSnippetMutex       sync.RWMutex
+	validateSnippetArgsForCall []struct {
+		arg1 string
+	}
+	validateSnippetReturns struct {
+		result1 error
+	}
+	validateSnippetReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -178,6 +222,67 @@ func (fake *FakeGenericValidator) ValidateEscapedStringNoVarExpansionReturnsOnCa
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateMIMEType(arg1 string) error {
+	fake.validateMIMETypeMutex.Lock()
+	ret, specificReturn := fake.validateMIMETypeReturnsOnCall[len(fake.validateMIMETypeArgsForCall)]
+	fake.validateMIMETypeArgsForCall = append(fake.validateMIMETypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateMIMETypeStub
+	fakeReturns := fake.validateMIMETypeReturns
+	fake.recordInvocation("ValidateMIMEType", []interface{}{arg1})
+	fake.validateMIMETypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeCallCount() int {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	return len(fake.validateMIMETypeArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeCalls(stub func(string) error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeArgsForCall(i int) string {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	argsForCall := fake.validateMIMETypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeReturns(result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	fake.validateMIMETypeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeReturnsOnCall(i int, result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	if fake.validateMIMETypeReturnsOnCall == nil {
+		fake.validateMIMETypeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateMIMETypeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateNginxDuration(arg1 string) error {
 	fake.validateNginxDurationMutex.Lock()
 	ret, specificReturn := fake.validateNginxDurationReturnsOnCall[len(fake.validateNginxDurationArgsForCall)]
@@ -239,6 +344,128 @@ func (fake *FakeGenericValidator) ValidateNginxDurationReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateNginxSize(arg1 string) error {
+	fake.validateNginxSizeMutex.Lock()
+	ret, specificReturn := fake.validateNginxSizeReturnsOnCall[len(fake.validateNginxSizeArgsForCall)]
+	fake.validateNginxSizeArgsForCall = append(fake.validateNginxSizeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateNginxSizeStub
+	fakeReturns := fake.validateNginxSizeReturns
+	fake.recordInvocation("ValidateNginxSize", []interface{}{arg1})
+	fake.validateNginxSizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateNginxSizeCallCount() int {
+	fake.validateNginxSizeMutex.RLock()
+	defer fake.validateNginxSizeMutex.RUnlock()
+	return len(fake.validateNginxSizeArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateNginxSizeCalls(stub func(string) error) {
+	fake.validateNginxSizeMutex.Lock()
+	defer fake.validateNginxSizeMutex.Unlock()
+	fake.ValidateNginxSizeStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateNginxSizeArgsForCall(i int) string {
+	fake.validateNginxSizeMutex.RLock()
+	defer fake.validateNginxSizeMutex.RUnlock()
+	argsForCall := fake.validateNginxSizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateNginxSizeReturns(result1 error) {
+	fake.validateNginxSizeMutex.Lock()
+	defer fake.validateNginxSizeMutex.Unlock()
+	fake.ValidateNginxSizeStub = nil
+	fake.validateNginxSizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateNginxSizeReturnsOnCall(i int, result1 error) {
+	fake.validateNginxSizeMutex.Lock()
+	defer fake.validateNginxSizeMutex.Unlock()
+	fake.ValidateNginxSizeStub = nil
+	if fake.validateNginxSizeReturnsOnCall == nil {
+		fake.validateNginxSizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateNginxSizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddress(arg1 string) error {
+	fake.validateResolverAddressMutex.Lock()
+	ret, specificReturn := fake.validateResolverAddressReturnsOnCall[len(fake.validateResolverAddressArgsForCall)]
+	fake.validateResolverAddressArgsForCall = append(fake.validateResolverAddressArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateResolverAddressStub
+	fakeReturns := fake.validateResolverAddressReturns
+	fake.recordInvocation("ValidateResolverAddress", []interface{}{arg1})
+	fake.validateResolverAddressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddressCallCount() int {
+	fake.validateResolverAddressMutex.RLock()
+	defer fake.validateResolverAddressMutex.RUnlock()
+	return len(fake.validateResolverAddressArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddressCalls(stub func(string) error) {
+	fake.validateResolverAddressMutex.Lock()
+	defer fake.validateResolverAddressMutex.Unlock()
+	fake.ValidateResolverAddressStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddressArgsForCall(i int) string {
+	fake.validateResolverAddressMutex.RLock()
+	defer fake.validateResolverAddressMutex.RUnlock()
+	argsForCall := fake.validateResolverAddressArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddressReturns(result1 error) {
+	fake.validateResolverAddressMutex.Lock()
+	defer fake.validateResolverAddressMutex.Unlock()
+	fake.ValidateResolverAddressStub = nil
+	fake.validateResolverAddressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateResolverAddressReturnsOnCall(i int, result1 error) {
+	fake.validateResolverAddressMutex.Lock()
+	defer fake.validateResolverAddressMutex.Unlock()
+	fake.ValidateResolverAddressStub = nil
+	if fake.validateResolverAddressReturnsOnCall == nil {
+		fake.validateResolverAddressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateResolverAddressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateServiceName(arg1 string) error {
 	fake.validateServiceNameMutex.Lock()
 	ret, specificReturn := fake.validateServiceNameReturnsOnCall[len(fake.validateServiceNameArgsForCall)]
@@ -300,6 +527,67 @@ func (fake *FakeGenericValidator) ValidateServiceNameReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateSnippet(arg1 string) error {
+	fake.validateSnippetMutex.Lock()
+	ret, specificReturn := fake.validateSnippetReturnsOnCall[len(fake.validateSnippetArgsForCall)]
+	fake.validateSnippetArgsForCall = append(fake.validateSnippetArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateSnippetStub
+	fakeReturns := fake.validateSnippetReturns
+	fake.recordInvocation("ValidateSnippet", []interface{}{arg1})
+	fake.validateSnippetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateSnippetCallCount() int {
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
+	return len(fake.validateSnippetArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateSnippetCalls(stub func(string) error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateSnippetArgsForCall(i int) string {
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
+	argsForCall := fake.validateSnippetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateSnippetReturns(result1 error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = nil
+	fake.validateSnippetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateSnippetReturnsOnCall(i int, result1 error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = nil
+	if fake.validateSnippetReturnsOnCall == nil {
+		fake.validateSnippetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSnippetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -307,10 +595,18 @@ func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateEndpointMutex.RUnlock()
 	fake.validateEscapedStringNoVarExpansionMutex.RLock()
 	defer fake.validateEscapedStringNoVarExpansionMutex.RUnlock()
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
 	fake.validateNginxDurationMutex.RLock()
 	defer fake.validateNginxDurationMutex.RUnlock()
+	fake.validateNginxSizeMutex.RLock()
+	defer fake.validateNginxSizeMutex.RUnlock()
+	fake.validateResolverAddressMutex.RLock()
+	defer fake.validateResolverAddressMutex.RUnlock()
 	fake.validateServiceNameMutex.RLock()
 	defer fake.validateServiceNameMutex.RUnlock()
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -7,6 +7,8 @@ package validation
 type Validators struct {
 	HTTPFieldsValidator HTTPFieldsValidator
 	GenericValidator    GenericValidator
+	// SnippetValidator is optional. If nil, snippets are only validated by the GenericValidator.
+	SnippetValidator SnippetValidator
 }
 
 // HTTPFieldsValidator validates the HTTP-related fields of Gateway API resources from the perspective of
@@ -37,5 +39,17 @@ type GenericValidator interface {
 	ValidateEscapedStringNoVarExpansion(value string) error
 	ValidateServiceName(name string) error
 	ValidateNginxDuration(duration string) error
+	ValidateNginxSize(size string) error
 	ValidateEndpoint(endpoint string) error
+	ValidateMIMEType(mimeType string) error
+	ValidateSnippet(snippet string) error
+	ValidateResolverAddress(address string) error
+}
+
+// SnippetValidator validates NGINX configuration snippets by loading them into the data-plane, in the NGINX context
+// where they are inserted. The context is one of the NginxContext values of the SnippetsFilter API.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SnippetValidator
+type SnippetValidator interface {
+	ValidateSnippet(context, snippet string) error
 }
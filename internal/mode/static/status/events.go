@@ -0,0 +1,309 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// EventReasonNginxReloadFailed is the reason of the Events that report a failure to reload NGINX.
+	EventReasonNginxReloadFailed = "NginxReloadFailed"
+	// EventReasonPolicyConflicted is the reason of the Events that report a policy that conflicts with another
+	// policy that targets the same Route.
+	EventReasonPolicyConflicted = "PolicyConflicted"
+)
+
+// problem is a problem with a resource that is reported with a Warning Event.
+type problem struct {
+	object  client.Object
+	reason  string
+	message string
+}
+
+// problemKey identifies a problem.
+type problemKey struct {
+	kind    string
+	nsName  types.NamespacedName
+	reason  string
+	message string
+}
+
+func (p problem) key() problemKey {
+	return problemKey{
+		kind:    fmt.Sprintf("%T", p.object),
+		nsName:  client.ObjectKeyFromObject(p.object),
+		reason:  p.reason,
+		message: p.message,
+	}
+}
+
+// EventEmitter emits Warning Events on Gateways and Routes for failed NGINX reloads, invalid Routes and Listeners,
+// unresolved references and conflicting policies, so that they show up in kubectl describe.
+//
+// To avoid Event storms:
+//   - A problem is emitted only when it appears, not for every processed batch of events.
+//   - The Events are rate limited. A problem that is dropped by the rate limiter is emitted on a later call to Emit,
+//     if it still exists.
+//   - The EventRecorder aggregates similar Events of the same resource and filters spam, like any recorder created
+//     by a client-go EventBroadcaster.
+//
+// The EventEmitter only emits Events after it is enabled, so that only the leader emits them.
+type EventEmitter struct {
+	recorder record.EventRecorder
+	limiter  flowcontrol.RateLimiter
+	logger   logr.Logger
+	// emitted holds the emitted problems that still exist.
+	emitted map[problemKey]struct{}
+	// latest holds the problems of the latest call to Emit.
+	latest  []problem
+	lock    sync.Mutex
+	enabled bool
+}
+
+// NewEventEmitter creates a new EventEmitter. The limiter limits the rate of the emitted Events.
+func NewEventEmitter(
+	recorder record.EventRecorder,
+	limiter flowcontrol.RateLimiter,
+	logger logr.Logger,
+) *EventEmitter {
+	return &EventEmitter{
+		recorder: recorder,
+		limiter:  limiter,
+		emitted:  make(map[problemKey]struct{}),
+		logger:   logger,
+	}
+}
+
+// Enable enables the EventEmitter and emits the problems of the latest call to Emit.
+func (e *EventEmitter) Enable(_ context.Context) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.enabled = true
+	e.emit()
+}
+
+// Emit emits Events for the new problems of the resources in the Graph and for a failed NGINX reload.
+func (e *EventEmitter) Emit(g *graph.Graph, nginxReloadRes NginxReloadResult) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.latest = findProblems(g, nginxReloadRes)
+
+	if !e.enabled {
+		return
+	}
+
+	e.emit()
+}
+
+func (e *EventEmitter) emit() {
+	emitted := make(map[problemKey]struct{}, len(e.latest))
+	dropped := 0
+
+	for _, p := range e.latest {
+		key := p.key()
+
+		if _, exists := e.emitted[key]; exists {
+			emitted[key] = struct{}{}
+			continue
+		}
+
+		if !e.limiter.TryAccept() {
+			dropped++
+			continue
+		}
+
+		e.recorder.Event(p.object, apiv1.EventTypeWarning, p.reason, p.message)
+		emitted[key] = struct{}{}
+		e.emitted[key] = struct{}{}
+	}
+
+	if dropped > 0 {
+		e.logger.V(1).Info("Rate limited Events; they will be emitted later if the problems persist", "count", dropped)
+	}
+
+	e.emitted = emitted
+}
+
+// findProblems returns the problems of the resources in the Graph, sorted by resource, reason and message, so that
+// the rate limiter drops the same problems for the same Graph.
+func findProblems(g *graph.Graph, nginxReloadRes NginxReloadResult) []problem {
+	var problems []problem
+
+	if g.Gateway != nil && g.Gateway.Source != nil {
+		problems = append(problems, findGatewayProblems(g.Gateway, nginxReloadRes)...)
+	}
+
+	for _, r := range g.Routes {
+		problems = append(problems, findRouteProblems(r, nginxReloadRes)...)
+	}
+
+	for nsName, pol := range g.CompressionPolicies {
+		target := findPolicyTargetRoute(g.Routes, pol.Source.Namespace, pol.Source.Spec.TargetRef.Name)
+		problems = append(problems, findPolicyConflicts("CompressionPolicy", nsName, target, pol.Conditions)...)
+	}
+
+	for nsName, pol := range g.ErrorPagePolicies {
+		var target client.Object
+		if pol.Source.Spec.TargetRef.Kind == "Gateway" {
+			if g.Gateway != nil && g.Gateway.Source != nil {
+				target = g.Gateway.Source
+			}
+		} else {
+			target = findPolicyTargetRoute(g.Routes, pol.Source.Namespace, pol.Source.Spec.TargetRef.Name)
+		}
+		problems = append(problems, findPolicyConflicts("ErrorPagePolicy", nsName, target, pol.Conditions)...)
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		ki, kj := problems[i].key(), problems[j].key()
+		if ki.kind != kj.kind {
+			return ki.kind < kj.kind
+		}
+		if ki.nsName != kj.nsName {
+			return ki.nsName.String() < kj.nsName.String()
+		}
+		if ki.reason != kj.reason {
+			return ki.reason < kj.reason
+		}
+		return ki.message < kj.message
+	})
+
+	return problems
+}
+
+func findGatewayProblems(gw *graph.Gateway, nginxReloadRes NginxReloadResult) []problem {
+	var problems []problem
+
+	for _, cond := range gw.Conditions {
+		if isProblem(cond) {
+			problems = append(problems, problem{object: gw.Source, reason: cond.Reason, message: cond.Message})
+		}
+	}
+
+	for _, l := range gw.Listeners {
+		for _, cond := range l.Conditions {
+			if isProblem(cond) {
+				problems = append(problems, problem{
+					object:  gw.Source,
+					reason:  cond.Reason,
+					message: fmt.Sprintf("Listener %s: %s", l.Name, cond.Message),
+				})
+			}
+		}
+	}
+
+	// The Gateway is managed by the cluster operator, so its Event includes the error. The Events of the Routes
+	// don't, because the error may include the configuration of other Routes.
+	if nginxReloadRes.Error != nil {
+		problems = append(problems, problem{
+			object:  gw.Source,
+			reason:  EventReasonNginxReloadFailed,
+			message: fmt.Sprintf("Failed to reload NGINX: %v", nginxReloadRes.Error),
+		})
+	}
+
+	return problems
+}
+
+func findRouteProblems(r *graph.L7Route, nginxReloadRes NginxReloadResult) []problem {
+	conds := make([]conditions.Condition, 0, len(r.Conditions)+len(r.ParentRefs))
+	conds = append(conds, r.Conditions...)
+
+	for _, ref := range r.ParentRefs {
+		if ref.Attachment != nil && !ref.Attachment.Attached {
+			conds = append(conds, ref.Attachment.FailedCondition)
+		}
+	}
+
+	var problems []problem
+
+	for _, cond := range conds {
+		if isProblem(cond) {
+			problems = append(problems, problem{object: r.Source, reason: cond.Reason, message: cond.Message})
+		}
+	}
+
+	if nginxReloadRes.Error != nil && r.Valid {
+		problems = append(problems, problem{
+			object:  r.Source,
+			reason:  EventReasonNginxReloadFailed,
+			message: routeNginxReloadFailedMessage(r, nginxReloadRes),
+		})
+	}
+
+	return problems
+}
+
+// findPolicyConflicts returns a problem for the target of the policy, if the policy conflicts with another policy
+// that targets the same resource.
+func findPolicyConflicts(
+	kind string,
+	policy types.NamespacedName,
+	target client.Object,
+	conds []conditions.Condition,
+) []problem {
+	if target == nil {
+		return nil
+	}
+
+	for _, cond := range conds {
+		if cond.Reason != string(v1alpha2.PolicyReasonConflicted) {
+			continue
+		}
+
+		return []problem{
+			{
+				object:  target,
+				reason:  EventReasonPolicyConflicted,
+				message: fmt.Sprintf("%s %s is ignored: %s", kind, policy, cond.Message),
+			},
+		}
+	}
+
+	return nil
+}
+
+// findPolicyTargetRoute returns the HTTPRoute targeted by a policy or nil if it isn't in the Graph.
+func findPolicyTargetRoute(
+	routes map[graph.RouteKey]*graph.L7Route,
+	namespace string,
+	name v1.ObjectName,
+) client.Object {
+	route, exists := routes[graph.RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: string(name)},
+		RouteType:      graph.RouteTypeHTTP,
+	}]
+	if !exists {
+		return nil
+	}
+
+	return route.Source
+}
+
+// isProblem returns true if the Condition reports a problem. Most Conditions report a problem if their status is
+// False, but the Conflicted and PartiallyInvalid Conditions report a problem if their status is True.
+func isProblem(cond conditions.Condition) bool {
+	switch cond.Type {
+	case string(v1.ListenerConditionConflicted), string(v1.RouteConditionPartiallyInvalid):
+		return cond.Status == metav1.ConditionTrue
+	default:
+		return cond.Status == metav1.ConditionFalse
+	}
+}
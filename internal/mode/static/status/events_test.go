@@ -0,0 +1,228 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func createEventsGraph(routeConds []conditions.Condition, policyConds []conditions.Condition) *graph.Graph {
+	gw := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+	}
+	hr := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+	}
+
+	routeKey := graph.CreateRouteKey(hr)
+	policyNsName := types.NamespacedName{Namespace: "test", Name: "cp"}
+
+	return &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: gw,
+			Listeners: []*graph.Listener{
+				{
+					Name: "listener-80",
+					Conditions: []conditions.Condition{
+						{
+							Type:    string(v1.ListenerConditionAccepted),
+							Status:  metav1.ConditionFalse,
+							Reason:  string(staticConds.ListenerReasonUnsupportedValue),
+							Message: "unsupported protocol",
+						},
+					},
+				},
+			},
+			Valid: true,
+		},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			routeKey: {
+				Source:     hr,
+				RouteType:  graph.RouteTypeHTTP,
+				Conditions: routeConds,
+				Valid:      true,
+			},
+		},
+		CompressionPolicies: map[types.NamespacedName]*graph.CompressionPolicy{
+			policyNsName: {
+				Source: &ngfAPI.CompressionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cp"},
+					Spec: ngfAPI.CompressionPolicySpec{
+						TargetRef: v1alpha2.PolicyTargetReference{
+							Group: v1.GroupName,
+							Kind:  "HTTPRoute",
+							Name:  "hr",
+						},
+					},
+				},
+				Conditions: policyConds,
+			},
+		},
+	}
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEventEmitter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(100)
+	emitter := NewEventEmitter(recorder, flowcontrol.NewFakeAlwaysRateLimiter(), zap.New())
+
+	listenerEvent := "Warning UnsupportedValue Listener listener-80: unsupported protocol"
+	routeEvent := "Warning UnsupportedValue invalid filter"
+	conflictEvent := "Warning PolicyConflicted CompressionPolicy test/cp is ignored: conflicts with test/cp2"
+
+	routeConds := []conditions.Condition{
+		{
+			Type:    string(v1.RouteConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.RouteReasonUnsupportedValue),
+			Message: "invalid filter",
+		},
+		{
+			Type:    string(v1.RouteConditionResolvedRefs),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(v1.RouteReasonResolvedRefs),
+			Message: "All references are resolved",
+		},
+	}
+	policyConds := []conditions.Condition{
+		{
+			Type:    string(v1alpha2.PolicyConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1alpha2.PolicyReasonConflicted),
+			Message: "conflicts with test/cp2",
+		},
+	}
+
+	// not enabled yet
+	emitter.Emit(createEventsGraph(routeConds, policyConds), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(BeEmpty())
+
+	// enabled
+	emitter.Enable(context.Background())
+	g.Expect(drainEvents(recorder)).To(ConsistOf(listenerEvent, routeEvent, conflictEvent))
+
+	// the same problems are not emitted again
+	emitter.Emit(createEventsGraph(routeConds, policyConds), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(BeEmpty())
+
+	// a failed reload is emitted on the Gateway and the valid Route
+	emitter.Emit(createEventsGraph(routeConds, policyConds), NginxReloadResult{Error: errors.New("test error")})
+	g.Expect(drainEvents(recorder)).To(ConsistOf(
+		"Warning NginxReloadFailed Failed to reload NGINX: test error",
+		"Warning NginxReloadFailed "+staticConds.RouteMessageFailedNginxReload,
+	))
+
+	// the problems of the Route and the policy are gone
+	emitter.Emit(createEventsGraph(nil, nil), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(BeEmpty())
+
+	// the problems of the Route and the policy are back, so they are emitted again
+	emitter.Emit(createEventsGraph(routeConds, policyConds), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(ConsistOf(routeEvent, conflictEvent))
+}
+
+func TestEventEmitterRateLimited(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(100)
+	emitter := NewEventEmitter(recorder, flowcontrol.NewFakeNeverRateLimiter(), zap.New())
+	emitter.Enable(context.Background())
+
+	routeConds := []conditions.Condition{
+		{
+			Type:    string(v1.RouteConditionResolvedRefs),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.RouteReasonBackendNotFound),
+			Message: "backend not found",
+		},
+	}
+
+	emitter.Emit(createEventsGraph(routeConds, nil), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(BeEmpty())
+
+	// the dropped problems are emitted once the rate limiter accepts them
+	emitter.limiter = flowcontrol.NewFakeAlwaysRateLimiter()
+
+	emitter.Emit(createEventsGraph(routeConds, nil), NginxReloadResult{})
+	g.Expect(drainEvents(recorder)).To(ConsistOf(
+		"Warning UnsupportedValue Listener listener-80: unsupported protocol",
+		"Warning BackendNotFound backend not found",
+	))
+}
+
+func TestIsProblem(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		cond     conditions.Condition
+		expected bool
+	}{
+		{
+			name:     "accepted true",
+			cond:     conditions.Condition{Type: string(v1.RouteConditionAccepted), Status: metav1.ConditionTrue},
+			expected: false,
+		},
+		{
+			name:     "accepted false",
+			cond:     conditions.Condition{Type: string(v1.RouteConditionAccepted), Status: metav1.ConditionFalse},
+			expected: true,
+		},
+		{
+			name:     "conflicted true",
+			cond:     conditions.Condition{Type: string(v1.ListenerConditionConflicted), Status: metav1.ConditionTrue},
+			expected: true,
+		},
+		{
+			name:     "conflicted false",
+			cond:     conditions.Condition{Type: string(v1.ListenerConditionConflicted), Status: metav1.ConditionFalse},
+			expected: false,
+		},
+		{
+			name: "partially invalid true",
+			cond: conditions.Condition{
+				Type:   string(v1.RouteConditionPartiallyInvalid),
+				Status: metav1.ConditionTrue,
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(isProblem(test.cond)).To(Equal(test.expected))
+		})
+	}
+}
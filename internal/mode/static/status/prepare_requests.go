@@ -21,16 +21,35 @@ import (
 type NginxReloadResult struct {
 	// Error is the error that occurred during the reload.
 	Error error
+	// InvalidSnippet is the snippet that NGINX reported as invalid, if the reload failed because of a snippet.
+	InvalidSnippet *InvalidSnippet
+}
+
+// InvalidSnippet describes a snippet that NGINX reported as invalid.
+type InvalidSnippet struct {
+	// Error is the error that NGINX reported.
+	Error error
+	// OwnerKind is the kind of the owner of the snippet: SnippetsFilter or HTTPRoute.
+	OwnerKind v1.Kind
+	// Owner is the namespaced name of the owner of the snippet.
+	Owner types.NamespacedName
 }
 
 // PrepareRouteRequests prepares status UpdateRequests for the given Routes.
+// detachedRoutes are the Routes that were previously attached to the Gateway but no longer are. Their requests
+// remove the RouteParentStatuses written by the Gateway controller, keeping the ones of other controllers.
 func PrepareRouteRequests(
 	routes map[graph.RouteKey]*graph.L7Route,
+	detachedRoutes []graph.RouteKey,
 	transitionTime metav1.Time,
 	nginxReloadRes NginxReloadResult,
 	gatewayCtlrName string,
 ) []frameworkStatus.UpdateRequest {
-	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routes))
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routes)+len(detachedRoutes))
+
+	for _, routeKey := range detachedRoutes {
+		reqs = append(reqs, prepareDetachedRouteRequest(routeKey, gatewayCtlrName))
+	}
 
 	for routeKey, r := range routes {
 
@@ -39,6 +58,7 @@ func PrepareRouteRequests(
 			r.ParentRefs,
 			r.Conditions,
 			nginxReloadRes,
+			routeNginxReloadFailedMessage(r, nginxReloadRes),
 			transitionTime,
 			r.Source.GetGeneration(),
 		)
@@ -76,11 +96,36 @@ func PrepareRouteRequests(
 	return reqs
 }
 
+// prepareDetachedRouteRequest prepares a status UpdateRequest that removes the RouteParentStatuses written by
+// the Gateway controller from a Route that is no longer attached to the Gateway.
+func prepareDetachedRouteRequest(routeKey graph.RouteKey, gatewayCtlrName string) frameworkStatus.UpdateRequest {
+	// Parents is a required field, so it must not be nil.
+	routeStatus := v1.RouteStatus{Parents: []v1.RouteParentStatus{}}
+
+	switch routeKey.RouteType {
+	case graph.RouteTypeHTTP:
+		return frameworkStatus.UpdateRequest{
+			NsName:       routeKey.NamespacedName,
+			ResourceType: &v1.HTTPRoute{},
+			Setter:       newHTTPRouteStatusSetter(v1.HTTPRouteStatus{RouteStatus: routeStatus}, gatewayCtlrName),
+		}
+	case graph.RouteTypeGRPC:
+		return frameworkStatus.UpdateRequest{
+			NsName:       routeKey.NamespacedName,
+			ResourceType: &v1alpha2.GRPCRoute{},
+			Setter:       newGRPCRouteStatusSetter(v1alpha2.GRPCRouteStatus{RouteStatus: routeStatus}, gatewayCtlrName),
+		}
+	default:
+		panic(fmt.Sprintf("Unknown route type: %s", routeKey.RouteType))
+	}
+}
+
 func prepareRouteStatus(
 	gatewayCtlrName string,
 	parentRefs []graph.ParentRef,
 	conds []conditions.Condition,
 	nginxReloadRes NginxReloadResult,
+	nginxReloadFailedMsg string,
 	transitionTime metav1.Time,
 	srcGeneration int64,
 ) v1.RouteStatus {
@@ -106,7 +151,7 @@ func prepareRouteStatus(
 		if nginxReloadRes.Error != nil {
 			allConds = append(
 				allConds,
-				staticConds.NewRouteGatewayNotProgrammed(staticConds.RouteMessageFailedNginxReload),
+				staticConds.NewRouteGatewayNotProgrammed(nginxReloadFailedMsg),
 			)
 		}
 
@@ -129,6 +174,50 @@ func prepareRouteStatus(
 	return v1.RouteStatus{Parents: parents}
 }
 
+// routeNginxReloadFailedMessage returns the message of the condition that reports a failed NGINX reload for
+// the Route. If NGINX reported that a snippet of the Route, or of a SnippetsFilter that the Route uses, is invalid,
+// the message names the owner of the snippet and includes the error of NGINX.
+func routeNginxReloadFailedMessage(route *graph.L7Route, nginxReloadRes NginxReloadResult) string {
+	invalidSnippet := nginxReloadRes.InvalidSnippet
+	if invalidSnippet == nil {
+		return staticConds.RouteMessageFailedNginxReload
+	}
+
+	switch invalidSnippet.OwnerKind {
+	case graph.HTTPRouteKind:
+		if route.RouteType == graph.RouteTypeHTTP && invalidSnippet.Owner == client.ObjectKeyFromObject(route.Source) {
+			return fmt.Sprintf(
+				"%s: the snippet annotations of the Route are invalid: %s",
+				staticConds.RouteMessageFailedNginxReload,
+				invalidSnippet.Error,
+			)
+		}
+	case graph.SnippetsFilterKind:
+		if routeUsesSnippetsFilter(route, invalidSnippet.Owner) {
+			return fmt.Sprintf(
+				"%s: the SnippetsFilter %s is invalid: %s",
+				staticConds.RouteMessageFailedNginxReload,
+				invalidSnippet.Owner,
+				invalidSnippet.Error,
+			)
+		}
+	}
+
+	return staticConds.RouteMessageFailedNginxReload
+}
+
+func routeUsesSnippetsFilter(route *graph.L7Route, nsname types.NamespacedName) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, filter := range rule.ExtensionRefFilters {
+			if filter.Kind == graph.SnippetsFilterKind && filter.NsName == nsname {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // PrepareGatewayClassRequests prepares status UpdateRequests for the given GatewayClasses.
 func PrepareGatewayClassRequests(
 	gc *graph.GatewayClass,
@@ -182,17 +271,20 @@ func PrepareGatewayClassRequests(
 }
 
 // PrepareGatewayRequests prepares status UpdateRequests for the given Gateways.
+// addressConds are the conditions that report the problems with the addresses requested in spec.addresses of
+// the winning Gateway.
 func PrepareGatewayRequests(
 	gateway *graph.Gateway,
 	ignoredGateways map[types.NamespacedName]*v1.Gateway,
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
+	addressConds []conditions.Condition,
 	nginxReloadRes NginxReloadResult,
 ) []frameworkStatus.UpdateRequest {
 	reqs := make([]frameworkStatus.UpdateRequest, 0, 1+len(ignoredGateways))
 
 	if gateway != nil {
-		reqs = append(reqs, prepareGatewayRequest(gateway, transitionTime, gwAddresses, nginxReloadRes))
+		reqs = append(reqs, prepareGatewayRequest(gateway, transitionTime, gwAddresses, addressConds, nginxReloadRes))
 	}
 
 	for nsname, gw := range ignoredGateways {
@@ -213,6 +305,7 @@ func prepareGatewayRequest(
 	gateway *graph.Gateway,
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
+	addressConds []conditions.Condition,
 	nginxReloadRes NginxReloadResult,
 ) frameworkStatus.UpdateRequest {
 	if !gateway.Valid {
@@ -272,6 +365,8 @@ func prepareGatewayRequest(
 		gwConds = append(gwConds, staticConds.NewGatewayAcceptedListenersNotValid())
 	}
 
+	gwConds = append(gwConds, addressConds...)
+
 	if nginxReloadRes.Error != nil {
 		gwConds = append(
 			gwConds,
@@ -334,6 +429,105 @@ func PrepareBackendTLSPolicyRequests(
 	return reqs
 }
 
+// PrepareCompressionPolicyRequests prepares status UpdateRequests for the given CompressionPolicies.
+func PrepareCompressionPolicyRequests(
+	policies map[types.NamespacedName]*graph.CompressionPolicy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
+
+	for nsname, pol := range policies {
+		conds := conditions.DeduplicateConditions(pol.Conditions)
+		apiConds := conditions.ConvertConditions(conds, pol.Source.Generation, transitionTime)
+
+		status := v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: (*v1.Namespace)(&pol.Gateway.Namespace),
+						Name:      v1alpha2.ObjectName(pol.Gateway.Name),
+					},
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+					Conditions:     apiConds,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.CompressionPolicy{},
+			Setter:       newCompressionPolicyStatusSetter(status, gatewayCtlrName),
+		})
+	}
+	return reqs
+}
+
+// PrepareErrorPagePolicyRequests prepares status UpdateRequests for the given ErrorPagePolicies.
+func PrepareErrorPagePolicyRequests(
+	policies map[types.NamespacedName]*graph.ErrorPagePolicy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
+
+	for nsname, pol := range policies {
+		conds := conditions.DeduplicateConditions(pol.Conditions)
+		apiConds := conditions.ConvertConditions(conds, pol.Source.Generation, transitionTime)
+
+		status := v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: (*v1.Namespace)(&pol.Gateway.Namespace),
+						Name:      v1alpha2.ObjectName(pol.Gateway.Name),
+					},
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+					Conditions:     apiConds,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.ErrorPagePolicy{},
+			Setter:       newErrorPagePolicyStatusSetter(status, gatewayCtlrName),
+		})
+	}
+	return reqs
+}
+
+// PrepareSnippetsFilterRequests prepares status UpdateRequests for the given SnippetsFilters.
+func PrepareSnippetsFilterRequests(
+	snippetsFilters map[types.NamespacedName]*graph.SnippetsFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(snippetsFilters))
+
+	for nsname, sf := range snippetsFilters {
+		conds := conditions.DeduplicateConditions(sf.Conditions)
+		apiConds := conditions.ConvertConditions(conds, sf.Source.Generation, transitionTime)
+
+		status := ngfAPI.SnippetsFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					ControllerName: v1.GatewayController(gatewayCtlrName),
+					Conditions:     apiConds,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.SnippetsFilter{},
+			Setter:       newSnippetsFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
 // ControlPlaneUpdateResult describes the result of a control plane update.
 type ControlPlaneUpdateResult struct {
 	// Error is the error that occurred during the update.
@@ -367,3 +561,16 @@ func PrepareNginxGatewayStatus(
 		}),
 	}
 }
+
+// PrepareNginxGatewayConfigHashStatus prepares a status UpdateRequest that sets the hash of the NGINX configuration
+// that was last applied to NGINX in the status of the NginxGateway.
+func PrepareNginxGatewayConfigHashStatus(
+	nsName types.NamespacedName,
+	hash string,
+) frameworkStatus.UpdateRequest {
+	return frameworkStatus.UpdateRequest{
+		NsName:       nsName,
+		ResourceType: &ngfAPI.NginxGateway{},
+		Setter:       newNginxGatewayConfigHashSetter(hash),
+	}
+}
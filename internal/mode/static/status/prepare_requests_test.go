@@ -263,7 +263,7 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 
 	updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
-	reqs := PrepareRouteRequests(routes, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+	reqs := PrepareRouteRequests(routes, nil, transitionTime, NginxReloadResult{}, gatewayCtlrName)
 
 	updater.Update(context.Background(), reqs...)
 
@@ -335,7 +335,7 @@ func TestBuildGRPCRouteStatuses(t *testing.T) {
 
 	updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
-	reqs := PrepareRouteRequests(routes, transitionTime, NginxReloadResult{}, gatewayCtlrName)
+	reqs := PrepareRouteRequests(routes, nil, transitionTime, NginxReloadResult{}, gatewayCtlrName)
 
 	updater.Update(context.Background(), reqs...)
 
@@ -434,6 +434,7 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 
 	reqs := PrepareRouteRequests(
 		routes,
+		nil,
 		transitionTime,
 		NginxReloadResult{Error: errors.New("test error")},
 		gatewayCtlrName,
@@ -450,6 +451,171 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	g.Expect(helpers.Diff(expectedStatus, hr.Status)).To(BeEmpty())
 }
 
+func TestBuildDetachedRouteStatuses(t *testing.T) {
+	otherCtlrParent := v1.RouteParentStatus{
+		ParentRef: v1.ParentReference{
+			Namespace: helpers.GetPointer[v1.Namespace]("test"),
+			Name:      "other-gateway",
+		},
+		ControllerName: "other-controller",
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(v1.RouteConditionAccepted),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 3,
+				LastTransitionTime: transitionTime,
+				Reason:             string(v1.RouteReasonAccepted),
+				Message:            "The route is accepted",
+			},
+		},
+	}
+
+	hrDetached := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       "hr-detached",
+			Generation: 3,
+		},
+	}
+	hrDeleted := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "hr-deleted",
+		},
+	}
+	grDetached := &v1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gr-detached",
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1.HTTPRoute{})
+
+	g.Expect(k8sClient.Create(context.Background(), hrDetached)).To(Succeed())
+
+	hrDetached.Status = v1.HTTPRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: append([]v1.RouteParentStatus{otherCtlrParent}, routeStatusValid.Parents...),
+		},
+	}
+	g.Expect(k8sClient.Status().Update(context.Background(), hrDetached)).To(Succeed())
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareRouteRequests(
+		nil,
+		[]graph.RouteKey{
+			graph.CreateRouteKey(hrDetached),
+			graph.CreateRouteKey(hrDeleted),
+			graph.CreateRouteKey(grDetached),
+		},
+		transitionTime,
+		NginxReloadResult{},
+		gatewayCtlrName,
+	)
+
+	g.Expect(reqs).To(HaveLen(3))
+	g.Expect(reqs[2].ResourceType).To(BeAssignableToTypeOf(&v1alpha2.GRPCRoute{}))
+
+	updater.Update(context.Background(), reqs[:2]...)
+
+	expectedStatus := v1.HTTPRouteStatus{
+		RouteStatus: v1.RouteStatus{
+			Parents: []v1.RouteParentStatus{otherCtlrParent},
+		},
+	}
+
+	var hr v1.HTTPRoute
+
+	err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(hrDetached), &hr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(helpers.Diff(expectedStatus, hr.Status)).To(BeEmpty())
+
+	// the status has no parents of the Gateway controller anymore, so it is not updated again
+	g.Expect(reqs[0].Setter(&hr)).To(BeFalse())
+}
+
+func TestRouteNginxReloadFailedMessage(t *testing.T) {
+	createSnippetsFilter := func(name string) graph.ExtensionRefFilter {
+		return graph.ExtensionRefFilter{
+			Filter: &graph.SnippetsFilter{
+				Source: &ngfAPI.SnippetsFilter{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
+				Valid:  true,
+			},
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			Kind:   graph.SnippetsFilterKind,
+			Valid:  true,
+		}
+	}
+
+	route := &graph.L7Route{
+		Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route"}},
+		RouteType: graph.RouteTypeHTTP,
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{ExtensionRefFilters: []graph.ExtensionRefFilter{createSnippetsFilter("sf1")}},
+				{ExtensionRefFilters: []graph.ExtensionRefFilter{createSnippetsFilter("sf2")}},
+			},
+		},
+	}
+
+	createReloadResult := func(kind v1.Kind, name string) NginxReloadResult {
+		return NginxReloadResult{
+			Error: errors.New("reload failed"),
+			InvalidSnippet: &InvalidSnippet{
+				Error:     errors.New(`[emerg] unknown directive "invalid"`),
+				OwnerKind: kind,
+				Owner:     types.NamespacedName{Namespace: "test", Name: name},
+			},
+		}
+	}
+
+	tests := []struct {
+		nginxReloadRes NginxReloadResult
+		name           string
+		expected       string
+	}{
+		{
+			name:           "no invalid snippet",
+			nginxReloadRes: NginxReloadResult{Error: errors.New("reload failed")},
+			expected:       staticConds.RouteMessageFailedNginxReload,
+		},
+		{
+			name:           "invalid snippet of a SnippetsFilter used by the route",
+			nginxReloadRes: createReloadResult(graph.SnippetsFilterKind, "sf2"),
+			expected: staticConds.RouteMessageFailedNginxReload + `: the SnippetsFilter test/sf2 is invalid: ` +
+				`[emerg] unknown directive "invalid"`,
+		},
+		{
+			name:           "invalid snippet of a SnippetsFilter not used by the route",
+			nginxReloadRes: createReloadResult(graph.SnippetsFilterKind, "sf3"),
+			expected:       staticConds.RouteMessageFailedNginxReload,
+		},
+		{
+			name:           "invalid snippet annotations of the route",
+			nginxReloadRes: createReloadResult(graph.HTTPRouteKind, "route"),
+			expected: staticConds.RouteMessageFailedNginxReload + `: the snippet annotations of the Route are ` +
+				`invalid: [emerg] unknown directive "invalid"`,
+		},
+		{
+			name:           "invalid snippet annotations of another route",
+			nginxReloadRes: createReloadResult(graph.HTTPRouteKind, "other"),
+			expected:       staticConds.RouteMessageFailedNginxReload,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(routeNginxReloadFailedMessage(route, test.nginxReloadRes)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestBuildGatewayClassStatuses(t *testing.T) {
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
 
@@ -643,6 +809,7 @@ func TestBuildGatewayStatuses(t *testing.T) {
 		ignoredGateways map[types.NamespacedName]*v1.Gateway
 		expected        map[types.NamespacedName]v1.GatewayStatus
 		name            string
+		addressConds    []conditions.Condition
 	}{
 		{
 			name:     "nil gateway and no ignored gateways",
@@ -763,6 +930,53 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid gateway; requested addresses are not assigned",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Valid: true,
+			},
+			addressConds: []conditions.Condition{
+				staticConds.NewGatewayAddressNotAssigned("addresses are not assigned"),
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAddressNotAssigned),
+							Message:            "addresses are not assigned",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "valid gateway; some valid listeners",
 			gateway: &graph.Gateway{
@@ -1057,7 +1271,14 @@ func TestBuildGatewayStatuses(t *testing.T) {
 
 			updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
-			reqs := PrepareGatewayRequests(test.gateway, test.ignoredGateways, transitionTime, addr, test.nginxReloadRes)
+			reqs := PrepareGatewayRequests(
+				test.gateway,
+				test.ignoredGateways,
+				transitionTime,
+				addr,
+				test.addressConds,
+				test.nginxReloadRes,
+			)
 
 			g.Expect(reqs).To(HaveLen(expectedTotalReqs))
 
@@ -1278,6 +1499,194 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildCompressionPolicyStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	getCompressionPolicy := func(name string, conds []conditions.Condition, valid bool) *graph.CompressionPolicy {
+		return &graph.CompressionPolicy{
+			Source: &ngfAPI.CompressionPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "test",
+					Name:       name,
+					Generation: 1,
+				},
+			},
+			Gateway:    types.NamespacedName{Namespace: "test", Name: "gateway"},
+			Conditions: conds,
+			Valid:      valid,
+		}
+	}
+
+	policies := map[types.NamespacedName]*graph.CompressionPolicy{
+		{Namespace: "test", Name: "valid-cp"}: getCompressionPolicy(
+			"valid-cp",
+			[]conditions.Condition{staticConds.NewCompressionPolicyAccepted()},
+			true,
+		),
+		{Namespace: "test", Name: "conflicted-cp"}: getCompressionPolicy(
+			"conflicted-cp",
+			[]conditions.Condition{staticConds.NewCompressionPolicyConflicted("conflicted")},
+			false,
+		),
+	}
+
+	createExpectedStatus := func(status metav1.ConditionStatus, reason, msg string) v1alpha2.PolicyStatus {
+		return v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						Name:      "gateway",
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             status,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             reason,
+							Message:            msg,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	expected := map[types.NamespacedName]v1alpha2.PolicyStatus{
+		{Namespace: "test", Name: "valid-cp"}: createExpectedStatus(
+			metav1.ConditionTrue,
+			string(v1alpha2.PolicyReasonAccepted),
+			"CompressionPolicy is accepted by the Gateway",
+		),
+		{Namespace: "test", Name: "conflicted-cp"}: createExpectedStatus(
+			metav1.ConditionFalse,
+			string(v1alpha2.PolicyReasonConflicted),
+			"conflicted",
+		),
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.CompressionPolicy{})
+
+	for _, pol := range policies {
+		err := k8sClient.Create(context.Background(), pol.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareCompressionPolicyRequests(policies, transitionTime, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, exp := range expected {
+		var pol ngfAPI.CompressionPolicy
+
+		err := k8sClient.Get(context.Background(), nsname, &pol)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, pol.Status)).To(BeEmpty())
+	}
+}
+
+func TestBuildErrorPagePolicyStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	getErrorPagePolicy := func(name string, conds []conditions.Condition, valid bool) *graph.ErrorPagePolicy {
+		return &graph.ErrorPagePolicy{
+			Source: &ngfAPI.ErrorPagePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "test",
+					Name:       name,
+					Generation: 1,
+				},
+			},
+			Gateway:    types.NamespacedName{Namespace: "test", Name: "gateway"},
+			Conditions: conds,
+			Valid:      valid,
+		}
+	}
+
+	policies := map[types.NamespacedName]*graph.ErrorPagePolicy{
+		{Namespace: "test", Name: "valid-epp"}: getErrorPagePolicy(
+			"valid-epp",
+			[]conditions.Condition{staticConds.NewErrorPagePolicyAccepted()},
+			true,
+		),
+		{Namespace: "test", Name: "conflicted-epp"}: getErrorPagePolicy(
+			"conflicted-epp",
+			[]conditions.Condition{staticConds.NewErrorPagePolicyConflicted("conflicted")},
+			false,
+		),
+	}
+
+	createExpectedStatus := func(status metav1.ConditionStatus, reason, msg string) v1alpha2.PolicyStatus {
+		return v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						Name:      "gateway",
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             status,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             reason,
+							Message:            msg,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	expected := map[types.NamespacedName]v1alpha2.PolicyStatus{
+		{Namespace: "test", Name: "valid-epp"}: createExpectedStatus(
+			metav1.ConditionTrue,
+			string(v1alpha2.PolicyReasonAccepted),
+			"ErrorPagePolicy is accepted by the Gateway",
+		),
+		{Namespace: "test", Name: "conflicted-epp"}: createExpectedStatus(
+			metav1.ConditionFalse,
+			string(v1alpha2.PolicyReasonConflicted),
+			"conflicted",
+		),
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.ErrorPagePolicy{})
+
+	for _, pol := range policies {
+		err := k8sClient.Create(context.Background(), pol.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareErrorPagePolicyRequests(policies, transitionTime, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, exp := range expected {
+		var pol ngfAPI.ErrorPagePolicy
+
+		err := k8sClient.Get(context.Background(), nsname, &pol)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, pol.Status)).To(BeEmpty())
+	}
+}
+
 func TestBuildNginxGatewayStatus(t *testing.T) {
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
 
@@ -1370,3 +1779,130 @@ func TestBuildNginxGatewayStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildNginxGatewayConfigHashStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.NginxGateway{})
+
+	nginxGateway := &ngfAPI.NginxGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "nginx-gateway",
+			Namespace: "test",
+		},
+	}
+	g.Expect(k8sClient.Create(context.Background(), nginxGateway)).To(Succeed())
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+	nsName := types.NamespacedName{Namespace: "test", Name: "nginx-gateway"}
+
+	updater.Update(
+		context.Background(),
+		*PrepareNginxGatewayStatus(nginxGateway, transitionTime, ControlPlaneUpdateResult{}),
+		PrepareNginxGatewayConfigHashStatus(nsName, "hash"),
+	)
+
+	var ngw ngfAPI.NginxGateway
+
+	g.Expect(k8sClient.Get(context.Background(), nsName, &ngw)).To(Succeed())
+	g.Expect(ngw.Status.LastAppliedConfigHash).To(Equal("hash"))
+	g.Expect(ngw.Status.Conditions).To(HaveLen(1))
+}
+
+func TestBuildSnippetsFilterStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	getSnippetsFilter := func(name string, conds []conditions.Condition, valid bool) *graph.SnippetsFilter {
+		return &graph.SnippetsFilter{
+			Source: &ngfAPI.SnippetsFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "test",
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: ngfAPI.SnippetsFilterSpec{
+					Snippets: []ngfAPI.Snippet{
+						{
+							Context: ngfAPI.NginxContextMain,
+							Value:   "worker_priority 0;",
+						},
+					},
+				},
+			},
+			Conditions: conds,
+			Valid:      valid,
+		}
+	}
+
+	snippetsFilters := map[types.NamespacedName]*graph.SnippetsFilter{
+		{Namespace: "test", Name: "valid-sf"}: getSnippetsFilter(
+			"valid-sf",
+			[]conditions.Condition{staticConds.NewSnippetsFilterAccepted()},
+			true,
+		),
+		{Namespace: "test", Name: "invalid-sf"}: getSnippetsFilter(
+			"invalid-sf",
+			[]conditions.Condition{staticConds.NewSnippetsFilterInvalid("invalid")},
+			false,
+		),
+	}
+
+	createExpectedStatus := func(status metav1.ConditionStatus, reason, msg string) ngfAPI.SnippetsFilterStatus {
+		return ngfAPI.SnippetsFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.SnippetsFilterConditionTypeAccepted),
+							Status:             status,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             reason,
+							Message:            msg,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	expected := map[types.NamespacedName]ngfAPI.SnippetsFilterStatus{
+		{Namespace: "test", Name: "valid-sf"}: createExpectedStatus(
+			metav1.ConditionTrue,
+			string(ngfAPI.SnippetsFilterConditionReasonAccepted),
+			"SnippetsFilter is accepted",
+		),
+		{Namespace: "test", Name: "invalid-sf"}: createExpectedStatus(
+			metav1.ConditionFalse,
+			string(ngfAPI.SnippetsFilterConditionReasonInvalid),
+			"invalid",
+		),
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.SnippetsFilter{})
+
+	for _, sf := range snippetsFilters {
+		err := k8sClient.Create(context.Background(), sf.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareSnippetsFilterRequests(snippetsFilters, transitionTime, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, exp := range expected {
+		var sf ngfAPI.SnippetsFilter
+
+		err := k8sClient.Get(context.Background(), nsname, &sf)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, sf.Status)).To(BeEmpty())
+	}
+}
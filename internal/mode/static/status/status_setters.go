@@ -20,7 +20,20 @@ func newNginxGatewayStatusSetter(status ngfAPI.NginxGatewayStatus) frameworkStat
 			return false
 		}
 
-		ng.Status = status
+		ng.Status.Conditions = status.Conditions
+		return true
+	}
+}
+
+func newNginxGatewayConfigHashSetter(hash string) frameworkStatus.Setter {
+	return func(obj client.Object) (wasSet bool) {
+		ng := helpers.MustCastObject[*ngfAPI.NginxGateway](obj)
+
+		if ng.Status.LastAppliedConfigHash == hash {
+			return false
+		}
+
+		ng.Status.LastAppliedConfigHash = hash
 		return true
 	}
 }
@@ -196,32 +209,92 @@ func newBackendTLSPolicyStatusSetter(
 	return func(object client.Object) (wasSet bool) {
 		btp := helpers.MustCastObject[*v1alpha2.BackendTLSPolicy](object)
 
-		// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
-		// ancestor statuses.
-		maxAncestors := 1 + len(btp.Status.Ancestors)
-		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
+		return setPolicyStatus(&btp.Status, status, gatewayCtlrName)
+	}
+}
+
+func newCompressionPolicyStatusSetter(
+	status v1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		cp := helpers.MustCastObject[*ngfAPI.CompressionPolicy](object)
 
-		// keep all the ancestor statuses that belong to other controllers
-		for _, os := range btp.Status.Ancestors {
-			if string(os.ControllerName) != gatewayCtlrName {
-				ancestors = append(ancestors, os)
+		return setPolicyStatus(&cp.Status, status, gatewayCtlrName)
+	}
+}
+
+func newErrorPagePolicyStatusSetter(
+	status v1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		ep := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](object)
+
+		return setPolicyStatus(&ep.Status, status, gatewayCtlrName)
+	}
+}
+
+func newSnippetsFilterStatusSetter(
+	status ngfAPI.SnippetsFilterStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		sf := helpers.MustCastObject[*ngfAPI.SnippetsFilter](object)
+
+		// keep all the controller statuses that belong to other controllers
+		controllers := make([]ngfAPI.ControllerStatus, 0, len(sf.Status.Controllers)+len(status.Controllers))
+		for _, cs := range sf.Status.Controllers {
+			if string(cs.ControllerName) != gatewayCtlrName {
+				controllers = append(controllers, cs)
 			}
 		}
+		controllers = append(controllers, status.Controllers...)
 
-		ancestors = append(ancestors, status.Ancestors...)
-		status.Ancestors = ancestors
-
-		if btpStatusEqual(gatewayCtlrName, btp.Status, status) {
+		if snippetsFilterControllerStatusesEqual(sf.Status.Controllers, controllers) {
 			return false
 		}
 
-		btp.Status = status
+		sf.Status.Controllers = controllers
 		return true
 	}
 }
 
-func btpStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) bool {
-	// Since other controllers may update BackendTLSPolicy status we can't assume anything about the order of the
+func snippetsFilterControllerStatusesEqual(prev, cur []ngfAPI.ControllerStatus) bool {
+	return slices.EqualFunc(prev, cur, func(p, c ngfAPI.ControllerStatus) bool {
+		return p.ControllerName == c.ControllerName && frameworkStatus.ConditionsEqual(p.Conditions, c.Conditions)
+	})
+}
+
+// setPolicyStatus replaces the ancestor statuses of the policy that belong to our controller with the ancestor
+// statuses from status, keeping the ancestor statuses that belong to other controllers.
+// It returns true if the policy status was changed.
+func setPolicyStatus(policyStatus *v1alpha2.PolicyStatus, status v1alpha2.PolicyStatus, gatewayCtlrName string) bool {
+	// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
+	// ancestor statuses.
+	maxAncestors := len(status.Ancestors) + len(policyStatus.Ancestors)
+	ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
+
+	// keep all the ancestor statuses that belong to other controllers
+	for _, os := range policyStatus.Ancestors {
+		if string(os.ControllerName) != gatewayCtlrName {
+			ancestors = append(ancestors, os)
+		}
+	}
+
+	ancestors = append(ancestors, status.Ancestors...)
+	status.Ancestors = ancestors
+
+	if policyStatusEqual(gatewayCtlrName, *policyStatus, status) {
+		return false
+	}
+
+	*policyStatus = status
+	return true
+}
+
+func policyStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) bool {
+	// Since other controllers may update the policy status we can't assume anything about the order of the
 	// statuses, and we have to ignore statuses written by other controllers when checking for equality.
 	// Therefore, we can't use slices.EqualFunc here because it cares about the order.
 
@@ -232,7 +305,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) boo
 		}
 
 		exists := slices.ContainsFunc(cur.Ancestors, func(curAncestor v1alpha2.PolicyAncestorStatus) bool {
-			return btpAncestorStatusEqual(prevAncestor, curAncestor)
+			return policyAncestorStatusEqual(prevAncestor, curAncestor)
 		})
 
 		if !exists {
@@ -243,7 +316,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) boo
 	// Then, we check if the cur status has any PolicyAncestorStatuses that are no longer present in the prev status.
 	for _, curParent := range cur.Ancestors {
 		exists := slices.ContainsFunc(prev.Ancestors, func(prevAncestor v1alpha2.PolicyAncestorStatus) bool {
-			return btpAncestorStatusEqual(curParent, prevAncestor)
+			return policyAncestorStatusEqual(curParent, prevAncestor)
 		})
 
 		if !exists {
@@ -254,7 +327,7 @@ func btpStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) boo
 	return true
 }
 
-func btpAncestorStatusEqual(p1, p2 v1alpha2.PolicyAncestorStatus) bool {
+func policyAncestorStatusEqual(p1, p2 v1alpha2.PolicyAncestorStatus) bool {
 	if p1.ControllerName != p2.ControllerName {
 		return false
 	}
@@ -46,6 +46,17 @@ func TestNewNginxGatewayStatusSetter(t *testing.T) {
 				Conditions: []metav1.Condition{{Message: "same condition"}},
 			},
 		},
+		{
+			name:         "NginxGateway has old status with config hash",
+			expStatusSet: true,
+			newStatus: ngfAPI.NginxGatewayStatus{
+				Conditions: []metav1.Condition{{Message: "new condition"}},
+			},
+			status: ngfAPI.NginxGatewayStatus{
+				Conditions:            []metav1.Condition{{Message: "old condition"}},
+				LastAppliedConfigHash: "hash",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -58,7 +69,56 @@ func TestNewNginxGatewayStatusSetter(t *testing.T) {
 			statusSet := setter(obj)
 
 			g.Expect(statusSet).To(Equal(test.expStatusSet))
-			g.Expect(obj.Status).To(Equal(test.newStatus))
+			g.Expect(obj.Status.Conditions).To(Equal(test.newStatus.Conditions))
+			g.Expect(obj.Status.LastAppliedConfigHash).To(Equal(test.status.LastAppliedConfigHash))
+		})
+	}
+}
+
+func TestNewNginxGatewayConfigHashSetter(t *testing.T) {
+	tests := []struct {
+		name         string
+		hash         string
+		status       ngfAPI.NginxGatewayStatus
+		expStatusSet bool
+	}{
+		{
+			name:         "NginxGateway has no status",
+			hash:         "hash",
+			status:       ngfAPI.NginxGatewayStatus{},
+			expStatusSet: true,
+		},
+		{
+			name: "NginxGateway has old hash",
+			hash: "new-hash",
+			status: ngfAPI.NginxGatewayStatus{
+				Conditions:            []metav1.Condition{{Message: "some condition"}},
+				LastAppliedConfigHash: "old-hash",
+			},
+			expStatusSet: true,
+		},
+		{
+			name: "NginxGateway has same hash",
+			hash: "hash",
+			status: ngfAPI.NginxGatewayStatus{
+				LastAppliedConfigHash: "hash",
+			},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newNginxGatewayConfigHashSetter(test.hash)
+			obj := &ngfAPI.NginxGateway{Status: *test.status.DeepCopy()}
+
+			statusSet := setter(obj)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(obj.Status.LastAppliedConfigHash).To(Equal(test.hash))
+			g.Expect(obj.Status.Conditions).To(Equal(test.status.Conditions))
 		})
 	}
 }
@@ -664,6 +724,61 @@ func TestNewBackendTLSPolicyStatusSetter(t *testing.T) {
 	}
 }
 
+func TestNewCompressionPolicyStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	g := NewWithT(t)
+
+	newStatus := v1alpha2.PolicyStatus{
+		Ancestors: []v1alpha2.PolicyAncestorStatus{
+			{
+				ControllerName: controllerName,
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+			},
+		},
+	}
+
+	obj := &ngfAPI.CompressionPolicy{
+		Status: v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					ControllerName: controllerName,
+					Conditions:     []metav1.Condition{{Message: "old condition"}},
+				},
+				{
+					ControllerName: otherControllerName,
+					Conditions:     []metav1.Condition{{Message: "some condition"}},
+				},
+			},
+		},
+	}
+
+	expStatus := v1alpha2.PolicyStatus{
+		Ancestors: []v1alpha2.PolicyAncestorStatus{
+			{
+				ControllerName: otherControllerName,
+				Conditions:     []metav1.Condition{{Message: "some condition"}},
+			},
+			{
+				ControllerName: controllerName,
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+			},
+		},
+	}
+
+	setter := newCompressionPolicyStatusSetter(newStatus, controllerName)
+
+	g.Expect(setter(obj)).To(BeTrue())
+	g.Expect(obj.Status).To(Equal(expStatus))
+
+	// setting the same status again is a no-op
+	g.Expect(setter(obj)).To(BeFalse())
+	g.Expect(obj.Status).To(Equal(expStatus))
+}
+
 func TestGWStatusEqual(t *testing.T) {
 	getDefaultStatus := func() gatewayv1.GatewayStatus {
 		return gatewayv1.GatewayStatus{
@@ -1151,7 +1266,7 @@ func TestEqualPointers(t *testing.T) {
 	}
 }
 
-func TestBtpStatusEqual(t *testing.T) {
+func TestPolicyStatusEqual(t *testing.T) {
 	getPolicyStatus := func(ancestorName, ancestorNs, ctlrName string) v1alpha2.PolicyStatus {
 		return v1alpha2.PolicyStatus{
 			Ancestors: []v1alpha2.PolicyAncestorStatus{
@@ -1226,7 +1341,7 @@ func TestBtpStatusEqual(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			equal := btpStatusEqual(test.controllerName, test.previous, test.current)
+			equal := policyStatusEqual(test.controllerName, test.previous, test.current)
 			g.Expect(equal).To(Equal(test.expEqual))
 		})
 	}
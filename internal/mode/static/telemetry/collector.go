@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	k8sversion "k8s.io/apimachinery/pkg/util/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -33,6 +34,13 @@ type ConfigurationGetter interface {
 	GetLatestConfiguration() *dataplane.Configuration
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NginxReloadCountGetter
+
+// NginxReloadCountGetter gets the number of NGINX reloads.
+type NginxReloadCountGetter interface {
+	GetNginxReloadCount() int64
+}
+
 // Data is telemetry data.
 //
 //go:generate go run -tags generator github.com/nginxinc/telemetry-exporter/cmd/generator -type=Data -scheme -scheme-protocol=NGFProductTelemetry -scheme-df-datatype=ngf-product-telemetry
@@ -47,8 +55,13 @@ type Data struct {
 	// Each value is either 'true' or 'false' for boolean flags and 'default' or 'user-defined' for non-boolean flags.
 	FlagValues        []string
 	NGFResourceCounts // embedding is required by the generator.
+	NGFFeatureCounts  // embedding is required by the generator.
 	// NGFReplicaCount is the number of replicas of the NGF Pod.
 	NGFReplicaCount int64
+	// NginxOSSReloadCount is the number of NGINX reloads since the NGF Pod started, if NGF uses NGINX open source.
+	NginxOSSReloadCount int64
+	// NginxPlusReloadCount is the number of NGINX reloads since the NGF Pod started, if NGF uses NGINX Plus.
+	NginxPlusReloadCount int64
 }
 
 // NGFResourceCounts stores the counts of all relevant resources that NGF processes and generates configuration from.
@@ -67,6 +80,43 @@ type NGFResourceCounts struct {
 	ServiceCount int64
 	// EndpointCount include the total count of Endpoints(IP:port) across all referenced services.
 	EndpointCount int64
+	// GRPCRouteCount is the number of relevant GRPCRoutes.
+	GRPCRouteCount int64
+	// BackendTLSPolicyCount is the number of relevant BackendTLSPolicies.
+	BackendTLSPolicyCount int64
+	// NginxProxyCount is the number of NginxProxies attached to the GatewayClass.
+	NginxProxyCount int64
+}
+
+// NGFFeatureCounts stores the counts of the filters and matches that are used in the valid rules of the relevant
+// HTTPRoutes and GRPCRoutes.
+//
+//go:generate go run -tags generator github.com/nginxinc/telemetry-exporter/cmd/generator -type=NGFFeatureCounts
+type NGFFeatureCounts struct {
+	// RequestHeaderModifierFilterCount is the number of RequestHeaderModifier filters.
+	RequestHeaderModifierFilterCount int64
+	// ResponseHeaderModifierFilterCount is the number of ResponseHeaderModifier filters.
+	ResponseHeaderModifierFilterCount int64
+	// RequestRedirectFilterCount is the number of RequestRedirect filters.
+	RequestRedirectFilterCount int64
+	// URLRewriteFilterCount is the number of URLRewrite filters.
+	URLRewriteFilterCount int64
+	// RequestMirrorFilterCount is the number of RequestMirror filters.
+	RequestMirrorFilterCount int64
+	// ExtensionRefFilterCount is the number of ExtensionRef filters.
+	ExtensionRefFilterCount int64
+	// PathExactMatchCount is the number of matches with an Exact path match.
+	PathExactMatchCount int64
+	// PathPrefixMatchCount is the number of matches with a PathPrefix path match.
+	PathPrefixMatchCount int64
+	// PathRegularExpressionMatchCount is the number of matches with a RegularExpression path match.
+	PathRegularExpressionMatchCount int64
+	// HeaderMatchCount is the number of matches with header matches.
+	HeaderMatchCount int64
+	// QueryParamMatchCount is the number of matches with query parameter matches.
+	QueryParamMatchCount int64
+	// MethodMatchCount is the number of matches with a method match.
+	MethodMatchCount int64
 }
 
 // DataCollectorConfig holds configuration parameters for DataCollectorImpl.
@@ -77,6 +127,8 @@ type DataCollectorConfig struct {
 	GraphGetter GraphGetter
 	// ConfigurationGetter allows us to get the Configuration.
 	ConfigurationGetter ConfigurationGetter
+	// NginxReloadCountGetter allows us to get the number of NGINX reloads.
+	NginxReloadCountGetter NginxReloadCountGetter
 	// Version is the NGF version.
 	Version string
 	// PodNSName is the NamespacedName of the NGF Pod.
@@ -85,6 +137,8 @@ type DataCollectorConfig struct {
 	ImageSource string
 	// Flags contains the command-line NGF flag keys and values.
 	Flags config.Flags
+	// Plus indicates whether NGF uses NGINX Plus.
+	Plus bool
 }
 
 // DataCollectorImpl is am implementation of DataCollector.
@@ -140,12 +194,20 @@ func (c DataCollectorImpl) Collect(ctx context.Context) (Data, error) {
 			ClusterNodeCount:    int64(clusterInfo.NodeCount),
 		},
 		NGFResourceCounts: graphResourceCount,
+		NGFFeatureCounts:  collectFeatureCounts(c.cfg.GraphGetter.GetLatestGraph()),
 		ImageSource:       c.cfg.ImageSource,
 		FlagNames:         c.cfg.Flags.Names,
 		FlagValues:        c.cfg.Flags.Values,
 		NGFReplicaCount:   int64(replicaCount),
 	}
 
+	reloadCount := c.cfg.NginxReloadCountGetter.GetNginxReloadCount()
+	if c.cfg.Plus {
+		data.NginxPlusReloadCount = reloadCount
+	} else {
+		data.NginxOSSReloadCount = reloadCount
+	}
+
 	return data, nil
 }
 
@@ -174,9 +236,14 @@ func collectGraphResourceCount(
 		ngfResourceCounts.GatewayCount++
 	}
 
-	ngfResourceCounts.HTTPRouteCount = computeRouteCount(g.Routes)
+	ngfResourceCounts.HTTPRouteCount, ngfResourceCounts.GRPCRouteCount = computeRouteCount(g.Routes)
 	ngfResourceCounts.SecretCount = int64(len(g.ReferencedSecrets))
 	ngfResourceCounts.ServiceCount = int64(len(g.ReferencedServices))
+	ngfResourceCounts.BackendTLSPolicyCount = int64(len(g.BackendTLSPolicies))
+
+	if g.NginxProxy != nil {
+		ngfResourceCounts.NginxProxyCount = 1
+	}
 
 	for _, upstream := range cfg.Upstreams {
 		if upstream.ErrorMsg == "" {
@@ -187,13 +254,89 @@ func collectGraphResourceCount(
 	return ngfResourceCounts, nil
 }
 
-func computeRouteCount(routes map[graph.RouteKey]*graph.L7Route) (httpRouteCount int64) {
+func computeRouteCount(routes map[graph.RouteKey]*graph.L7Route) (httpRouteCount, grpcRouteCount int64) {
 	for _, r := range routes {
-		if r.RouteType == graph.RouteTypeHTTP {
+		switch r.RouteType {
+		case graph.RouteTypeHTTP:
 			httpRouteCount = httpRouteCount + 1
+		case graph.RouteTypeGRPC:
+			grpcRouteCount = grpcRouteCount + 1
+		}
+	}
+	return httpRouteCount, grpcRouteCount
+}
+
+// collectFeatureCounts counts the filters and matches of the valid rules of the valid Routes.
+func collectFeatureCounts(g *graph.Graph) NGFFeatureCounts {
+	var counts NGFFeatureCounts
+
+	if g == nil {
+		return counts
+	}
+
+	for _, r := range g.Routes {
+		if !r.Valid {
+			continue
+		}
+
+		for _, rule := range r.Spec.Rules {
+			if rule.ValidFilters {
+				countFilters(&counts, rule.Filters)
+			}
+
+			if rule.ValidMatches {
+				countMatches(&counts, rule.Matches)
+			}
+		}
+	}
+
+	return counts
+}
+
+func countFilters(counts *NGFFeatureCounts, filters []gatewayv1.HTTPRouteFilter) {
+	for _, filter := range filters {
+		switch filter.Type {
+		case gatewayv1.HTTPRouteFilterRequestHeaderModifier:
+			counts.RequestHeaderModifierFilterCount++
+		case gatewayv1.HTTPRouteFilterResponseHeaderModifier:
+			counts.ResponseHeaderModifierFilterCount++
+		case gatewayv1.HTTPRouteFilterRequestRedirect:
+			counts.RequestRedirectFilterCount++
+		case gatewayv1.HTTPRouteFilterURLRewrite:
+			counts.URLRewriteFilterCount++
+		case gatewayv1.HTTPRouteFilterRequestMirror:
+			counts.RequestMirrorFilterCount++
+		case gatewayv1.HTTPRouteFilterExtensionRef:
+			counts.ExtensionRefFilterCount++
+		}
+	}
+}
+
+func countMatches(counts *NGFFeatureCounts, matches []gatewayv1.HTTPRouteMatch) {
+	for _, match := range matches {
+		if match.Path != nil && match.Path.Type != nil {
+			switch *match.Path.Type {
+			case gatewayv1.PathMatchExact:
+				counts.PathExactMatchCount++
+			case gatewayv1.PathMatchPathPrefix:
+				counts.PathPrefixMatchCount++
+			case gatewayv1.PathMatchRegularExpression:
+				counts.PathRegularExpressionMatchCount++
+			}
+		}
+
+		if len(match.Headers) > 0 {
+			counts.HeaderMatchCount++
+		}
+
+		if len(match.QueryParams) > 0 {
+			counts.QueryParamMatchCount++
+		}
+
+		if match.Method != nil {
+			counts.MethodMatchCount++
 		}
 	}
-	return httpRouteCount
 }
 
 func getPodReplicaSet(
@@ -16,7 +16,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events/eventsfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
@@ -70,21 +72,22 @@ func createGetCallsFunc(objects ...client.Object) getCallsFunc {
 
 var _ = Describe("Collector", Ordered, func() {
 	var (
-		k8sClientReader         *eventsfakes.FakeReader
-		fakeGraphGetter         *telemetryfakes.FakeGraphGetter
-		fakeConfigurationGetter *telemetryfakes.FakeConfigurationGetter
-		dataCollector           telemetry.DataCollector
-		version                 string
-		expData                 telemetry.Data
-		ctx                     context.Context
-		podNSName               types.NamespacedName
-		ngfPod                  *v1.Pod
-		ngfReplicaSet           *appsv1.ReplicaSet
-		kubeNamespace           *v1.Namespace
-		baseGetCalls            getCallsFunc
-		baseListCalls           listCallsFunc
-		flags                   config.Flags
-		nodeList                *v1.NodeList
+		k8sClientReader            *eventsfakes.FakeReader
+		fakeGraphGetter            *telemetryfakes.FakeGraphGetter
+		fakeConfigurationGetter    *telemetryfakes.FakeConfigurationGetter
+		fakeNginxReloadCountGetter *telemetryfakes.FakeNginxReloadCountGetter
+		dataCollector              telemetry.DataCollector
+		version                    string
+		expData                    telemetry.Data
+		ctx                        context.Context
+		podNSName                  types.NamespacedName
+		ngfPod                     *v1.Pod
+		ngfReplicaSet              *appsv1.ReplicaSet
+		kubeNamespace              *v1.Namespace
+		baseGetCalls               getCallsFunc
+		baseListCalls              listCallsFunc
+		flags                      config.Flags
+		nodeList                   *v1.NodeList
 	)
 
 	BeforeAll(func() {
@@ -178,18 +181,20 @@ var _ = Describe("Collector", Ordered, func() {
 		k8sClientReader = &eventsfakes.FakeReader{}
 		fakeGraphGetter = &telemetryfakes.FakeGraphGetter{}
 		fakeConfigurationGetter = &telemetryfakes.FakeConfigurationGetter{}
+		fakeNginxReloadCountGetter = &telemetryfakes.FakeNginxReloadCountGetter{}
 
 		fakeGraphGetter.GetLatestGraphReturns(&graph.Graph{})
 		fakeConfigurationGetter.GetLatestConfigurationReturns(&dataplane.Configuration{})
 
 		dataCollector = telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
-			K8sClientReader:     k8sClientReader,
-			GraphGetter:         fakeGraphGetter,
-			ConfigurationGetter: fakeConfigurationGetter,
-			Version:             version,
-			PodNSName:           podNSName,
-			ImageSource:         "local",
-			Flags:               flags,
+			K8sClientReader:        k8sClientReader,
+			GraphGetter:            fakeGraphGetter,
+			ConfigurationGetter:    fakeConfigurationGetter,
+			NginxReloadCountGetter: fakeNginxReloadCountGetter,
+			Version:                version,
+			PodNSName:              podNSName,
+			ImageSource:            "local",
+			Flags:                  flags,
 		})
 
 		baseGetCalls = createGetCallsFunc(ngfPod, ngfReplicaSet, kubeNamespace)
@@ -279,11 +284,37 @@ var _ = Describe("Collector", Ordered, func() {
 						{Name: "ignoredGw2"}: {},
 					},
 					Routes: map[graph.RouteKey]*graph.L7Route{
-						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {
+							RouteType: graph.RouteTypeHTTP,
+							Valid:     true,
+							Spec: graph.L7RouteSpec{
+								Rules: []graph.RouteRule{
+									{
+										Matches: []gatewayv1.HTTPRouteMatch{
+											{
+												Path: &gatewayv1.HTTPPathMatch{
+													Type: helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+												},
+											},
+										},
+										Filters: []gatewayv1.HTTPRouteFilter{
+											{Type: gatewayv1.HTTPRouteFilterRequestRedirect},
+										},
+										ValidMatches: true,
+										ValidFilters: true,
+									},
+								},
+							},
+						},
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-2"}}: {RouteType: graph.RouteTypeHTTP},
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-3"}}: {RouteType: graph.RouteTypeHTTP},
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-1"}}: {RouteType: graph.RouteTypeGRPC},
 					},
+					BackendTLSPolicies: map[types.NamespacedName]*graph.BackendTLSPolicy{
+						{Namespace: "test", Name: "btp-1"}: {},
+						{Namespace: "test", Name: "btp-2"}: {},
+					},
+					NginxProxy: &ngfAPI.NginxProxy{},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 						client.ObjectKeyFromObject(secret1): {
 							Source: secret1,
@@ -333,16 +364,25 @@ var _ = Describe("Collector", Ordered, func() {
 
 				fakeGraphGetter.GetLatestGraphReturns(graph)
 				fakeConfigurationGetter.GetLatestConfigurationReturns(config)
+				fakeNginxReloadCountGetter.GetNginxReloadCountReturns(5)
 
 				expData.ClusterNodeCount = 3
 				expData.NGFResourceCounts = telemetry.NGFResourceCounts{
-					GatewayCount:      3,
-					GatewayClassCount: 3,
-					HTTPRouteCount:    3,
-					SecretCount:       3,
-					ServiceCount:      3,
-					EndpointCount:     4,
+					GatewayCount:          3,
+					GatewayClassCount:     3,
+					HTTPRouteCount:        3,
+					SecretCount:           3,
+					ServiceCount:          3,
+					EndpointCount:         4,
+					GRPCRouteCount:        1,
+					BackendTLSPolicyCount: 2,
+					NginxProxyCount:       1,
+				}
+				expData.NGFFeatureCounts = telemetry.NGFFeatureCounts{
+					RequestRedirectFilterCount: 1,
+					PathPrefixMatchCount:       1,
 				}
+				expData.NginxOSSReloadCount = 5
 				expData.ClusterVersion = "1.29.2"
 				expData.ClusterPlatform = "kind"
 
@@ -605,6 +645,126 @@ var _ = Describe("Collector", Ordered, func() {
 		})
 	})
 
+	Describe("NGF feature count collector", func() {
+		When("collecting NGF feature counts", func() {
+			It("counts the filters and matches of the valid rules of the valid routes", func() {
+				rule := graph.RouteRule{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type: helpers.GetPointer(gatewayv1.PathMatchExact),
+							},
+							Headers:     []gatewayv1.HTTPHeaderMatch{{Name: "header"}, {Name: "other-header"}},
+							QueryParams: []gatewayv1.HTTPQueryParamMatch{{Name: "param"}},
+							Method:      helpers.GetPointer(gatewayv1.HTTPMethodGet),
+						},
+						{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type: helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+							},
+						},
+						{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type: helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+							},
+						},
+					},
+					Filters: []gatewayv1.HTTPRouteFilter{
+						{Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier},
+						{Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier},
+						{Type: gatewayv1.HTTPRouteFilterRequestRedirect},
+						{Type: gatewayv1.HTTPRouteFilterURLRewrite},
+						{Type: gatewayv1.HTTPRouteFilterRequestMirror},
+						{Type: gatewayv1.HTTPRouteFilterExtensionRef},
+					},
+					ValidMatches: true,
+					ValidFilters: true,
+				}
+
+				invalidRule := rule
+				invalidRule.ValidMatches = false
+				invalidRule.ValidFilters = false
+
+				fakeGraphGetter.GetLatestGraphReturns(&graph.Graph{
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {
+							RouteType: graph.RouteTypeHTTP,
+							Valid:     true,
+							Spec: graph.L7RouteSpec{
+								Rules: []graph.RouteRule{rule, invalidRule},
+							},
+						},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-1"}}: {
+							RouteType: graph.RouteTypeGRPC,
+							Valid:     true,
+							Spec: graph.L7RouteSpec{
+								Rules: []graph.RouteRule{rule},
+							},
+						},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "invalid"}}: {
+							RouteType: graph.RouteTypeHTTP,
+							Valid:     false,
+							Spec: graph.L7RouteSpec{
+								Rules: []graph.RouteRule{rule},
+							},
+						},
+					},
+				})
+
+				expData.NGFResourceCounts = telemetry.NGFResourceCounts{
+					HTTPRouteCount: 2,
+					GRPCRouteCount: 1,
+				}
+				expData.NGFFeatureCounts = telemetry.NGFFeatureCounts{
+					RequestHeaderModifierFilterCount:  2,
+					ResponseHeaderModifierFilterCount: 2,
+					RequestRedirectFilterCount:        2,
+					URLRewriteFilterCount:             2,
+					RequestMirrorFilterCount:          2,
+					ExtensionRefFilterCount:           2,
+					PathExactMatchCount:               2,
+					PathPrefixMatchCount:              2,
+					PathRegularExpressionMatchCount:   2,
+					HeaderMatchCount:                  2,
+					QueryParamMatchCount:              2,
+					MethodMatchCount:                  2,
+				}
+
+				data, err := dataCollector.Collect(ctx)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expData).To(Equal(data))
+			})
+		})
+	})
+
+	Describe("NGINX reload count collector", func() {
+		When("collecting NGINX reload count", func() {
+			It("reports the reloads of NGINX Plus", func() {
+				dataCollector = telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
+					K8sClientReader:        k8sClientReader,
+					GraphGetter:            fakeGraphGetter,
+					ConfigurationGetter:    fakeConfigurationGetter,
+					NginxReloadCountGetter: fakeNginxReloadCountGetter,
+					Version:                version,
+					PodNSName:              podNSName,
+					ImageSource:            "local",
+					Flags:                  flags,
+					Plus:                   true,
+				})
+
+				fakeNginxReloadCountGetter.GetNginxReloadCountReturns(3)
+
+				expData.NginxPlusReloadCount = 3
+
+				data, err := dataCollector.Collect(ctx)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expData).To(Equal(data))
+			})
+		})
+	})
+
 	Describe("NGF replica count collector", func() {
 		When("collecting NGF replica count", func() {
 			When("it encounters an error while collecting data", func() {
@@ -22,7 +22,10 @@ func (d *Data) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, attribute.StringSlice("FlagNames", d.FlagNames))
 	attrs = append(attrs, attribute.StringSlice("FlagValues", d.FlagValues))
 	attrs = append(attrs, d.NGFResourceCounts.Attributes()...)
+	attrs = append(attrs, d.NGFFeatureCounts.Attributes()...)
 	attrs = append(attrs, attribute.Int64("NGFReplicaCount", d.NGFReplicaCount))
+	attrs = append(attrs, attribute.Int64("NginxOSSReloadCount", d.NginxOSSReloadCount))
+	attrs = append(attrs, attribute.Int64("NginxPlusReloadCount", d.NginxPlusReloadCount))
 	
 
 	return attrs
@@ -24,14 +24,33 @@ func TestDataAttributes(t *testing.T) {
 		FlagNames:  []string{"test-flag"},
 		FlagValues: []string{"test-value"},
 		NGFResourceCounts: NGFResourceCounts{
-			GatewayCount:      1,
-			GatewayClassCount: 2,
-			HTTPRouteCount:    3,
-			SecretCount:       4,
-			ServiceCount:      5,
-			EndpointCount:     6,
+			GatewayCount:          1,
+			GatewayClassCount:     2,
+			HTTPRouteCount:        3,
+			SecretCount:           4,
+			ServiceCount:          5,
+			EndpointCount:         6,
+			GRPCRouteCount:        7,
+			BackendTLSPolicyCount: 8,
+			NginxProxyCount:       1,
 		},
-		NGFReplicaCount: 3,
+		NGFFeatureCounts: NGFFeatureCounts{
+			RequestHeaderModifierFilterCount:  1,
+			ResponseHeaderModifierFilterCount: 2,
+			RequestRedirectFilterCount:        3,
+			URLRewriteFilterCount:             4,
+			RequestMirrorFilterCount:          5,
+			ExtensionRefFilterCount:           6,
+			PathExactMatchCount:               7,
+			PathPrefixMatchCount:              8,
+			PathRegularExpressionMatchCount:   9,
+			HeaderMatchCount:                  10,
+			QueryParamMatchCount:              11,
+			MethodMatchCount:                  12,
+		},
+		NGFReplicaCount:      3,
+		NginxOSSReloadCount:  4,
+		NginxPlusReloadCount: 5,
 	}
 
 	expected := []attribute.KeyValue{
@@ -53,7 +72,24 @@ func TestDataAttributes(t *testing.T) {
 		attribute.Int64("SecretCount", 4),
 		attribute.Int64("ServiceCount", 5),
 		attribute.Int64("EndpointCount", 6),
+		attribute.Int64("GRPCRouteCount", 7),
+		attribute.Int64("BackendTLSPolicyCount", 8),
+		attribute.Int64("NginxProxyCount", 1),
+		attribute.Int64("RequestHeaderModifierFilterCount", 1),
+		attribute.Int64("ResponseHeaderModifierFilterCount", 2),
+		attribute.Int64("RequestRedirectFilterCount", 3),
+		attribute.Int64("URLRewriteFilterCount", 4),
+		attribute.Int64("RequestMirrorFilterCount", 5),
+		attribute.Int64("ExtensionRefFilterCount", 6),
+		attribute.Int64("PathExactMatchCount", 7),
+		attribute.Int64("PathPrefixMatchCount", 8),
+		attribute.Int64("PathRegularExpressionMatchCount", 9),
+		attribute.Int64("HeaderMatchCount", 10),
+		attribute.Int64("QueryParamMatchCount", 11),
+		attribute.Int64("MethodMatchCount", 12),
 		attribute.Int64("NGFReplicaCount", 3),
+		attribute.Int64("NginxOSSReloadCount", 4),
+		attribute.Int64("NginxPlusReloadCount", 5),
 	}
 
 	result := data.Attributes()
@@ -85,7 +121,24 @@ func TestDataAttributesWithEmptyData(t *testing.T) {
 		attribute.Int64("SecretCount", 0),
 		attribute.Int64("ServiceCount", 0),
 		attribute.Int64("EndpointCount", 0),
+		attribute.Int64("GRPCRouteCount", 0),
+		attribute.Int64("BackendTLSPolicyCount", 0),
+		attribute.Int64("NginxProxyCount", 0),
+		attribute.Int64("RequestHeaderModifierFilterCount", 0),
+		attribute.Int64("ResponseHeaderModifierFilterCount", 0),
+		attribute.Int64("RequestRedirectFilterCount", 0),
+		attribute.Int64("URLRewriteFilterCount", 0),
+		attribute.Int64("RequestMirrorFilterCount", 0),
+		attribute.Int64("ExtensionRefFilterCount", 0),
+		attribute.Int64("PathExactMatchCount", 0),
+		attribute.Int64("PathPrefixMatchCount", 0),
+		attribute.Int64("PathRegularExpressionMatchCount", 0),
+		attribute.Int64("HeaderMatchCount", 0),
+		attribute.Int64("QueryParamMatchCount", 0),
+		attribute.Int64("MethodMatchCount", 0),
 		attribute.Int64("NGFReplicaCount", 0),
+		attribute.Int64("NginxOSSReloadCount", 0),
+		attribute.Int64("NginxPlusReloadCount", 0),
 	}
 
 	result := data.Attributes()
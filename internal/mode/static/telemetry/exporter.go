@@ -2,9 +2,20 @@ package telemetry
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	tel "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 // Exporter exports telemetry data to some destination.
@@ -31,3 +42,118 @@ func (e *LoggingExporter) Export(_ context.Context, data tel.Exportable) error {
 	e.logger.Info("Exporting telemetry", "data", data)
 	return nil
 }
+
+// CreateOTLPHTTPSpanProvider creates a SpanProvider for the telemetry-exporter Exporter that sends the telemetry
+// data using OTLP over HTTP.
+func CreateOTLPHTTPSpanProvider(options ...otlptracehttp.Option) tel.SpanProvider {
+	return func(ctx context.Context) (sdktrace.SpanExporter, error) {
+		return otlptrace.New(ctx, otlptracehttp.NewClient(options...))
+	}
+}
+
+// FileExporterConfig holds the configuration of the FileExporter.
+type FileExporterConfig struct {
+	// Now returns the current time. It is used for the timestamps of the records.
+	Now func() time.Time
+	// Path is the path of the file.
+	Path string
+	// MaxSize is the maximum size of the file in bytes. When a record doesn't fit in the file,
+	// the file is rotated.
+	MaxSize int64
+	// MaxBackups is the number of rotated files to keep. The rotated files have the suffixes .1, .2, etc.,
+	// where .1 is the most recent one.
+	MaxBackups int
+}
+
+// FileExporter writes telemetry data to a local file, one JSON record per line. It rotates the file when
+// it reaches its maximum size.
+type FileExporter struct {
+	cfg FileExporterConfig
+	// lock prevents concurrent writes to the file.
+	lock sync.Mutex
+}
+
+// fileRecord is a line of the file written by the FileExporter.
+type fileRecord struct {
+	Timestamp time.Time      `json:"timestamp"`
+	Data      tel.Exportable `json:"data"`
+}
+
+// NewFileExporter creates a new FileExporter.
+func NewFileExporter(cfg FileExporterConfig) *FileExporter {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	return &FileExporter{
+		cfg: cfg,
+	}
+}
+
+// Export appends the provided telemetry data to the file.
+func (e *FileExporter) Export(_ context.Context, data tel.Exportable) error {
+	record, err := json.Marshal(fileRecord{
+		Timestamp: e.cfg.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal telemetry data: %w", err)
+	}
+	record = append(record, '\n')
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(e.cfg.Path), 0o750); err != nil {
+		return fmt.Errorf("failed to create the directory of the telemetry file: %w", err)
+	}
+
+	if err := e.rotateIfFull(int64(len(record))); err != nil {
+		return fmt.Errorf("failed to rotate the telemetry file: %w", err)
+	}
+
+	f, err := os.OpenFile(e.cfg.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the telemetry file: %w", err)
+	}
+
+	if _, err := f.Write(record); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write to the telemetry file: %w", err)
+	}
+
+	return f.Close()
+}
+
+// rotateIfFull rotates the file if the record of the provided size doesn't fit in it. A non-empty file is always
+// rotated, so a record bigger than MaxSize is written to a new file.
+func (e *FileExporter) rotateIfFull(recordSize int64) error {
+	info, err := os.Stat(e.cfg.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if info.Size() == 0 || info.Size()+recordSize <= e.cfg.MaxSize {
+		return nil
+	}
+
+	if e.cfg.MaxBackups <= 0 {
+		return os.Remove(e.cfg.Path)
+	}
+
+	// the oldest backup is overwritten by the next one
+	for i := e.cfg.MaxBackups - 1; i > 0; i-- {
+		if err := os.Rename(e.backupPath(i), e.backupPath(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return os.Rename(e.cfg.Path, e.backupPath(1))
+}
+
+func (e *FileExporter) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", e.cfg.Path, i)
+}
@@ -3,8 +3,14 @@ package telemetry
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	tel "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
@@ -22,3 +28,80 @@ func TestLoggingExporter(t *testing.T) {
 	g.Expect(buffer.String()).To(ContainSubstring(`"level":"info"`))
 	g.Expect(buffer.String()).To(ContainSubstring(`"msg":"Exporting telemetry"`))
 }
+
+func TestFileExporter(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "telemetry", "telemetry.json")
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	exporter := NewFileExporter(FileExporterConfig{
+		Path:       path,
+		MaxSize:    200,
+		MaxBackups: 2,
+		Now: func() time.Time {
+			return now
+		},
+	})
+
+	export := func(version string) {
+		err := exporter.Export(context.Background(), &Data{Data: tel.Data{ProjectVersion: version}})
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	readRecords := func(path string) []string {
+		content, err := os.ReadFile(path)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		var versions []string
+		for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+			var record struct {
+				Timestamp time.Time `json:"timestamp"`
+				Data      Data      `json:"data"`
+			}
+			g.Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			g.Expect(record.Timestamp).To(Equal(now))
+
+			versions = append(versions, record.Data.ProjectVersion)
+		}
+
+		return versions
+	}
+
+	// MaxSize can't hold two records, so every record except the first one rotates the file
+	export("1")
+	g.Expect(readRecords(path)).To(Equal([]string{"1"}))
+
+	export("2")
+	g.Expect(readRecords(path)).To(Equal([]string{"2"}))
+	g.Expect(readRecords(path + ".1")).To(Equal([]string{"1"}))
+
+	export("3")
+	export("4")
+	g.Expect(readRecords(path)).To(Equal([]string{"4"}))
+	g.Expect(readRecords(path + ".1")).To(Equal([]string{"3"}))
+	g.Expect(readRecords(path + ".2")).To(Equal([]string{"2"}))
+	g.Expect(path + ".3").ToNot(BeAnExistingFile())
+}
+
+func TestFileExporterAppends(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+
+	exporter := NewFileExporter(FileExporterConfig{
+		Path:       path,
+		MaxSize:    1024 * 1024,
+		MaxBackups: 1,
+	})
+
+	for range 3 {
+		g.Expect(exporter.Export(context.Background(), &Data{})).To(Succeed())
+	}
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(strings.Count(string(content), "\n")).To(Equal(3))
+	g.Expect(path + ".1").ToNot(BeAnExistingFile())
+}
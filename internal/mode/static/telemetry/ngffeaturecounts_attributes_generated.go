@@ -0,0 +1,35 @@
+
+package telemetry
+/*
+This is a generated file. DO NOT EDIT.
+*/
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	
+	ngxTelemetry "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
+	
+)
+
+func (d *NGFFeatureCounts) Attributes() []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+
+	attrs = append(attrs, attribute.Int64("RequestHeaderModifierFilterCount", d.RequestHeaderModifierFilterCount))
+	attrs = append(attrs, attribute.Int64("ResponseHeaderModifierFilterCount", d.ResponseHeaderModifierFilterCount))
+	attrs = append(attrs, attribute.Int64("RequestRedirectFilterCount", d.RequestRedirectFilterCount))
+	attrs = append(attrs, attribute.Int64("URLRewriteFilterCount", d.URLRewriteFilterCount))
+	attrs = append(attrs, attribute.Int64("RequestMirrorFilterCount", d.RequestMirrorFilterCount))
+	attrs = append(attrs, attribute.Int64("ExtensionRefFilterCount", d.ExtensionRefFilterCount))
+	attrs = append(attrs, attribute.Int64("PathExactMatchCount", d.PathExactMatchCount))
+	attrs = append(attrs, attribute.Int64("PathPrefixMatchCount", d.PathPrefixMatchCount))
+	attrs = append(attrs, attribute.Int64("PathRegularExpressionMatchCount", d.PathRegularExpressionMatchCount))
+	attrs = append(attrs, attribute.Int64("HeaderMatchCount", d.HeaderMatchCount))
+	attrs = append(attrs, attribute.Int64("QueryParamMatchCount", d.QueryParamMatchCount))
+	attrs = append(attrs, attribute.Int64("MethodMatchCount", d.MethodMatchCount))
+	
+
+	return attrs
+}
+
+var _ ngxTelemetry.Exportable = (*NGFFeatureCounts)(nil)
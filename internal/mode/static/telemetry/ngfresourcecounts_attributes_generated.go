@@ -21,6 +21,9 @@ func (d *NGFResourceCounts) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, attribute.Int64("SecretCount", d.SecretCount))
 	attrs = append(attrs, attribute.Int64("ServiceCount", d.ServiceCount))
 	attrs = append(attrs, attribute.Int64("EndpointCount", d.EndpointCount))
+	attrs = append(attrs, attribute.Int64("GRPCRouteCount", d.GRPCRouteCount))
+	attrs = append(attrs, attribute.Int64("BackendTLSPolicyCount", d.BackendTLSPolicyCount))
+	attrs = append(attrs, attribute.Int64("NginxProxyCount", d.NginxProxyCount))
 	
 
 	return attrs
@@ -3,60 +3,175 @@ package usage
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
 )
 
-func CreateUsageJobWorker(
-	logger logr.Logger,
-	k8sClient client.Reader,
-	reporter Reporter,
-	cfg config.Config,
-) func(ctx context.Context) {
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . MetricsCollector
+
+// maxPendingReports is the maximum number of unsent usage reports that are kept. When the limit is reached,
+// the oldest reports are dropped.
+const maxPendingReports = 100
+
+// MetricsCollector collects metrics of the NGINX Plus usage reporting.
+type MetricsCollector interface {
+	// IncReportSuccessCount increments the counter of usage reports that were sent.
+	IncReportSuccessCount()
+	// IncReportFailureCount increments the counter of failed attempts to send a usage report.
+	IncReportFailureCount()
+	// SetPendingReportCount sets the number of usage reports that haven't been sent yet.
+	SetPendingReportCount(int)
+}
+
+// DefaultRetryBackoff returns the backoff of the retries of sending a usage report.
+func DefaultRetryBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: 5 * time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    5,
+		Cap:      time.Minute,
+	}
+}
+
+// JobWorkerConfig is the configuration of the usage reporting job worker.
+type JobWorkerConfig struct {
+	// Logger is the logger of the worker.
+	Logger logr.Logger
+	// K8sClientReader is a Kubernetes API client Reader.
+	K8sClientReader client.Reader
+	// Reporter sends the usage reports.
+	Reporter Reporter
+	// ReportStore persists the usage reports that haven't been sent yet.
+	ReportStore ReportStore
+	// MetricsCollector collects the metrics of the usage reporting.
+	MetricsCollector MetricsCollector
+	// Now returns the current time. It sets the timestamp of the usage reports.
+	Now func() time.Time
+	// RetryBackoff is the backoff of the retries of sending a usage report.
+	RetryBackoff wait.Backoff
+	// Config is the NGF config.
+	Config config.Config
+}
+
+// CreateUsageJobWorker creates a worker that collects the usage report of the cluster and sends it,
+// together with the reports that previous runs failed to send. Each report has the time of its collection. Every report is retried with backoff;
+// the reports that still can't be sent are kept in the ReportStore for the next run.
+func CreateUsageJobWorker(cfg JobWorkerConfig) func(ctx context.Context) {
+	logger := cfg.Logger
+
 	return func(ctx context.Context) {
-		nodeCount, err := CollectNodeCount(ctx, k8sClient)
+		clusterDetails, err := collectClusterDetails(ctx, cfg.K8sClientReader, cfg.Config, cfg.Now())
 		if err != nil {
-			logger.Error(err, "Failed to collect node count")
+			logger.Error(err, "Failed to collect NGINX Plus usage")
 			return
 		}
 
-		podCount, err := GetTotalNGFPodCount(ctx, k8sClient)
+		// If the unsent reports can't be loaded, the new report is still sent.
+		pending, err := cfg.ReportStore.Load(ctx)
 		if err != nil {
-			logger.Error(err, "Failed to collect replica count")
-			return
+			logger.Error(err, "Failed to load unsent NGINX Plus usage reports")
 		}
 
-		clusterUID, err := telemetry.CollectClusterID(ctx, k8sClient)
-		if err != nil {
-			logger.Error(err, "Failed to collect cluster UID")
-			return
+		stored := len(pending) > 0
+
+		pending = append(pending, clusterDetails)
+		if dropped := len(pending) - maxPendingReports; dropped > 0 {
+			logger.Info("Dropping the oldest unsent NGINX Plus usage reports", "count", dropped)
+			pending = pending[dropped:]
 		}
 
-		clusterDetails := ClusterDetails{
-			Metadata: Metadata{
-				DisplayName: cfg.UsageReportConfig.ClusterDisplayName,
-				UID:         clusterUID,
-			},
-			NodeCount: int64(nodeCount),
-			PodDetails: PodDetails{
-				CurrentPodCounts: CurrentPodsCount{
-					DosCount: int64(0),
-					PodCount: int64(podCount),
-					WafCount: int64(0),
-				},
-			},
+		sent := 0
+		for _, report := range pending {
+			if err := sendReport(ctx, cfg, report); err != nil {
+				logger.Error(err, "Failed to report NGINX Plus usage; will retry on the next run")
+				break
+			}
+			sent++
 		}
 
-		if err := reporter.Report(ctx, clusterDetails); err != nil {
-			logger.Error(err, "Failed to report NGINX Plus usage")
+		pending = pending[sent:]
+		cfg.MetricsCollector.SetPendingReportCount(len(pending))
+
+		if !stored && len(pending) == 0 {
+			return
 		}
+
+		if err := cfg.ReportStore.Save(ctx, pending); err != nil {
+			logger.Error(err, "Failed to save unsent NGINX Plus usage reports")
+		}
+	}
+}
+
+// sendReport sends the report, retrying with backoff on failure.
+func sendReport(ctx context.Context, cfg JobWorkerConfig, report ClusterDetails) error {
+	var reportErr error
+
+	err := wait.ExponentialBackoffWithContext(
+		ctx,
+		cfg.RetryBackoff,
+		func(ctx context.Context) (bool, error) {
+			if reportErr = cfg.Reporter.Report(ctx, report); reportErr != nil {
+				cfg.MetricsCollector.IncReportFailureCount()
+				cfg.Logger.V(1).Info("Failed to send NGINX Plus usage report", "error", reportErr.Error())
+				return false, nil
+			}
+
+			cfg.MetricsCollector.IncReportSuccessCount()
+			return true, nil
+		},
+	)
+	if err != nil && reportErr != nil {
+		return reportErr
 	}
+
+	return err
+}
+
+func collectClusterDetails(
+	ctx context.Context,
+	k8sClient client.Reader,
+	cfg config.Config,
+	now time.Time,
+) (ClusterDetails, error) {
+	nodeCount, err := CollectNodeCount(ctx, k8sClient)
+	if err != nil {
+		return ClusterDetails{}, fmt.Errorf("failed to collect node count: %w", err)
+	}
+
+	podCount, err := GetTotalNGFPodCount(ctx, k8sClient)
+	if err != nil {
+		return ClusterDetails{}, fmt.Errorf("failed to collect replica count: %w", err)
+	}
+
+	clusterUID, err := telemetry.CollectClusterID(ctx, k8sClient)
+	if err != nil {
+		return ClusterDetails{}, fmt.Errorf("failed to collect cluster UID: %w", err)
+	}
+
+	return ClusterDetails{
+		Metadata: Metadata{
+			DisplayName: cfg.UsageReportConfig.ClusterDisplayName,
+			UID:         clusterUID,
+		},
+		Timestamp: now.UTC(),
+		NodeCount: int64(nodeCount),
+		PodDetails: PodDetails{
+			CurrentPodCounts: CurrentPodsCount{
+				DosCount: int64(0),
+				PodCount: int64(podCount),
+				WafCount: int64(0),
+			},
+		},
+	}, nil
 }
 
 // GetTotalNGFPodCount returns the total count of NGF Pods in the cluster.
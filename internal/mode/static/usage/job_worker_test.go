@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -70,6 +71,7 @@ func TestCreateUsageJobWorker(t *testing.T) {
 					UID:         "1234abcd",
 					DisplayName: "my-cluster",
 				},
+				Timestamp: testNow,
 				NodeCount: 1,
 				PodDetails: usage.PodDetails{
 					CurrentPodCounts: usage.CurrentPodsCount{
@@ -147,11 +149,15 @@ func TestCreateUsageJobWorker(t *testing.T) {
 
 			reporter := &usagefakes.FakeReporter{}
 
-			worker := usage.CreateUsageJobWorker(
-				zap.New(),
-				k8sClientReader,
-				reporter,
-				config.Config{
+			worker := usage.CreateUsageJobWorker(usage.JobWorkerConfig{
+				Logger:           zap.New(),
+				K8sClientReader:  k8sClientReader,
+				Reporter:         reporter,
+				Now:              func() time.Time { return testNow },
+				ReportStore:      &usagefakes.FakeReportStore{},
+				MetricsCollector: &usagefakes.FakeMetricsCollector{},
+				RetryBackoff:     testRetryBackoff,
+				Config: config.Config{
 					GatewayPodConfig: config.GatewayPodConfig{
 						Namespace: "nginx-gateway",
 						Name:      "ngf-pod",
@@ -160,7 +166,7 @@ func TestCreateUsageJobWorker(t *testing.T) {
 						ClusterDisplayName: "my-cluster",
 					},
 				},
-			)
+			})
 
 			timeout := 10 * time.Second
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -177,6 +183,170 @@ func TestCreateUsageJobWorker(t *testing.T) {
 	}
 }
 
+var testNow = time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+var testRetryBackoff = wait.Backoff{
+	Duration: time.Millisecond,
+	Factor:   1,
+	Steps:    3,
+}
+
+func TestCreateUsageJobWorker_Retries(t *testing.T) {
+	// The reports with the greater pod count were collected earlier.
+	report := func(podCount int64) usage.ClusterDetails {
+		return usage.ClusterDetails{
+			Metadata: usage.Metadata{
+				UID:         "1234abcd",
+				DisplayName: "my-cluster",
+			},
+			Timestamp: testNow.Add(-time.Duration(podCount-1) * time.Hour),
+			NodeCount: 1,
+			PodDetails: usage.PodDetails{
+				CurrentPodCounts: usage.CurrentPodsCount{
+					PodCount: podCount,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		reportErrs     []error
+		pending        []usage.ClusterDetails
+		expReports     []usage.ClusterDetails
+		expSaved       []usage.ClusterDetails
+		expSaveCalled  bool
+		expSuccesses   int
+		expFailures    int
+		expPendingSize int
+	}{
+		{
+			name:         "sends the report on the first attempt",
+			expReports:   []usage.ClusterDetails{report(1)},
+			expSuccesses: 1,
+		},
+		{
+			name:         "retries the report until it is sent",
+			reportErrs:   []error{errors.New("error"), errors.New("error")},
+			expReports:   []usage.ClusterDetails{report(1), report(1), report(1)},
+			expSuccesses: 1,
+			expFailures:  2,
+		},
+		{
+			name:           "stores the report when all the attempts fail",
+			reportErrs:     []error{errors.New("error"), errors.New("error"), errors.New("error")},
+			expReports:     []usage.ClusterDetails{report(1), report(1), report(1)},
+			expSaved:       []usage.ClusterDetails{report(1)},
+			expSaveCalled:  true,
+			expFailures:    3,
+			expPendingSize: 1,
+		},
+		{
+			name:          "sends the stored reports before the new report",
+			pending:       []usage.ClusterDetails{report(3), report(2)},
+			expReports:    []usage.ClusterDetails{report(3), report(2), report(1)},
+			expSaved:      []usage.ClusterDetails{},
+			expSaveCalled: true,
+			expSuccesses:  3,
+		},
+		{
+			name:    "stops sending and stores the reports when a stored report can't be sent",
+			pending: []usage.ClusterDetails{report(3), report(2)},
+			reportErrs: []error{
+				nil,
+				errors.New("error"),
+				errors.New("error"),
+				errors.New("error"),
+			},
+			expReports:     []usage.ClusterDetails{report(3), report(2), report(2), report(2)},
+			expSaved:       []usage.ClusterDetails{report(2), report(1)},
+			expSaveCalled:  true,
+			expSuccesses:   1,
+			expFailures:    3,
+			expPendingSize: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			replicas := int32(1)
+			k8sClientReader := &eventsfakes.FakeReader{}
+			k8sClientReader.ListCalls(
+				func(_ context.Context, object client.ObjectList, _ ...client.ListOption) error {
+					switch typedList := object.(type) {
+					case *v1.NodeList:
+						typedList.Items = append(typedList.Items, v1.Node{})
+					case *appsv1.ReplicaSetList:
+						typedList.Items = append(typedList.Items, appsv1.ReplicaSet{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"app.kubernetes.io/name": "nginx-gateway"},
+							},
+							Spec: appsv1.ReplicaSetSpec{Replicas: &replicas},
+						})
+					}
+					return nil
+				},
+			)
+			k8sClientReader.GetCalls(
+				func(_ context.Context, _ types.NamespacedName, object client.Object, _ ...client.GetOption) error {
+					if ns, ok := object.(*v1.Namespace); ok {
+						ns.UID = "1234abcd"
+					}
+					return nil
+				},
+			)
+
+			reporter := &usagefakes.FakeReporter{}
+			for i, err := range test.reportErrs {
+				reporter.ReportReturnsOnCall(i, err)
+			}
+
+			store := &usagefakes.FakeReportStore{}
+			store.LoadReturns(test.pending, nil)
+
+			metricsCollector := &usagefakes.FakeMetricsCollector{}
+
+			worker := usage.CreateUsageJobWorker(usage.JobWorkerConfig{
+				Logger:           zap.New(),
+				K8sClientReader:  k8sClientReader,
+				Reporter:         reporter,
+				Now:              func() time.Time { return testNow },
+				ReportStore:      store,
+				MetricsCollector: metricsCollector,
+				RetryBackoff:     testRetryBackoff,
+				Config: config.Config{
+					UsageReportConfig: &config.UsageReportConfig{
+						ClusterDisplayName: "my-cluster",
+					},
+				},
+			})
+
+			worker(context.Background())
+
+			g.Expect(reporter.ReportCallCount()).To(Equal(len(test.expReports)))
+			for i, expReport := range test.expReports {
+				_, data := reporter.ReportArgsForCall(i)
+				g.Expect(data).To(Equal(expReport))
+			}
+
+			if test.expSaveCalled {
+				g.Expect(store.SaveCallCount()).To(Equal(1))
+				_, saved := store.SaveArgsForCall(0)
+				g.Expect(saved).To(Equal(test.expSaved))
+			} else {
+				g.Expect(store.SaveCallCount()).To(BeZero())
+			}
+
+			g.Expect(metricsCollector.IncReportSuccessCountCallCount()).To(Equal(test.expSuccesses))
+			g.Expect(metricsCollector.IncReportFailureCountCallCount()).To(Equal(test.expFailures))
+			g.Expect(metricsCollector.SetPendingReportCountCallCount()).To(Equal(1))
+			g.Expect(metricsCollector.SetPendingReportCountArgsForCall(0)).To(Equal(test.expPendingSize))
+		})
+	}
+}
+
 func TestGetTotalNGFPodCount(t *testing.T) {
 	g := NewWithT(t)
 
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . credentialsGetter
@@ -23,6 +25,9 @@ type ClusterDetails struct {
 	Metadata Metadata `json:"metadata"`
 	// PodDetails contain the details about the NGF Pod.
 	PodDetails PodDetails `json:"pod_details"`
+	// Timestamp is the time when the usage was collected. It allows the usage collector to tell when the usage
+	// of a report, which was sent after a failure, happened.
+	Timestamp time.Time `json:"timestamp"`
 	// NodeCount is the count of Nodes in the cluster.
 	NodeCount int64 `json:"node_count"`
 }
@@ -58,6 +63,8 @@ type CurrentPodsCount struct {
 type credentialsGetter interface {
 	// GetCredentials returns the base64 encoded username and password from the Secret.
 	GetCredentials() ([]byte, []byte)
+	// GetCACert returns the CA certificate that verifies the certificate of the usage reporting server, if any.
+	GetCACert() []byte
 }
 
 // Reporter reports the NGINX Plus usage info to the provided collector.
@@ -117,11 +124,21 @@ func (r *NIMReporter) Report(ctx context.Context, data ClusterDetails) error {
 	}
 	req.SetBasicAuth(string(username), string(password))
 
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: r.insecureSkipVerify, //nolint:gosec // used for testing
+	}
+
+	if caCert := r.credentials.GetCACert(); len(caCert) > 0 {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return errors.New("failed to parse the CA certificate for NGINX Plus usage reporting")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
 	client := http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: r.insecureSkipVerify, //nolint:gosec // used for testing
-			},
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -3,10 +3,12 @@ package usage
 import (
 	"context"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
@@ -20,6 +22,7 @@ func TestReport(t *testing.T) {
 			UID:         "12345abcde",
 			DisplayName: "my-cluster",
 		},
+		Timestamp: time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC),
 		NodeCount: 9,
 		PodDetails: PodDetails{
 			CurrentPodCounts: CurrentPodsCount{
@@ -106,3 +109,60 @@ func TestReport_ServerError(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("non-200 response"))
 }
+
+func TestReport_CACert(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(200)
+		}),
+	)
+	defer server.Close()
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	secret := &v1.Secret{
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+
+	store := NewUsageSecret()
+	store.Set(secret)
+
+	insecureSkipVerify := false
+	reporter, err := NewNIMReporter(store, server.URL, insecureSkipVerify)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = reporter.Report(context.Background(), ClusterDetails{})
+	g.Expect(err).To(MatchError(ContainSubstring("certificate")))
+
+	secret.Data["ca.crt"] = caCert
+	store.Set(secret)
+
+	g.Expect(reporter.Report(context.Background(), ClusterDetails{})).To(Succeed())
+}
+
+func TestReport_InvalidCACert(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := &v1.Secret{
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+			"ca.crt":   []byte("invalid"),
+		},
+	}
+
+	store := NewUsageSecret()
+	store.Set(secret)
+
+	insecureSkipVerify := false
+	reporter, err := NewNIMReporter(store, "https://nim.example.com", insecureSkipVerify)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = reporter.Report(context.Background(), ClusterDetails{})
+	g.Expect(err).To(MatchError(ContainSubstring("failed to parse the CA certificate")))
+}
@@ -6,6 +6,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
+// caCertKey is the key of the optional CA certificate in the Secret. The certificate is used to verify
+// the certificate of the usage reporting server.
+const caCertKey = "ca.crt"
+
 // Secret implements the SecretStorer interface.
 type Secret struct {
 	secret *v1.Secret
@@ -46,3 +50,15 @@ func (s *Secret) GetCredentials() ([]byte, []byte) {
 
 	return nil, nil
 }
+
+// GetCACert returns the CA certificate from the Secret, if any.
+func (s *Secret) GetCACert() []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.secret != nil {
+		return s.secret.Data[caCertKey]
+	}
+
+	return nil
+}
@@ -66,3 +66,26 @@ func TestGetCredentials(t *testing.T) {
 	g.Expect(user).To(Equal([]byte("user")))
 	g.Expect(pass).To(Equal([]byte("pass")))
 }
+
+func TestGetCACert(t *testing.T) {
+	store := NewUsageSecret()
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "custom",
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+			"ca.crt":   []byte("cert"),
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(store.GetCACert()).To(BeNil())
+
+	store.Set(secret)
+
+	g.Expect(store.GetCACert()).To(Equal([]byte("cert")))
+}
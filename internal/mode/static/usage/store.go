@@ -0,0 +1,112 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ReportStore
+
+// reportsKey is the key of the unsent usage reports in the ConfigMap.
+const reportsKey = "reports"
+
+// ReportStore persists the usage reports that haven't been sent yet.
+type ReportStore interface {
+	// Load returns the unsent reports, from the oldest to the newest.
+	Load(context.Context) ([]ClusterDetails, error)
+	// Save replaces the unsent reports.
+	Save(context.Context, []ClusterDetails) error
+}
+
+// ConfigMapReportStore stores the unsent usage reports in a ConfigMap, so that they survive the restarts of NGF
+// and the changes of the leader.
+type ConfigMapReportStore struct {
+	k8sReader client.Reader
+	k8sWriter client.Writer
+	nsName    types.NamespacedName
+}
+
+// NewConfigMapReportStore creates a new ConfigMapReportStore that stores the reports in the ConfigMap
+// with the provided namespaced name. The ConfigMap is created when the reports are saved for the first time.
+func NewConfigMapReportStore(
+	k8sReader client.Reader,
+	k8sWriter client.Writer,
+	nsName types.NamespacedName,
+) *ConfigMapReportStore {
+	return &ConfigMapReportStore{
+		k8sReader: k8sReader,
+		k8sWriter: k8sWriter,
+		nsName:    nsName,
+	}
+}
+
+// Load returns the unsent reports from the ConfigMap.
+func (s *ConfigMapReportStore) Load(ctx context.Context) ([]ClusterDetails, error) {
+	var cm v1.ConfigMap
+	if err := s.k8sReader.Get(ctx, s.nsName, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ConfigMap %s: %w", s.nsName, err)
+	}
+
+	data, exists := cm.Data[reportsKey]
+	if !exists {
+		return nil, nil
+	}
+
+	var reports []ClusterDetails
+	if err := json.Unmarshal([]byte(data), &reports); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the usage reports of ConfigMap %s: %w", s.nsName, err)
+	}
+
+	return reports, nil
+}
+
+// Save writes the unsent reports to the ConfigMap, creating it if it doesn't exist.
+func (s *ConfigMapReportStore) Save(ctx context.Context, reports []ClusterDetails) error {
+	data, err := json.Marshal(reports)
+	if err != nil {
+		return fmt.Errorf("error marshaling usage reports: %w", err)
+	}
+
+	var cm v1.ConfigMap
+	err = s.k8sReader.Get(ctx, s.nsName, &cm)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ConfigMap %s: %w", s.nsName, err)
+	}
+
+	if apierrors.IsNotFound(err) {
+		cm = v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.nsName.Namespace,
+				Name:      s.nsName.Name,
+			},
+			Data: map[string]string{reportsKey: string(data)},
+		}
+
+		if err := s.k8sWriter.Create(ctx, &cm); err != nil {
+			return fmt.Errorf("failed to create ConfigMap %s: %w", s.nsName, err)
+		}
+
+		return nil
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[reportsKey] = string(data)
+
+	if err := s.k8sWriter.Update(ctx, &cm); err != nil {
+		return fmt.Errorf("failed to update ConfigMap %s: %w", s.nsName, err)
+	}
+
+	return nil
+}
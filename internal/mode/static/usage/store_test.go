@@ -0,0 +1,75 @@
+package usage
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapReportStore(t *testing.T) {
+	g := NewWithT(t)
+
+	k8sClient := fake.NewClientBuilder().Build()
+	nsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-usage-reports"}
+	store := NewConfigMapReportStore(k8sClient, k8sClient, nsName)
+
+	reports, err := store.Load(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reports).To(BeEmpty())
+
+	expReports := []ClusterDetails{
+		{
+			Metadata:  Metadata{UID: "1234abcd"},
+			NodeCount: 3,
+		},
+		{
+			Metadata:  Metadata{UID: "1234abcd"},
+			NodeCount: 4,
+		},
+	}
+
+	// creates the ConfigMap
+	g.Expect(store.Save(context.Background(), expReports)).To(Succeed())
+
+	reports, err = store.Load(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reports).To(Equal(expReports))
+
+	// updates the ConfigMap
+	g.Expect(store.Save(context.Background(), expReports[1:])).To(Succeed())
+
+	reports, err = store.Load(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reports).To(Equal(expReports[1:]))
+
+	var cm v1.ConfigMap
+	g.Expect(k8sClient.Get(context.Background(), nsName, &cm)).To(Succeed())
+	g.Expect(cm.Data).To(HaveKey(reportsKey))
+}
+
+func TestConfigMapReportStore_InvalidData(t *testing.T) {
+	g := NewWithT(t)
+
+	nsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-usage-reports"}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: nsName.Namespace,
+			Name:      nsName.Name,
+		},
+		Data: map[string]string{
+			reportsKey: "invalid",
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithObjects(cm).Build()
+	store := NewConfigMapReportStore(k8sClient, k8sClient, nsName)
+
+	reports, err := store.Load(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("failed to unmarshal the usage reports")))
+	g.Expect(reports).To(BeNil())
+}
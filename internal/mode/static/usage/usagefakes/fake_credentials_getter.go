@@ -6,6 +6,16 @@ import (
 )
 
 type FakeCredentialsGetter struct {
+	GetCACertStub        func() []byte
+	getCACertMutex       sync.RWMutex
+	getCACertArgsForCall []struct {
+	}
+	getCACertReturns struct {
+		result1 []byte
+	}
+	getCACertReturnsOnCall map[int]struct {
+		result1 []byte
+	}
 	GetCredentialsStub        func() ([]byte, []byte)
 	getCredentialsMutex       sync.RWMutex
 	getCredentialsArgsForCall []struct {
@@ -22,6 +32,59 @@ type FakeCredentialsGetter struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCredentialsGetter) GetCACert() []byte {
+	fake.getCACertMutex.Lock()
+	ret, specificReturn := fake.getCACertReturnsOnCall[len(fake.getCACertArgsForCall)]
+	fake.getCACertArgsForCall = append(fake.getCACertArgsForCall, struct {
+	}{})
+	stub := fake.GetCACertStub
+	fakeReturns := fake.getCACertReturns
+	fake.recordInvocation("GetCACert", []interface{}{})
+	fake.getCACertMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCredentialsGetter) GetCACertCallCount() int {
+	fake.getCACertMutex.RLock()
+	defer fake.getCACertMutex.RUnlock()
+	return len(fake.getCACertArgsForCall)
+}
+
+func (fake *FakeCredentialsGetter) GetCACertCalls(stub func() []byte) {
+	fake.getCACertMutex.Lock()
+	defer fake.getCACertMutex.Unlock()
+	fake.GetCACertStub = stub
+}
+
+func (fake *FakeCredentialsGetter) GetCACertReturns(result1 []byte) {
+	fake.getCACertMutex.Lock()
+	defer fake.getCACertMutex.Unlock()
+	fake.GetCACertStub = nil
+	fake.getCACertReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeCredentialsGetter) GetCACertReturnsOnCall(i int, result1 []byte) {
+	fake.getCACertMutex.Lock()
+	defer fake.getCACertMutex.Unlock()
+	fake.GetCACertStub = nil
+	if fake.getCACertReturnsOnCall == nil {
+		fake.getCACertReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.getCACertReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
 func (fake *FakeCredentialsGetter) GetCredentials() ([]byte, []byte) {
 	fake.getCredentialsMutex.Lock()
 	ret, specificReturn := fake.getCredentialsReturnsOnCall[len(fake.getCredentialsArgsForCall)]
@@ -81,6 +144,8 @@ func (fake *FakeCredentialsGetter) GetCredentialsReturnsOnCall(i int, result1 []
 func (fake *FakeCredentialsGetter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getCACertMutex.RLock()
+	defer fake.getCACertMutex.RUnlock()
 	fake.getCredentialsMutex.RLock()
 	defer fake.getCredentialsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
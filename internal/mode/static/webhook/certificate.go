@@ -0,0 +1,332 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	admregv1 "k8s.io/api/admissionregistration/v1"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CACertKey is the key of the CA certificate in the Secret of the webhook.
+	CACertKey = "ca.crt"
+
+	// CertName and KeyName are the names of the files of the serving certificate and key in the certificate directory.
+	CertName = apiv1.TLSCertKey
+	KeyName  = apiv1.TLSPrivateKeyKey
+
+	certValidity = 365 * 24 * time.Hour
+	// certRenewBefore is how long before the certificates expire they are regenerated.
+	certRenewBefore = 30 * 24 * time.Hour
+	// maxSecretAttempts is how many times the Secret is read and written before giving up. Several replicas
+	// of the control plane can race to create or update the Secret.
+	maxSecretAttempts = 3
+)
+
+// CertificateConfig specifies how the serving certificate of the webhook is managed.
+type CertificateConfig struct {
+	// SecretNsName is the namespaced name of the Secret that stores the certificates.
+	SecretNsName types.NamespacedName
+	// ServiceNsName is the namespaced name of the Service of the webhook.
+	ServiceNsName types.NamespacedName
+	// WebhookConfigurationName is the name of the ValidatingWebhookConfiguration that gets the CA certificate.
+	WebhookConfigurationName string
+	// CertDir is the directory where the serving certificate and key are written.
+	CertDir string
+}
+
+// EnsureCertificate makes sure the Secret contains a valid serving certificate for the Service of the webhook,
+// writes the certificate and key to the certificate directory, and injects the CA certificate into
+// the ValidatingWebhookConfiguration.
+// The certificates are self-signed. They are generated if the Secret doesn't exist, or if they are invalid
+// or about to expire.
+func EnsureCertificate(
+	ctx context.Context,
+	k8sClient client.Client,
+	logger logr.Logger,
+	cfg CertificateConfig,
+) error {
+	return ensureCertificate(ctx, k8sClient, logger, cfg, time.Now())
+}
+
+// CreateCertificateRenewalWorker creates a worker that renews the certificates before they expire. Every run
+// does the same as EnsureCertificate, so the Secret, the certificate files and the CA certificate of the
+// ValidatingWebhookConfiguration are rewritten when the certificates are about to expire, or when another replica
+// has renewed them. The webhook server reloads the certificate files when they change.
+func CreateCertificateRenewalWorker(
+	k8sClient client.Client,
+	logger logr.Logger,
+	cfg CertificateConfig,
+) func(context.Context) {
+	return func(ctx context.Context) {
+		if err := EnsureCertificate(ctx, k8sClient, logger, cfg); err != nil {
+			logger.Error(err, "Failed to renew webhook certificates")
+		}
+	}
+}
+
+func ensureCertificate(
+	ctx context.Context,
+	k8sClient client.Client,
+	logger logr.Logger,
+	cfg CertificateConfig,
+	now time.Time,
+) error {
+	secret, err := ensureCertificateSecret(ctx, k8sClient, logger, cfg, now)
+	if err != nil {
+		return err
+	}
+
+	if err := writeCertificate(cfg.CertDir, secret); err != nil {
+		return fmt.Errorf("error writing webhook certificate: %w", err)
+	}
+
+	return injectCABundle(ctx, k8sClient, logger, cfg.WebhookConfigurationName, secret.Data[CACertKey])
+}
+
+func ensureCertificateSecret(
+	ctx context.Context,
+	k8sClient client.Client,
+	logger logr.Logger,
+	cfg CertificateConfig,
+	now time.Time,
+) (*apiv1.Secret, error) {
+	dnsNames := serviceDNSNames(cfg.ServiceNsName)
+
+	var err error
+	for attempt := 0; attempt < maxSecretAttempts; attempt++ {
+		var secret apiv1.Secret
+
+		err = k8sClient.Get(ctx, cfg.SecretNsName, &secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting webhook certificate Secret %s: %w", cfg.SecretNsName, err)
+		}
+		exists := err == nil
+
+		if exists {
+			certErr := validateCertificates(secret.Data, dnsNames, now)
+			if certErr == nil {
+				return &secret, nil
+			}
+			logger.Info("Regenerating webhook certificates", "secret", cfg.SecretNsName, "reason", certErr.Error())
+		}
+
+		data, genErr := generateCertificates(dnsNames, now)
+		if genErr != nil {
+			return nil, fmt.Errorf("error generating webhook certificates: %w", genErr)
+		}
+
+		if exists {
+			secret.Data = data
+			err = k8sClient.Update(ctx, &secret)
+		} else {
+			secret = apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: cfg.SecretNsName.Namespace,
+					Name:      cfg.SecretNsName.Name,
+				},
+				Type: apiv1.SecretTypeTLS,
+				Data: data,
+			}
+			err = k8sClient.Create(ctx, &secret)
+		}
+
+		if err == nil {
+			logger.Info("Stored webhook certificates", "secret", cfg.SecretNsName)
+			return &secret, nil
+		}
+
+		// Another replica has written the Secret in the meantime; use its certificates.
+		if !apierrors.IsConflict(err) && !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("error storing webhook certificates in Secret %s: %w", cfg.SecretNsName, err)
+		}
+	}
+
+	return nil, fmt.Errorf("error storing webhook certificates in Secret %s: %w", cfg.SecretNsName, err)
+}
+
+// serviceDNSNames returns the DNS names under which the API server reaches the Service.
+func serviceDNSNames(svc types.NamespacedName) []string {
+	return []string{
+		svc.Name,
+		fmt.Sprintf("%s.%s", svc.Name, svc.Namespace),
+		fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+	}
+}
+
+// validateCertificates makes sure the serving certificate is signed by the CA, is valid for the DNS names,
+// and doesn't expire soon.
+func validateCertificates(data map[string][]byte, dnsNames []string, now time.Time) error {
+	keyPair, err := tls.X509KeyPair(data[apiv1.TLSCertKey], data[apiv1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("invalid serving certificate: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("invalid serving certificate: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(data[CACertKey]) {
+		return errors.New("invalid CA certificate")
+	}
+
+	if now.Add(certRenewBefore).After(leaf.NotAfter) {
+		return errors.New("serving certificate expires soon")
+	}
+
+	for _, name := range dnsNames {
+		if _, err := leaf.Verify(x509.VerifyOptions{
+			DNSName:     name,
+			Roots:       roots,
+			CurrentTime: now,
+		}); err != nil {
+			return fmt.Errorf("serving certificate is not valid for %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// generateCertificates generates a self-signed CA certificate and a serving certificate for the DNS names,
+// signed by the CA. It returns them in the format of the data of a TLS Secret.
+func generateCertificates(dnsNames []string, now time.Time) (map[string][]byte, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	notBefore := now.Add(-time.Hour) // tolerate clock skew
+	notAfter := now.Add(certValidity)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "nginx-gateway-webhook-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[len(dnsNames)-1]},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		CACertKey:              pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		apiv1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		apiv1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+func writeCertificate(certDir string, secret *apiv1.Secret) error {
+	if err := os.MkdirAll(certDir, 0o700); err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		CertName: secret.Data[apiv1.TLSCertKey],
+		KeyName:  secret.Data[apiv1.TLSPrivateKeyKey],
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(certDir, name), content, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// injectCABundle sets the CA certificate in all webhooks of the ValidatingWebhookConfiguration. If the
+// ValidatingWebhookConfiguration doesn't exist, the API server doesn't call the webhook, and the control plane
+// validates the resources only when it processes them.
+func injectCABundle(
+	ctx context.Context,
+	k8sClient client.Client,
+	logger logr.Logger,
+	name string,
+	caBundle []byte,
+) error {
+	var whConfig admregv1.ValidatingWebhookConfiguration
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, &whConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(
+				"ValidatingWebhookConfiguration not found; resources are not validated at admission time",
+				"name", name,
+			)
+			return nil
+		}
+		return fmt.Errorf("error getting ValidatingWebhookConfiguration %s: %w", name, err)
+	}
+
+	changed := false
+	for i := range whConfig.Webhooks {
+		if !bytes.Equal(whConfig.Webhooks[i].ClientConfig.CABundle, caBundle) {
+			whConfig.Webhooks[i].ClientConfig.CABundle = caBundle
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := k8sClient.Update(ctx, &whConfig); err != nil {
+		return fmt.Errorf("error injecting CA certificate into ValidatingWebhookConfiguration %s: %w", name, err)
+	}
+
+	return nil
+}
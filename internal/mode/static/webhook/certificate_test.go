@@ -0,0 +1,292 @@
+package webhook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	admregv1 "k8s.io/api/admissionregistration/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureCertificate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	g := NewWithT(t)
+	g.Expect(apiv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(admregv1.AddToScheme(scheme)).To(Succeed())
+
+	secretNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook-cert"}
+
+	createConfig := func(certDir string) CertificateConfig {
+		return CertificateConfig{
+			SecretNsName:             secretNsName,
+			ServiceNsName:            types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook"},
+			WebhookConfigurationName: "webhook",
+			CertDir:                  certDir,
+		}
+	}
+
+	whConfig := &admregv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+		Webhooks: []admregv1.ValidatingWebhook{
+			{Name: "httproute.validate.gateway.nginx.org"},
+			{Name: "nginxproxy.validate.gateway.nginx.org"},
+		},
+	}
+
+	now := time.Now()
+
+	getSecret := func(k8sClient client.Client) *apiv1.Secret {
+		var secret apiv1.Secret
+		g.Expect(k8sClient.Get(context.Background(), secretNsName, &secret)).To(Succeed())
+		return &secret
+	}
+
+	expectFiles := func(g *WithT, certDir string, secret *apiv1.Secret) {
+		cert, err := os.ReadFile(filepath.Join(certDir, CertName))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cert).To(Equal(secret.Data[apiv1.TLSCertKey]))
+
+		key, err := os.ReadFile(filepath.Join(certDir, KeyName))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(key).To(Equal(secret.Data[apiv1.TLSPrivateKeyKey]))
+	}
+
+	t.Run("creates the Secret and injects the CA certificate", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(whConfig.DeepCopy()).Build()
+		certDir := t.TempDir()
+
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(certDir), now)).
+			To(Succeed())
+
+		secret := getSecret(k8sClient)
+		g.Expect(secret.Type).To(Equal(apiv1.SecretTypeTLS))
+		g.Expect(validateCertificates(secret.Data, []string{"webhook.nginx-gateway.svc"}, now)).To(Succeed())
+		expectFiles(g, certDir, secret)
+
+		var updated admregv1.ValidatingWebhookConfiguration
+		g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "webhook"}, &updated)).To(Succeed())
+		for _, wh := range updated.Webhooks {
+			g.Expect(wh.ClientConfig.CABundle).To(Equal(secret.Data[CACertKey]))
+		}
+	})
+
+	t.Run("reuses valid certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(t.TempDir()), now)).
+			To(Succeed())
+		original := getSecret(k8sClient)
+
+		certDir := t.TempDir()
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(certDir), now)).
+			To(Succeed())
+
+		g.Expect(getSecret(k8sClient).Data).To(Equal(original.Data))
+		expectFiles(g, certDir, original)
+	})
+
+	t.Run("regenerates certificates that expire soon", func(t *testing.T) {
+		g := NewWithT(t)
+
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(t.TempDir()), now)).
+			To(Succeed())
+		original := getSecret(k8sClient)
+
+		later := now.Add(certValidity - certRenewBefore + time.Hour)
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(t.TempDir()), later)).
+			To(Succeed())
+
+		regenerated := getSecret(k8sClient)
+		g.Expect(regenerated.Data).ToNot(Equal(original.Data))
+		g.Expect(validateCertificates(regenerated.Data, []string{"webhook"}, later)).To(Succeed())
+	})
+
+	t.Run("regenerates invalid certificates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		secret := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: secretNsName.Namespace, Name: secretNsName.Name},
+			Data: map[string][]byte{
+				CACertKey:              []byte("invalid"),
+				apiv1.TLSCertKey:       []byte("invalid"),
+				apiv1.TLSPrivateKeyKey: []byte("invalid"),
+			},
+		}
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+		g.Expect(ensureCertificate(context.Background(), k8sClient, logr.Discard(), createConfig(t.TempDir()), now)).
+			To(Succeed())
+
+		g.Expect(validateCertificates(getSecret(k8sClient).Data, []string{"webhook.nginx-gateway"}, now)).To(Succeed())
+	})
+}
+
+func TestCreateCertificateRenewalWorker(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(admregv1.AddToScheme(scheme)).To(Succeed())
+
+	cfg := CertificateConfig{
+		SecretNsName:             types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook-cert"},
+		ServiceNsName:            types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook"},
+		WebhookConfigurationName: "webhook",
+		CertDir:                  t.TempDir(),
+	}
+
+	// the certificates expire in less than certRenewBefore
+	expiringData, err := generateCertificates(
+		serviceDNSNames(cfg.ServiceNsName),
+		time.Now().Add(-certValidity+certRenewBefore/2),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cfg.SecretNsName.Namespace,
+			Name:      cfg.SecretNsName.Name,
+		},
+		Type: apiv1.SecretTypeTLS,
+		Data: expiringData,
+	}
+
+	whConfig := &admregv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+		Webhooks: []admregv1.ValidatingWebhook{
+			{
+				Name:         "httproute.validate.gateway.nginx.org",
+				ClientConfig: admregv1.WebhookClientConfig{CABundle: expiringData[CACertKey]},
+			},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, whConfig).Build()
+
+	worker := CreateCertificateRenewalWorker(k8sClient, logr.Discard(), cfg)
+	worker(context.Background())
+
+	var renewed apiv1.Secret
+	g.Expect(k8sClient.Get(context.Background(), cfg.SecretNsName, &renewed)).To(Succeed())
+	g.Expect(renewed.Data[CACertKey]).ToNot(Equal(expiringData[CACertKey]))
+	g.Expect(validateCertificates(renewed.Data, serviceDNSNames(cfg.ServiceNsName), time.Now())).To(Succeed())
+
+	cert, err := os.ReadFile(filepath.Join(cfg.CertDir, CertName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cert).To(Equal(renewed.Data[apiv1.TLSCertKey]))
+
+	key, err := os.ReadFile(filepath.Join(cfg.CertDir, KeyName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal(renewed.Data[apiv1.TLSPrivateKeyKey]))
+
+	var updated admregv1.ValidatingWebhookConfiguration
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "webhook"}, &updated)).To(Succeed())
+	g.Expect(updated.Webhooks[0].ClientConfig.CABundle).To(Equal(renewed.Data[CACertKey]))
+
+	// the next run keeps the valid certificates
+	worker(context.Background())
+
+	var unchanged apiv1.Secret
+	g.Expect(k8sClient.Get(context.Background(), cfg.SecretNsName, &unchanged)).To(Succeed())
+	g.Expect(unchanged.ResourceVersion).To(Equal(renewed.ResourceVersion))
+}
+
+func TestValidateCertificates(t *testing.T) {
+	now := time.Now()
+	dnsNames := serviceDNSNames(types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook"})
+
+	g := NewWithT(t)
+
+	data, err := generateCertificates(dnsNames, now)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	otherData, err := generateCertificates(dnsNames, now)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tests := []struct {
+		data     map[string][]byte
+		now      time.Time
+		name     string
+		dnsNames []string
+		expErr   bool
+	}{
+		{
+			name:     "valid",
+			data:     data,
+			dnsNames: dnsNames,
+			now:      now,
+		},
+		{
+			name:     "expires soon",
+			data:     data,
+			dnsNames: dnsNames,
+			now:      now.Add(certValidity - certRenewBefore + time.Minute),
+			expErr:   true,
+		},
+		{
+			name:     "other DNS name",
+			data:     data,
+			dnsNames: []string{"other.nginx-gateway.svc"},
+			now:      now,
+			expErr:   true,
+		},
+		{
+			name: "signed by another CA",
+			data: map[string][]byte{
+				CACertKey:              otherData[CACertKey],
+				apiv1.TLSCertKey:       data[apiv1.TLSCertKey],
+				apiv1.TLSPrivateKeyKey: data[apiv1.TLSPrivateKeyKey],
+			},
+			dnsNames: dnsNames,
+			now:      now,
+			expErr:   true,
+		},
+		{
+			name: "key doesn't match",
+			data: map[string][]byte{
+				CACertKey:              data[CACertKey],
+				apiv1.TLSCertKey:       data[apiv1.TLSCertKey],
+				apiv1.TLSPrivateKeyKey: otherData[apiv1.TLSPrivateKeyKey],
+			},
+			dnsNames: dnsNames,
+			now:      now,
+			expErr:   true,
+		},
+		{
+			name:     "missing",
+			data:     nil,
+			dnsNames: dnsNames,
+			now:      now,
+			expErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := validateCertificates(test.data, test.dnsNames, test.now)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+/*
+Package webhook contains the validating admission webhook, which rejects Gateway API and NGINX Gateway Fabric
+resources with invalid fields at admission time, and the management of its serving certificate.
+*/
+package webhook
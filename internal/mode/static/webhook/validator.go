@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// The paths of the validating webhooks. They must match the paths in the ValidatingWebhookConfiguration.
+const (
+	HTTPRoutePath            = "/validate-httproute"
+	GRPCRoutePath            = "/validate-grpcroute"
+	NginxProxyPath           = "/validate-nginxproxy"
+	ClientSettingsPolicyPath = "/validate-clientsettingspolicy"
+	CompressionPolicyPath    = "/validate-compressionpolicy"
+	ErrorPagePolicyPath      = "/validate-errorpagepolicy"
+	SnippetsFilterPath       = "/validate-snippetsfilter"
+)
+
+// objectValidator validates an object and returns the invalid fields.
+type objectValidator func(obj client.Object) field.ErrorList
+
+// objectFilter determines whether an object must be validated.
+type objectFilter func(ctx context.Context, obj client.Object) (bool, error)
+
+// resourceValidator validates the resources of a kind on creation and update. It runs the same validation as
+// building the graph. It implements admission.CustomValidator.
+type resourceValidator struct {
+	validate objectValidator
+	// filter is optional. If set, the objects that it filters out are admitted without validation.
+	filter    objectFilter
+	groupKind schema.GroupKind
+}
+
+func (v resourceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validateObject(ctx, obj)
+}
+
+func (v resourceValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validateObject(ctx, newObj)
+}
+
+func (v resourceValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v resourceValidator) validateObject(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	if v.filter != nil {
+		matches, err := v.filter(ctx, clientObj)
+		if err != nil {
+			// The control plane still validates the resource when it processes it, so it is not rejected
+			// because of a transient error.
+			return admission.Warnings{
+				fmt.Sprintf("NGINX Gateway Fabric could not validate the resource at admission time: %s", err),
+			}, nil
+		}
+
+		if !matches {
+			return nil, nil
+		}
+	}
+
+	if errs := v.validate(clientObj); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(v.groupKind, clientObj.GetName(), errs)
+	}
+
+	return nil, nil
+}
+
+// GatewayConfig specifies the Gateways of the control plane. Only the routes attached to them are validated,
+// so that the routes of other Gateway API implementations are always admitted.
+type GatewayConfig struct {
+	// Reader reads the Gateways.
+	Reader client.Reader
+	// GatewayNsName is the namespaced name of the only Gateway of the control plane, if set.
+	GatewayNsName *types.NamespacedName
+	// GatewayClassName is the name of the GatewayClass of the control plane.
+	GatewayClassName string
+}
+
+// attachedToGateway returns true if any of the parentRefs of the route references a Gateway of the control plane.
+// The parentRefs that reference missing Gateways are ignored.
+func (c GatewayConfig) attachedToGateway(
+	ctx context.Context,
+	routeNamespace string,
+	parentRefs []gatewayv1.ParentReference,
+) (bool, error) {
+	for _, ref := range parentRefs {
+		if ref.Group != nil && *ref.Group != gatewayv1.GroupName {
+			continue
+		}
+
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+
+		nsname := types.NamespacedName{Namespace: routeNamespace, Name: string(ref.Name)}
+		if ref.Namespace != nil {
+			nsname.Namespace = string(*ref.Namespace)
+		}
+
+		if c.GatewayNsName != nil && *c.GatewayNsName != nsname {
+			continue
+		}
+
+		var gw gatewayv1.Gateway
+		if err := c.Reader.Get(ctx, nsname, &gw); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("error getting Gateway %s: %w", nsname, err)
+		}
+
+		if string(gw.Spec.GatewayClassName) == c.GatewayClassName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Register registers the validating webhooks in the webhook server. The plus argument specifies whether
+// the data plane is NGINX Plus, which supports more features than NGINX open source.
+func Register(
+	server webhook.Server,
+	scheme *runtime.Scheme,
+	validators validation.Validators,
+	plus bool,
+	gatewayCfg GatewayConfig,
+) {
+	httpValidator := validators.HTTPFieldsValidator
+	genericValidator := validators.GenericValidator
+
+	webhooks := []struct {
+		obj       client.Object
+		validate  objectValidator
+		filter    objectFilter
+		groupKind schema.GroupKind
+		path      string
+	}{
+		{
+			path:      HTTPRoutePath,
+			obj:       &gatewayv1.HTTPRoute{},
+			groupKind: schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateHTTPRoute(httpValidator, obj.(*gatewayv1.HTTPRoute))
+			},
+			filter: func(ctx context.Context, obj client.Object) (bool, error) {
+				route := obj.(*gatewayv1.HTTPRoute)
+				return gatewayCfg.attachedToGateway(ctx, route.Namespace, route.Spec.ParentRefs)
+			},
+		},
+		{
+			path:      GRPCRoutePath,
+			obj:       &gatewayv1alpha2.GRPCRoute{},
+			groupKind: schema.GroupKind{Group: gatewayv1alpha2.GroupName, Kind: "GRPCRoute"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateGRPCRoute(httpValidator, obj.(*gatewayv1alpha2.GRPCRoute))
+			},
+			filter: func(ctx context.Context, obj client.Object) (bool, error) {
+				route := obj.(*gatewayv1alpha2.GRPCRoute)
+				return gatewayCfg.attachedToGateway(ctx, route.Namespace, route.Spec.ParentRefs)
+			},
+		},
+		{
+			path:      NginxProxyPath,
+			obj:       &ngfAPI.NginxProxy{},
+			groupKind: schema.GroupKind{Group: ngfAPI.GroupName, Kind: "NginxProxy"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateNginxProxy(genericValidator, obj.(*ngfAPI.NginxProxy), plus)
+			},
+		},
+		{
+			path:      ClientSettingsPolicyPath,
+			obj:       &ngfAPI.ClientSettingsPolicy{},
+			groupKind: schema.GroupKind{Group: ngfAPI.GroupName, Kind: "ClientSettingsPolicy"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateClientSettingsPolicy(genericValidator, obj.(*ngfAPI.ClientSettingsPolicy))
+			},
+		},
+		{
+			path:      CompressionPolicyPath,
+			obj:       &ngfAPI.CompressionPolicy{},
+			groupKind: schema.GroupKind{Group: ngfAPI.GroupName, Kind: "CompressionPolicy"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateCompressionPolicy(genericValidator, obj.(*ngfAPI.CompressionPolicy), plus)
+			},
+		},
+		{
+			path:      ErrorPagePolicyPath,
+			obj:       &ngfAPI.ErrorPagePolicy{},
+			groupKind: schema.GroupKind{Group: ngfAPI.GroupName, Kind: "ErrorPagePolicy"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateErrorPagePolicy(genericValidator, obj.(*ngfAPI.ErrorPagePolicy))
+			},
+		},
+		{
+			path:      SnippetsFilterPath,
+			obj:       &ngfAPI.SnippetsFilter{},
+			groupKind: schema.GroupKind{Group: ngfAPI.GroupName, Kind: "SnippetsFilter"},
+			validate: func(obj client.Object) field.ErrorList {
+				return graph.ValidateSnippetsFilter(
+					genericValidator,
+					validators.SnippetValidator,
+					obj.(*ngfAPI.SnippetsFilter),
+				)
+			},
+		},
+	}
+
+	for _, wh := range webhooks {
+		validator := resourceValidator{
+			validate:  wh.validate,
+			filter:    wh.filter,
+			groupKind: wh.groupKind,
+		}
+
+		// Invalid input must not crash the control plane.
+		server.Register(wh.path, admission.WithCustomValidator(scheme, wh.obj, validator).WithRecoverPanic(true))
+	}
+}
@@ -0,0 +1,346 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestResourceValidator(t *testing.T) {
+	groupKind := schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"}
+
+	invalid := resourceValidator{
+		groupKind: groupKind,
+		validate: func(client.Object) field.ErrorList {
+			return field.ErrorList{field.Invalid(field.NewPath("spec", "hostnames"), "", "invalid hostname")}
+		},
+	}
+	valid := resourceValidator{
+		groupKind: groupKind,
+		validate: func(client.Object) field.ErrorList {
+			return nil
+		},
+	}
+
+	route := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}}
+
+	g := NewWithT(t)
+
+	_, err := valid.ValidateCreate(context.Background(), route)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = valid.ValidateUpdate(context.Background(), route, route)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = invalid.ValidateCreate(context.Background(), route)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring(`HTTPRoute.gateway.networking.k8s.io "hr" is invalid`))
+	g.Expect(err.Error()).To(ContainSubstring("spec.hostnames"))
+
+	_, err = invalid.ValidateUpdate(context.Background(), route, route)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+	_, err = invalid.ValidateDelete(context.Background(), route)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	filteredOut := invalid
+	filteredOut.filter = func(context.Context, client.Object) (bool, error) {
+		return false, nil
+	}
+
+	_, err = filteredOut.ValidateCreate(context.Background(), route)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	filterFails := invalid
+	filterFails.filter = func(context.Context, client.Object) (bool, error) {
+		return false, errors.New("filter error")
+	}
+
+	warnings, err := filterFails.ValidateCreate(context.Background(), route)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(ContainSubstring("filter error")))
+}
+
+func TestGatewayConfigAttachedToGateway(t *testing.T) {
+	scheme := runtime.NewScheme()
+	g := NewWithT(t)
+	g.Expect(gatewayv1.Install(scheme)).To(Succeed())
+
+	createGateway := func(namespace, name, className string) *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       gatewayv1.GatewaySpec{GatewayClassName: gatewayv1.ObjectName(className)},
+		}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			createGateway("test", "gateway", "nginx"),
+			createGateway("other", "gateway", "nginx"),
+			createGateway("test", "other-gateway", "other"),
+		).
+		Build()
+
+	failingClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return errors.New("get error")
+			},
+		}).
+		Build()
+
+	tests := []struct {
+		reader        client.Reader
+		gatewayNsName *types.NamespacedName
+		name          string
+		refs          []gatewayv1.ParentReference
+		expAttached   bool
+		expErr        bool
+	}{
+		{
+			name:        "Gateway of the GatewayClass",
+			reader:      k8sClient,
+			refs:        []gatewayv1.ParentReference{{Name: "gateway"}},
+			expAttached: true,
+		},
+		{
+			name:   "Gateway of another GatewayClass",
+			reader: k8sClient,
+			refs:   []gatewayv1.ParentReference{{Name: "other-gateway"}},
+		},
+		{
+			name:   "Gateway in another Namespace",
+			reader: k8sClient,
+			refs: []gatewayv1.ParentReference{
+				{Name: "gateway", Namespace: helpers.GetPointer[gatewayv1.Namespace]("other")},
+			},
+			expAttached: true,
+		},
+		{
+			name:   "parent of another kind",
+			reader: k8sClient,
+			refs: []gatewayv1.ParentReference{
+				{Name: "gateway", Kind: helpers.GetPointer[gatewayv1.Kind]("Service")},
+			},
+		},
+		{
+			name:          "Gateway that is not the Gateway of the control plane",
+			reader:        k8sClient,
+			gatewayNsName: &types.NamespacedName{Namespace: "other", Name: "gateway"},
+			refs:          []gatewayv1.ParentReference{{Name: "gateway"}},
+		},
+		{
+			name:   "missing Gateway",
+			reader: k8sClient,
+			refs:   []gatewayv1.ParentReference{{Name: "missing"}},
+		},
+		{
+			name:   "no parentRefs",
+			reader: k8sClient,
+		},
+		{
+			name:   "Gateway can't be read",
+			reader: failingClient,
+			refs:   []gatewayv1.ParentReference{{Name: "gateway"}},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cfg := GatewayConfig{
+				Reader:           test.reader,
+				GatewayNsName:    test.gatewayNsName,
+				GatewayClassName: "nginx",
+			}
+
+			attached, err := cfg.attachedToGateway(context.Background(), "test", test.refs)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(attached).To(Equal(test.expAttached))
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	scheme := runtime.NewScheme()
+	g := NewWithT(t)
+	g.Expect(gatewayv1.Install(scheme)).To(Succeed())
+	g.Expect(ngfAPI.AddToScheme(scheme)).To(Succeed())
+
+	createGateway := func(name, className string) *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Spec:       gatewayv1.GatewaySpec{GatewayClassName: gatewayv1.ObjectName(className)},
+		}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(createGateway("gateway", "nginx"), createGateway("other-gateway", "other")).
+		Build()
+
+	server := webhook.NewServer(webhook.Options{})
+	Register(
+		server,
+		scheme,
+		validation.Validators{
+			HTTPFieldsValidator: &validationfakes.FakeHTTPFieldsValidator{},
+			GenericValidator:    &validationfakes.FakeGenericValidator{},
+		},
+		false, /* plus */
+		GatewayConfig{
+			Reader:           k8sClient,
+			GatewayClassName: "nginx",
+		},
+	)
+
+	mux := server.(*webhook.DefaultServer).WebhookMux()
+
+	createReview := func(obj runtime.Object) []byte {
+		raw, err := json.Marshal(obj)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		review := admissionv1.AdmissionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+			Request: &admissionv1.AdmissionRequest{
+				UID:       "uid",
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		}
+
+		body, err := json.Marshal(review)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return body
+	}
+
+	createHTTPRoute := func(hostname gatewayv1.Hostname, gatewayNames ...string) *gatewayv1.HTTPRoute {
+		route := &gatewayv1.HTTPRoute{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+			Spec: gatewayv1.HTTPRouteSpec{
+				Hostnames: []gatewayv1.Hostname{hostname},
+			},
+		}
+
+		for _, name := range gatewayNames {
+			route.Spec.ParentRefs = append(
+				route.Spec.ParentRefs,
+				gatewayv1.ParentReference{Name: gatewayv1.ObjectName(name)},
+			)
+		}
+
+		return route
+	}
+
+	tests := []struct {
+		obj        runtime.Object
+		name       string
+		path       string
+		expAllowed bool
+	}{
+		{
+			name:       "valid HTTPRoute",
+			path:       HTTPRoutePath,
+			obj:        createHTTPRoute("example.com", "gateway"),
+			expAllowed: true,
+		},
+		{
+			name:       "invalid HTTPRoute",
+			path:       HTTPRoutePath,
+			obj:        createHTTPRoute("*.*.example.com", "other-gateway", "gateway"),
+			expAllowed: false,
+		},
+		{
+			name:       "invalid HTTPRoute of a Gateway of another GatewayClass",
+			path:       HTTPRoutePath,
+			obj:        createHTTPRoute("*.*.example.com", "other-gateway"),
+			expAllowed: true,
+		},
+		{
+			name:       "invalid HTTPRoute of a missing Gateway",
+			path:       HTTPRoutePath,
+			obj:        createHTTPRoute("*.*.example.com", "missing"),
+			expAllowed: true,
+		},
+		{
+			name:       "invalid HTTPRoute without parentRefs",
+			path:       HTTPRoutePath,
+			obj:        createHTTPRoute("*.*.example.com"),
+			expAllowed: true,
+		},
+		{
+			name: "invalid ClientSettingsPolicy",
+			path: ClientSettingsPolicyPath,
+			obj: &ngfAPI.ClientSettingsPolicy{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.nginx.org/v1alpha1", Kind: "ClientSettingsPolicy"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy"},
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					TargetRef: v1alpha2.PolicyTargetReference{Group: "core", Kind: "Service", Name: "svc"},
+				},
+			},
+			expAllowed: false,
+		},
+		{
+			name: "object of unexpected kind",
+			path: NginxProxyPath,
+			obj: &apiv1.Service{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc"},
+			},
+			expAllowed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			req := httptest.NewRequest(http.MethodPost, test.path, bytes.NewReader(createReview(test.obj)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			mux.ServeHTTP(rec, req)
+			g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+			var resp admissionv1.AdmissionReview
+			g.Expect(json.Unmarshal(rec.Body.Bytes(), &resp)).To(Succeed())
+			g.Expect(resp.Response).ToNot(BeNil())
+			g.Expect(resp.Response.Allowed).To(Equal(test.expAllowed))
+		})
+	}
+}